@@ -3,8 +3,10 @@ package bsctypes
 import (
 	"crypto/ecdsa"
 
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
 )
 
 // BscAccount indicates the user's identity information used for interaction with BSC.
@@ -64,3 +66,30 @@ func NewBscAccountFromPrivateKey(name, privKey string) (*BscAccount, error) {
 		km:   km,
 	}, nil
 }
+
+// NewBscAccountFromKeystore - Create bsc account instance from an Ethereum keystore v3 json file content.
+//
+// The keystore exported by types.Account.ExportKeystore can be loaded as well, since Greenfield uses eth_secp256k1 keys.
+func NewBscAccountFromKeystore(name string, keyJSON []byte, passphrase string) (*BscAccount, error) {
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return &BscAccount{
+		name: name,
+		km: &BscKeyManager{
+			privateKey: key.PrivateKey,
+			address:    &key.Address,
+		},
+	}, nil
+}
+
+// ExportKeystore - Encrypt the private key of the account with the passphrase in the Ethereum keystore v3 format.
+func (a *BscAccount) ExportKeystore(passphrase string) ([]byte, error) {
+	key := &keystore.Key{
+		Id:         uuid.New(),
+		Address:    *a.km.GetAddr(),
+		PrivateKey: a.km.GetPrivateKey(),
+	}
+	return keystore.EncryptKey(key, passphrase, keystore.StandardScryptN, keystore.StandardScryptP)
+}
@@ -0,0 +1,494 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/rs/zerolog/log"
+
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/utils"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	gnfdSdkTypes "github.com/bnb-chain/greenfield/sdk/types"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+)
+
+// AccountPool - Spread the CreateObject and PutObject workloads across several funded accounts.
+//
+// One account can only get a limited number of txs into every block, the pool round-robins the uploads across its
+// accounts and keeps the nonce of every account locally, so that several txs of the same account can be in flight.
+// The accounts are expected to share the target bucket through a group permission, see GrantBucketAccess.
+type AccountPool struct {
+	// funder is the client of the account which pays the fees of the pool accounts, it allows to be nil.
+	funder     IClient
+	members    []*poolMember
+	next       uint64
+	opts       types.AccountPoolOptions
+	createTime time.Time
+}
+
+// poolMember is one account of the pool with its own client and statistics.
+type poolMember struct {
+	client  IClient
+	account *types.Account
+
+	// mtx guards the locally tracked nonce of the account, it is held from the nonce allocation through the sync
+	// broadcast, so that the txs of the account reach the node in the nonce order.
+	mtx         sync.Mutex
+	nonce       uint64
+	nonceLoaded bool
+
+	pending   int64
+	submitted uint64
+	failed    uint64
+	objects   uint64
+	bytes     uint64
+}
+
+// NewAccountPool - Create an account pool, one Client is created for every account of the pool.
+//
+// - chainID: The Greenfield Blockchain's chainID that the clients would interact with.
+//
+// - endpoint: The Greenfield Blockchain's RPC URL that the clients would interact with.
+//
+// - option: The optional configurations for the clients. The DefaultAccount, if set, is used as the funder which
+// tops up the pool accounts and grants them the bucket permission.
+//
+// - accounts: The accounts of the pool.
+//
+// - opts: The options of the pool.
+//
+// - ret1: The new account pool.
+//
+// - ret2: Return error when creating any of the clients failed, otherwise return nil.
+func NewAccountPool(chainID string, endpoint string, option Option, accounts []*types.Account, opts types.AccountPoolOptions) (*AccountPool, error) {
+	if len(accounts) == 0 {
+		return nil, errors.New("the account pool requires at least one account")
+	}
+	if opts.MaxPendingTxPerAccount <= 0 {
+		opts.MaxPendingTxPerAccount = types.DefaultMaxPendingTxPerAccount
+	}
+
+	pool := &AccountPool{
+		members:    make([]*poolMember, 0, len(accounts)),
+		opts:       opts,
+		createTime: time.Now(),
+	}
+	if option.DefaultAccount != nil {
+		funder, err := New(chainID, endpoint, option)
+		if err != nil {
+			return nil, err
+		}
+		pool.funder = funder
+	}
+	for _, account := range accounts {
+		memberOption := option
+		memberOption.DefaultAccount = account
+		client, err := New(chainID, endpoint, memberOption)
+		if err != nil {
+			// stop the background goroutines of the clients created so far
+			_ = pool.Close()
+			return nil, err
+		}
+		pool.members = append(pool.members, &poolMember{client: client, account: account})
+	}
+	return pool, nil
+}
+
+// Accounts - List the accounts of the pool.
+func (p *AccountPool) Accounts() []*types.Account {
+	accounts := make([]*types.Account, 0, len(p.members))
+	for _, m := range p.members {
+		accounts = append(accounts, m.account)
+	}
+	return accounts
+}
+
+// CreateObject - Create an object meta on chain with the next available account of the pool.
+//
+// The tx is broadcast in sync mode and confirmed in the background, it is counted as pending until it is included in a block.
+//
+// - ctx: Context variables for the current API call.
+//
+// - bucketName: The bucket name identifies the bucket, the pool accounts should have been granted the permission to create objects in it.
+//
+// - objectName: The object name identifies the object.
+//
+// - reader: The io.Reader of the object payload.
+//
+// - opts: The options for customizing the object and the transaction, the Nonce of the TxOpts is set by the pool.
+//
+// - ret1: Transaction hash return from blockchain.
+//
+// - ret2: The account which creates the object, the same account should be used to put the object payload.
+//
+// - ret3: Return error when the request failed, otherwise return nil.
+func (p *AccountPool) CreateObject(ctx context.Context, bucketName, objectName string, reader io.Reader, opts types.CreateObjectOptions) (string, *types.Account, error) {
+	m := p.pick()
+	// the result channel is buffered, so the tx is still confirmed in the background without being received
+	txHash, _, err := p.createObject(ctx, m, bucketName, objectName, reader, opts)
+	if err != nil {
+		return "", m.account, err
+	}
+	return txHash, m.account, nil
+}
+
+// PutObject - Upload the payload of an object created by CreateObject of the pool.
+//
+// - ctx: Context variables for the current API call.
+//
+// - account: The pool account which created the object.
+//
+// - bucketName: The bucket name identifies the bucket.
+//
+// - objectName: The object name identifies the object.
+//
+// - objectSize: The size of the object.
+//
+// - reader: The io.Reader of the object payload.
+//
+// - opts: The options for customizing the upload.
+//
+// - ret: Return error when the request failed, otherwise return nil.
+func (p *AccountPool) PutObject(ctx context.Context, account *types.Account, bucketName, objectName string, objectSize int64, reader io.Reader, opts types.PutObjectOptions) error {
+	m, err := p.member(account)
+	if err != nil {
+		return err
+	}
+	return p.putObject(ctx, m, bucketName, objectName, objectSize, reader, opts)
+}
+
+// UploadObject - Create the object meta on chain and upload the payload with the next available account of the pool.
+//
+// - ctx: Context variables for the current API call.
+//
+// - bucketName: The bucket name identifies the bucket.
+//
+// - objectName: The object name identifies the object.
+//
+// - reader: The io.ReadSeeker of the object payload, it is rewound after the hash computation.
+//
+// - opts: The options for creating and uploading the object.
+//
+// - ret1: The account which uploads the object.
+//
+// - ret2: Return error when the request failed, otherwise return nil.
+func (p *AccountPool) UploadObject(ctx context.Context, bucketName, objectName string, reader io.ReadSeeker, opts types.UploadObjectOptions) (*types.Account, error) {
+	m := p.pick()
+	txHash, done, err := p.createObject(ctx, m, bucketName, objectName, reader, opts.CreateOpts)
+	if err != nil {
+		return m.account, err
+	}
+	if err = <-done; err != nil {
+		return m.account, err
+	}
+
+	size, err := reader.Seek(0, io.SeekEnd)
+	if err != nil {
+		return m.account, err
+	}
+	if _, err = reader.Seek(0, io.SeekStart); err != nil {
+		return m.account, err
+	}
+	opts.PutOpts.TxnHash = txHash
+	return m.account, p.putObject(ctx, m, bucketName, objectName, size, reader, opts.PutOpts)
+}
+
+// Rebalance - Top up the pool accounts whose balances are under MinBalance with TopUpAmount, in one MultiTransfer tx sent by the funder.
+//
+// - ctx: Context variables for the current API call.
+//
+// - ret1: Transaction hash return from blockchain, it is empty if no account needs to be topped up.
+//
+// - ret2: Return error when the request failed, otherwise return nil.
+func (p *AccountPool) Rebalance(ctx context.Context) (string, error) {
+	if p.funder == nil {
+		return "", errors.New("the account pool has no funder account")
+	}
+	if p.opts.MinBalance.IsNil() || p.opts.TopUpAmount.IsNil() || !p.opts.TopUpAmount.IsPositive() {
+		return "", errors.New("MinBalance and TopUpAmount should be set to rebalance the account pool")
+	}
+
+	details := make([]types.TransferDetail, 0)
+	for _, m := range p.members {
+		address := m.account.GetAddress().String()
+		balance, err := p.funder.GetAccountBalance(ctx, address)
+		if err != nil {
+			return "", err
+		}
+		if balance.Amount.LT(p.opts.MinBalance) {
+			details = append(details, types.TransferDetail{ToAddress: address, Amount: p.opts.TopUpAmount})
+		}
+	}
+	if len(details) == 0 {
+		return "", nil
+	}
+
+	txHash, err := p.funder.MultiTransfer(ctx, details, gnfdSdkTypes.TxOption{})
+	if err != nil {
+		return "", err
+	}
+	return txHash, p.waitFunderTx(ctx, txHash)
+}
+
+// StartRebalancer - Call Rebalance every interval until the context is done, the failures are logged.
+func (p *AccountPool) StartRebalancer(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := p.Rebalance(ctx); err != nil {
+					log.Error().Msg(fmt.Sprintf("fail to rebalance the account pool, err %v ", err))
+				}
+			}
+		}
+	}()
+}
+
+// GrantBucketAccess - Let the pool accounts create objects in a bucket owned by the funder.
+//
+// The group is created if it does not exist, the pool accounts are added to it, and a bucket policy allowing the
+// group to create objects is put on the bucket.
+//
+// - ctx: Context variables for the current API call.
+//
+// - bucketName: The bucket name identifies the bucket, it should be owned by the funder account.
+//
+// - groupName: The name of the group holding the pool accounts.
+//
+// - ret: Return error when the request failed, otherwise return nil.
+func (p *AccountPool) GrantBucketAccess(ctx context.Context, bucketName, groupName string) error {
+	if p.funder == nil {
+		return errors.New("the account pool has no funder account")
+	}
+	owner, err := p.funder.GetDefaultAccount()
+	if err != nil {
+		return err
+	}
+	ownerAddr := owner.GetAddress().String()
+
+	groupInfo, err := p.funder.HeadGroup(ctx, groupName, ownerAddr)
+	if types.IsNotFound(err) {
+		txHash, err := p.funder.CreateGroup(ctx, groupName, types.CreateGroupOptions{})
+		if err != nil {
+			return err
+		}
+		if err = p.waitFunderTx(ctx, txHash); err != nil {
+			return err
+		}
+		if groupInfo, err = p.funder.HeadGroup(ctx, groupName, ownerAddr); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	newMembers := make([]string, 0)
+	for _, m := range p.members {
+		address := m.account.GetAddress().String()
+		if !p.funder.HeadGroupMember(ctx, groupName, ownerAddr, address) {
+			newMembers = append(newMembers, address)
+		}
+	}
+	if len(newMembers) > 0 {
+		txHash, err := p.funder.UpdateGroupMember(ctx, groupName, ownerAddr, newMembers, nil, types.UpdateGroupMemberOption{})
+		if err != nil {
+			return err
+		}
+		if err = p.waitFunderTx(ctx, txHash); err != nil {
+			return err
+		}
+	}
+
+	principal, err := utils.NewPrincipalWithGroupId(groupInfo.Id.Uint64())
+	if err != nil {
+		return err
+	}
+	statement := utils.NewStatement([]permTypes.ActionType{permTypes.ACTION_CREATE_OBJECT}, permTypes.EFFECT_ALLOW, nil, types.NewStatementOptions{})
+	txHash, err := p.funder.PutBucketPolicy(ctx, bucketName, principal, []*permTypes.Statement{&statement}, types.PutPolicyOption{})
+	if err != nil {
+		return err
+	}
+	return p.waitFunderTx(ctx, txHash)
+}
+
+// Close - Close the clients of the funder and the pool accounts, which stops their background goroutines.
+//
+// - ret: Return the errors of the clients failed to be closed.
+func (p *AccountPool) Close() error {
+	var errs []error
+	if p.funder != nil {
+		errs = append(errs, p.funder.Close())
+	}
+	for _, m := range p.members {
+		errs = append(errs, m.client.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// Stats - Return the throughput statistics of the pool and of every account.
+func (p *AccountPool) Stats() types.AccountPoolStats {
+	stats := types.AccountPoolStats{
+		Accounts: make([]types.PooledAccountStats, 0, len(p.members)),
+		Elapsed:  time.Since(p.createTime),
+	}
+	for _, m := range p.members {
+		s := types.PooledAccountStats{
+			Address:      m.account.GetAddress().String(),
+			PendingTxs:   atomic.LoadInt64(&m.pending),
+			SubmittedTxs: atomic.LoadUint64(&m.submitted),
+			FailedTxs:    atomic.LoadUint64(&m.failed),
+			Objects:      atomic.LoadUint64(&m.objects),
+			Bytes:        atomic.LoadUint64(&m.bytes),
+		}
+		stats.Accounts = append(stats.Accounts, s)
+		stats.PendingTxs += s.PendingTxs
+		stats.SubmittedTxs += s.SubmittedTxs
+		stats.FailedTxs += s.FailedTxs
+		stats.Objects += s.Objects
+		stats.Bytes += s.Bytes
+	}
+	if seconds := stats.Elapsed.Seconds(); seconds > 0 {
+		stats.TxsPerSecond = float64(stats.SubmittedTxs) / seconds
+		stats.BytesPerSecond = float64(stats.Bytes) / seconds
+	}
+	return stats
+}
+
+// pick returns the next account in round-robin order whose pending txs are under the limit,
+// or the account with the least pending txs if all of them are busy.
+func (p *AccountPool) pick() *poolMember {
+	n := uint64(len(p.members))
+	start := atomic.AddUint64(&p.next, 1) - 1
+	var least *poolMember
+	for i := uint64(0); i < n; i++ {
+		m := p.members[(start+i)%n]
+		pending := atomic.LoadInt64(&m.pending)
+		if pending < p.opts.MaxPendingTxPerAccount {
+			return m
+		}
+		if least == nil || pending < atomic.LoadInt64(&least.pending) {
+			least = m
+		}
+	}
+	return least
+}
+
+func (p *AccountPool) member(account *types.Account) (*poolMember, error) {
+	if account == nil {
+		return nil, errors.New("the account is not provided")
+	}
+	for _, m := range p.members {
+		if m.account.GetAddress().Equals(account.GetAddress()) {
+			return m, nil
+		}
+	}
+	return nil, fmt.Errorf("the account %s does not belong to the pool", account.GetAddress().String())
+}
+
+// createObject broadcasts the CreateObject tx with the locally tracked nonce of the member, the returned channel
+// receives the execution result of the tx once it is included in a block.
+func (p *AccountPool) createObject(ctx context.Context, m *poolMember, bucketName, objectName string,
+	reader io.Reader, opts types.CreateObjectOptions,
+) (string, <-chan error, error) {
+	var txOpts gnfdSdkTypes.TxOption
+	if opts.TxOpts != nil {
+		txOpts = *opts.TxOpts
+	}
+	if txOpts.Mode == nil {
+		broadcastMode := tx.BroadcastMode_BROADCAST_MODE_SYNC
+		txOpts.Mode = &broadcastMode
+	}
+	opts.TxOpts = &txOpts
+	// the tx is only checked by the node before returning, its execution result is awaited below
+	opts.IsAsyncMode = true
+
+	// the tx is pending from now on, so that pick steers the concurrent uploads to the other accounts
+	atomic.AddInt64(&m.pending, 1)
+	txHash, err := m.broadcastCreateObject(ctx, bucketName, objectName, reader, opts)
+	if err != nil {
+		atomic.AddInt64(&m.pending, -1)
+		atomic.AddUint64(&m.failed, 1)
+		return "", nil, err
+	}
+
+	atomic.AddUint64(&m.submitted, 1)
+	done := make(chan error, 1)
+	go func() {
+		defer atomic.AddInt64(&m.pending, -1)
+		waitCtx, cancel := context.WithTimeout(context.Background(), types.ContextTimeout)
+		defer cancel()
+		txResult, err := m.client.WaitForTx(waitCtx, txHash)
+		if err == nil && txResult.TxResult.Code != 0 {
+			err = types.NewTxError(txResult.TxResult.Codespace, txResult.TxResult.Code, txResult.TxResult.Log,
+				"the tx %s has failed with response code: %d, codespace:%s", txHash, txResult.TxResult.Code, txResult.TxResult.Codespace)
+		}
+		if err != nil {
+			atomic.AddUint64(&m.failed, 1)
+		}
+		done <- err
+	}()
+	return txHash, done, nil
+}
+
+// broadcastCreateObject allocates the next nonce of the account and broadcasts the CreateObject tx with it, the
+// nonce is loaded from chain if it is not tracked yet. The lock of the member is held until the node has checked
+// the tx, so no later nonce of the account is in flight when the tx fails.
+func (m *poolMember) broadcastCreateObject(ctx context.Context, bucketName, objectName string,
+	reader io.Reader, opts types.CreateObjectOptions,
+) (string, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if !m.nonceLoaded {
+		account, err := m.client.GetAccount(ctx, m.account.GetAddress().String())
+		if err != nil {
+			return "", err
+		}
+		m.nonce = account.GetSequence()
+		m.nonceLoaded = true
+	}
+	opts.TxOpts.Nonce = m.nonce
+	// a non-zero response code of the sync broadcast is returned as a TxError
+	txHash, err := m.client.CreateObject(ctx, bucketName, objectName, reader, opts)
+	if err != nil {
+		// the nonce may be out of date or not consumed by the failed tx, reload it from chain for the next tx
+		m.nonceLoaded = false
+		return "", err
+	}
+	m.nonce++
+	return txHash, nil
+}
+
+func (p *AccountPool) putObject(ctx context.Context, m *poolMember, bucketName, objectName string, objectSize int64,
+	reader io.Reader, opts types.PutObjectOptions,
+) error {
+	if err := m.client.PutObject(ctx, bucketName, objectName, objectSize, reader, opts); err != nil {
+		return err
+	}
+	atomic.AddUint64(&m.objects, 1)
+	atomic.AddUint64(&m.bytes, uint64(objectSize))
+	return nil
+}
+
+func (p *AccountPool) waitFunderTx(ctx context.Context, txHash string) error {
+	waitCtx, cancel := context.WithTimeout(ctx, types.ContextTimeout)
+	defer cancel()
+	txResult, err := p.funder.WaitForTx(waitCtx, txHash)
+	if err != nil {
+		return err
+	}
+	if txResult.TxResult.Code != 0 {
+		return types.NewTxError(txResult.TxResult.Codespace, txResult.TxResult.Code, txResult.TxResult.Log,
+			"the tx %s has failed with response code: %d, codespace:%s", txHash, txResult.TxResult.Code, txResult.TxResult.Codespace)
+	}
+	return nil
+}
@@ -11,6 +11,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // IAccountClient - Client APIs for operating Greenfield accounts.
@@ -25,6 +27,7 @@ type IAccountClient interface {
 	GetModuleAccounts(ctx context.Context) ([]authTypes.ModuleAccountI, error)
 	GetModuleAccountByName(ctx context.Context, name string) (authTypes.ModuleAccountI, error)
 	GetPaymentAccountsByOwner(ctx context.Context, owner string) ([]*paymentTypes.PaymentAccount, error)
+	ScanHDWalletAccounts(ctx context.Context, wallet *types.HDWallet, opts types.ScanHDWalletOptions) ([]*types.Account, error)
 
 	CreatePaymentAccount(ctx context.Context, address string, txOption gnfdSdkTypes.TxOption) (string, error)
 	Transfer(ctx context.Context, toAddress string, amount math.Int, txOption gnfdSdkTypes.TxOption) (string, error)
@@ -34,7 +37,8 @@ type IAccountClient interface {
 // SetDefaultAccount - Set the default account of the Client.
 //
 // If you call other APIs without specifying the account, it will be assumed that you are operating on the default
-// account. This includes sending transactions and other actions.
+// account. This includes sending transactions and other actions. To use another account for a single call, set the
+// Account field of the call options instead, which does not change the default account and is safe for concurrent use.
 //
 // - account: The account to be set as the default account, should be created using a private key or a mnemonic phrase.
 func (c *Client) SetDefaultAccount(account *types.Account) {
@@ -64,6 +68,39 @@ func (c *Client) MustGetDefaultAccount() *types.Account {
 	return c.defaultAccount
 }
 
+// getSigner returns the account signing the request, the account set in the options takes precedence over the
+// default account, so that concurrent calls can be signed by different accounts without calling SetDefaultAccount.
+func (c *Client) getSigner(account *types.Account) (*types.Account, error) {
+	if account != nil {
+		return account, nil
+	}
+	return c.GetDefaultAccount()
+}
+
+// getTxSignerAddr returns the address of the account signing the tx, which is the OverrideKeyManager of the tx
+// option if it is set, otherwise the default account.
+func (c *Client) getTxSignerAddr(txOpt *gnfdSdkTypes.TxOption) (sdk.AccAddress, error) {
+	if txOpt != nil && txOpt.OverrideKeyManager != nil {
+		return (*txOpt.OverrideKeyManager).GetAddr(), nil
+	}
+	account, err := c.GetDefaultAccount()
+	if err != nil {
+		return nil, err
+	}
+	return account.GetAddress(), nil
+}
+
+// signerTxOpts returns a copy of the tx option whose key manager is overridden by the signer account.
+func signerTxOpts(txOpt *gnfdSdkTypes.TxOption, signer *types.Account) *gnfdSdkTypes.TxOption {
+	var opt gnfdSdkTypes.TxOption
+	if txOpt != nil {
+		opt = *txOpt
+	}
+	km := signer.GetKeyManager()
+	opt.OverrideKeyManager = &km
+	return &opt
+}
+
 // GetAccount - Retrieve on-chain account information for a given address.
 //
 // - ctx: Context variables for the current API call.
@@ -73,7 +110,10 @@ func (c *Client) MustGetDefaultAccount() *types.Account {
 // - ret1: The account interface for the given address.
 //
 // - ret2: Return error when getting account failed, otherwise return nil.
-func (c *Client) GetAccount(ctx context.Context, address string) (authTypes.AccountI, error) {
+func (c *Client) GetAccount(ctx context.Context, address string) (_ authTypes.AccountI, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetAccount")
+	defer func() { endSpan(span, err) }()
+
 	accAddress, err := sdk.AccAddressFromHexUnsafe(address)
 	if err != nil {
 		return nil, err
@@ -113,7 +153,10 @@ func (c *Client) GetAccount(ctx context.Context, address string) (authTypes.Acco
 // - ret1: Return the transaction hash if created successfully, otherwise return empty string.
 //
 // - ret2: Return error when created failed, otherwise return nil.
-func (c *Client) CreatePaymentAccount(ctx context.Context, address string, txOption gnfdSdkTypes.TxOption) (string, error) {
+func (c *Client) CreatePaymentAccount(ctx context.Context, address string, txOption gnfdSdkTypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.CreatePaymentAccount")
+	defer func() { endSpan(span, err) }()
+
 	accAddress, err := sdk.AccAddressFromHexUnsafe(address)
 	if err != nil {
 		return "", err
@@ -135,7 +178,10 @@ func (c *Client) CreatePaymentAccount(ctx context.Context, address string, txOpt
 // - ret1: The account interface for the given module name.
 //
 // - ret2: Return error when getting failed, otherwise return nil.
-func (c *Client) GetModuleAccountByName(ctx context.Context, name string) (authTypes.ModuleAccountI, error) {
+func (c *Client) GetModuleAccountByName(ctx context.Context, name string) (_ authTypes.ModuleAccountI, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetModuleAccountByName")
+	defer func() { endSpan(span, err) }()
+
 	response, err := c.chainClient.ModuleAccountByName(ctx, &authTypes.QueryModuleAccountByNameRequest{Name: name})
 	if err != nil {
 		return nil, err
@@ -159,7 +205,10 @@ func (c *Client) GetModuleAccountByName(ctx context.Context, name string) (authT
 // - ret1: The account interface lists for all the module accounts.
 //
 // - ret2: Return error when getting failed, otherwise return nil.
-func (c *Client) GetModuleAccounts(ctx context.Context) ([]authTypes.ModuleAccountI, error) {
+func (c *Client) GetModuleAccounts(ctx context.Context) (_ []authTypes.ModuleAccountI, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetModuleAccounts")
+	defer func() { endSpan(span, err) }()
+
 	response, err := c.chainClient.ModuleAccounts(ctx, &authTypes.QueryModuleAccountsRequest{})
 	if err != nil {
 		return nil, err
@@ -186,7 +235,10 @@ func (c *Client) GetModuleAccounts(ctx context.Context) ([]authTypes.ModuleAccou
 // - ret1: The balance info for the given address, in sdk.Coin format.
 //
 // - ret2: Return error when getting failed, otherwise return nil.
-func (c *Client) GetAccountBalance(ctx context.Context, address string) (*sdk.Coin, error) {
+func (c *Client) GetAccountBalance(ctx context.Context, address string) (_ *sdk.Coin, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetAccountBalance")
+	defer func() { endSpan(span, err) }()
+
 	accAddress, err := sdk.AccAddressFromHexUnsafe(address)
 	if err != nil {
 		return nil, err
@@ -208,7 +260,10 @@ func (c *Client) GetAccountBalance(ctx context.Context, address string) (*sdk.Co
 // - ret1: The payment account info for the given address.
 //
 // - ret2: Return error when getting failed, otherwise return nil.
-func (c *Client) GetPaymentAccount(ctx context.Context, address string) (*paymentTypes.PaymentAccount, error) {
+func (c *Client) GetPaymentAccount(ctx context.Context, address string) (_ *paymentTypes.PaymentAccount, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetPaymentAccount")
+	defer func() { endSpan(span, err) }()
+
 	accAddress, err := sdk.AccAddressFromHexUnsafe(address)
 	if err != nil {
 		return nil, err
@@ -229,7 +284,10 @@ func (c *Client) GetPaymentAccount(ctx context.Context, address string) (*paymen
 // - ret1: The payment accounts list for the given owner address.
 //
 // - ret2: Return error when getting failed, otherwise return nil.
-func (c *Client) GetPaymentAccountsByOwner(ctx context.Context, owner string) ([]*paymentTypes.PaymentAccount, error) {
+func (c *Client) GetPaymentAccountsByOwner(ctx context.Context, owner string) (_ []*paymentTypes.PaymentAccount, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetPaymentAccountsByOwner")
+	defer func() { endSpan(span, err) }()
+
 	ownerAcc, err := sdk.AccAddressFromHexUnsafe(owner)
 	if err != nil {
 		return nil, err
@@ -271,12 +329,19 @@ func (c *Client) GetPaymentAccountsByOwner(ctx context.Context, owner string) ([
 // - ret1: Return the transaction hash if transferred successfully, otherwise return empty string.
 //
 // - ret2: Return error if transferred failed, otherwise return nil.
-func (c *Client) Transfer(ctx context.Context, toAddress string, amount math.Int, txOption gnfdSdkTypes.TxOption) (string, error) {
+func (c *Client) Transfer(ctx context.Context, toAddress string, amount math.Int, txOption gnfdSdkTypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.Transfer")
+	defer func() { endSpan(span, err) }()
+
 	toAddr, err := sdk.AccAddressFromHexUnsafe(toAddress)
 	if err != nil {
 		return "", err
 	}
-	msgSend := bankTypes.NewMsgSend(c.MustGetDefaultAccount().GetAddress(), toAddr, sdk.Coins{sdk.Coin{Denom: gnfdSdkTypes.Denom, Amount: amount}})
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
+	msgSend := bankTypes.NewMsgSend(sender, toAddr, sdk.Coins{sdk.Coin{Denom: gnfdSdkTypes.Denom, Amount: amount}})
 	tx, err := c.BroadcastTx(ctx, []sdk.Msg{msgSend}, &txOption)
 	if err != nil {
 		return "", err
@@ -295,7 +360,14 @@ func (c *Client) Transfer(ctx context.Context, toAddress string, amount math.Int
 // - ret1: Return the transaction hash if transferred successfully, otherwise return empty string.
 //
 // - ret2: Return error if transferred failed, otherwise return nil.
-func (c *Client) MultiTransfer(ctx context.Context, details []types.TransferDetail, txOption gnfdSdkTypes.TxOption) (string, error) {
+func (c *Client) MultiTransfer(ctx context.Context, details []types.TransferDetail, txOption gnfdSdkTypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.MultiTransfer")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
 	outputs := make([]bankTypes.Output, 0)
 	denom := gnfdSdkTypes.Denom
 	sum := math.NewInt(0)
@@ -314,7 +386,7 @@ func (c *Client) MultiTransfer(ctx context.Context, details []types.TransferDeta
 		sum = sum.Add(details[i].Amount)
 	}
 	in := bankTypes.Input{
-		Address: c.MustGetDefaultAccount().GetAddress().String(),
+		Address: sender.String(),
 		Coins:   []sdk.Coin{{Denom: denom, Amount: sum}},
 	}
 	msg := &bankTypes.MsgMultiSend{
@@ -327,3 +399,69 @@ func (c *Client) MultiTransfer(ctx context.Context, details []types.TransferDeta
 	}
 	return tx.TxResponse.TxHash, nil
 }
+
+// ScanHDWalletAccounts - Scan the chain to find the accounts of the HD wallet which are already in use.
+//
+// Accounts are derived from opts.StartIndex one by one, an account is in use if it exists on chain or holds balance.
+// The scan stops once opts.GapLimit consecutive accounts are found unused, or the account with
+// the max index types.MaxHDWalletIndex is scanned.
+//
+// - ctx: Context variables for the current API call.
+//
+// - wallet: The HD wallet to derive accounts from.
+//
+// - opts: The options to set the start index and gap limit of the scan.
+//
+// - ret1: The accounts in use, ordered by index.
+//
+// - ret2: Return error when the scan failed, otherwise return nil.
+func (c *Client) ScanHDWalletAccounts(ctx context.Context, wallet *types.HDWallet, opts types.ScanHDWalletOptions) (_ []*types.Account, err error) {
+	ctx, span := c.startSpan(ctx, "client.ScanHDWalletAccounts")
+	defer func() { endSpan(span, err) }()
+
+	if wallet == nil {
+		return nil, fmt.Errorf("hd wallet is nil")
+	}
+	gapLimit := opts.GapLimit
+	if gapLimit == 0 {
+		gapLimit = types.DefaultHDWalletGapLimit
+	}
+
+	usedAccounts := make([]*types.Account, 0)
+	for index, gap := opts.StartIndex, uint32(0); gap < gapLimit; index++ {
+		account, err := wallet.Derive(index)
+		if err != nil {
+			return nil, err
+		}
+		used, err := c.isAccountInUse(ctx, account.GetAddress().String())
+		if err != nil {
+			return nil, err
+		}
+		if used {
+			usedAccounts = append(usedAccounts, account)
+			gap = 0
+		} else {
+			gap++
+		}
+		if index == types.MaxHDWalletIndex {
+			break
+		}
+	}
+	return usedAccounts, nil
+}
+
+// isAccountInUse checks if the account exists on chain or holds balance.
+func (c *Client) isAccountInUse(ctx context.Context, address string) (bool, error) {
+	_, err := c.GetAccount(ctx, address)
+	if err == nil {
+		return true, nil
+	}
+	if status.Code(err) != codes.NotFound {
+		return false, err
+	}
+	balance, err := c.GetAccountBalance(ctx, address)
+	if err != nil {
+		return false, err
+	}
+	return balance != nil && balance.Amount.IsPositive(), nil
+}
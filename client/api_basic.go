@@ -20,12 +20,14 @@ import (
 
 	gosdktypes "github.com/bnb-chain/greenfield-go-sdk/types"
 	"github.com/bnb-chain/greenfield/sdk/types"
+	resourcetypes "github.com/bnb-chain/greenfield/types/resource"
 	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
 )
 
 // IBasicClient interface defines basic functions of greenfield Client.
 type IBasicClient interface {
 	EnableTrace(outputStream io.Writer, onlyTraceErr bool)
+	Close() error
 
 	GetNodeInfo(ctx context.Context) (*p2p.DefaultNodeInfo, *tmservice.VersionInfo, error)
 	GetStatus(ctx context.Context) (*ctypes.ResultStatus, error)
@@ -33,6 +35,9 @@ type IBasicClient interface {
 	GetLatestBlockHeight(ctx context.Context) (int64, error)
 	GetLatestBlock(ctx context.Context) (*bfttypes.Block, error)
 	GetSyncing(ctx context.Context) (bool, error)
+	GetRPCEndpointsStatus() ([]RPCEndpointStatus, error)
+	GetCacheStats() (gosdktypes.CacheStats, error)
+	InvalidateBucketCache(bucketName string)
 	GetBlockByHeight(ctx context.Context, height int64) (*bfttypes.Block, error)
 	GetBlockResultByHeight(ctx context.Context, height int64) (*ctypes.ResultBlockResults, error)
 
@@ -75,7 +80,10 @@ func (c *Client) EnableTrace(output io.Writer, onlyTraceErr bool) {
 // - ret2: The Version info.
 //
 // - ret3: Return error when the request failed, otherwise return nil.
-func (c *Client) GetNodeInfo(ctx context.Context) (*p2p.DefaultNodeInfo, *tmservice.VersionInfo, error) {
+func (c *Client) GetNodeInfo(ctx context.Context) (_ *p2p.DefaultNodeInfo, _ *tmservice.VersionInfo, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetNodeInfo")
+	defer func() { endSpan(span, err) }()
+
 	nodeInfoResponse, err := c.chainClient.TmClient.GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{})
 	if err != nil {
 		return nil, nil, err
@@ -90,7 +98,10 @@ func (c *Client) GetNodeInfo(ctx context.Context) (*p2p.DefaultNodeInfo, *tmserv
 // - ret1: The detail of Node status.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetStatus(ctx context.Context) (*ctypes.ResultStatus, error) {
+func (c *Client) GetStatus(ctx context.Context) (_ *ctypes.ResultStatus, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetStatus")
+	defer func() { endSpan(span, err) }()
+
 	return c.chainClient.GetStatus(ctx)
 }
 
@@ -103,7 +114,10 @@ func (c *Client) GetStatus(ctx context.Context) (*ctypes.ResultStatus, error) {
 // - ret1: The commit result.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetCommit(ctx context.Context, height int64) (*ctypes.ResultCommit, error) {
+func (c *Client) GetCommit(ctx context.Context, height int64) (_ *ctypes.ResultCommit, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetCommit")
+	defer func() { endSpan(span, err) }()
+
 	return c.chainClient.GetCommit(ctx, height)
 }
 
@@ -118,7 +132,10 @@ func (c *Client) GetCommit(ctx context.Context, height int64) (*ctypes.ResultCom
 // - ret1: Transaction response, it can indicate both success and failed transaction.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) BroadcastRawTx(ctx context.Context, txBytes []byte, sync bool) (*sdk.TxResponse, error) {
+func (c *Client) BroadcastRawTx(ctx context.Context, txBytes []byte, sync bool) (_ *sdk.TxResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.BroadcastRawTx")
+	defer func() { endSpan(span, err) }()
+
 	var mode tx.BroadcastMode
 	if sync {
 		mode = tx.BroadcastMode_BROADCAST_MODE_SYNC
@@ -143,7 +160,10 @@ func (c *Client) BroadcastRawTx(ctx context.Context, txBytes []byte, sync bool)
 // - ret1: The simulation result.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) SimulateRawTx(ctx context.Context, txBytes []byte, opts ...grpc.CallOption) (*tx.SimulateResponse, error) {
+func (c *Client) SimulateRawTx(ctx context.Context, txBytes []byte, opts ...grpc.CallOption) (_ *tx.SimulateResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.SimulateRawTx")
+	defer func() { endSpan(span, err) }()
+
 	simulateResponse, err := c.chainClient.TxClient.Simulate(
 		ctx,
 		&tx.SimulateRequest{
@@ -164,7 +184,10 @@ func (c *Client) SimulateRawTx(ctx context.Context, txBytes []byte, opts ...grpc
 // - ret1: The block result.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetLatestBlock(ctx context.Context) (*bfttypes.Block, error) {
+func (c *Client) GetLatestBlock(ctx context.Context) (_ *bfttypes.Block, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetLatestBlock")
+	defer func() { endSpan(span, err) }()
+
 	res, err := c.chainClient.GetBlock(ctx, nil)
 	if err != nil {
 		return nil, err
@@ -179,7 +202,10 @@ func (c *Client) GetLatestBlock(ctx context.Context) (*bfttypes.Block, error) {
 // - ret1: The block height.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetLatestBlockHeight(ctx context.Context) (int64, error) {
+func (c *Client) GetLatestBlockHeight(ctx context.Context) (_ int64, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetLatestBlockHeight")
+	defer func() { endSpan(span, err) }()
+
 	resp, err := c.chainClient.GetStatus(ctx)
 	if err != nil {
 		return 0, nil
@@ -192,7 +218,10 @@ func (c *Client) GetLatestBlockHeight(ctx context.Context) (int64, error) {
 // - ctx: Context variables for the current API call.
 //
 // - ret: Return error when the request failed, otherwise return nil.
-func (c *Client) WaitForBlockHeight(ctx context.Context, h int64) error {
+func (c *Client) WaitForBlockHeight(ctx context.Context, h int64) (err error) {
+	ctx, span := c.startSpan(ctx, "client.WaitForBlockHeight")
+	defer func() { endSpan(span, err) }()
+
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 
@@ -217,7 +246,10 @@ func (c *Client) WaitForBlockHeight(ctx context.Context, h int64) error {
 // - ctx: Context variables for the current API call.
 //
 // - ret: Return error when the request failed, otherwise return nil.
-func (c *Client) WaitForNextBlock(ctx context.Context) error {
+func (c *Client) WaitForNextBlock(ctx context.Context) (err error) {
+	ctx, span := c.startSpan(ctx, "client.WaitForNextBlock")
+	defer func() { endSpan(span, err) }()
+
 	return c.WaitForNBlocks(ctx, 1)
 }
 
@@ -228,7 +260,10 @@ func (c *Client) WaitForNextBlock(ctx context.Context) error {
 // - n: number of blocks to be waited.
 //
 // - ret: Return error when the request failed, otherwise return nil.
-func (c *Client) WaitForNBlocks(ctx context.Context, n int64) error {
+func (c *Client) WaitForNBlocks(ctx context.Context, n int64) (err error) {
+	ctx, span := c.startSpan(ctx, "client.WaitForNBlocks")
+	defer func() { endSpan(span, err) }()
+
 	start, err := c.GetLatestBlock(ctx)
 	if err != nil {
 		return err
@@ -245,7 +280,10 @@ func (c *Client) WaitForNBlocks(ctx context.Context, n int64) error {
 // - ret1: The transaction result details.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) WaitForTx(ctx context.Context, hash string) (*ctypes.ResultTx, error) {
+func (c *Client) WaitForTx(ctx context.Context, hash string) (_ *ctypes.ResultTx, err error) {
+	ctx, span := c.startSpan(ctx, "client.WaitForTx", attrTxHash.String(hash))
+	defer func() { endSpan(span, err) }()
+
 	for {
 		var (
 			txResponse *ctypes.ResultTx
@@ -284,6 +322,11 @@ func (c *Client) WaitForTx(ctx context.Context, hash string) (*ctypes.ResultTx,
 			continue
 		}
 		// Tx found
+		result := "success"
+		if txResponse.TxResult.Code != 0 {
+			result = "failed"
+		}
+		c.metrics.txGasUsed.Observe(float64(txResponse.TxResult.GasUsed), result)
 		return txResponse, nil
 	}
 }
@@ -301,7 +344,10 @@ func (c *Client) WaitForTx(ctx context.Context, hash string) (*ctypes.ResultTx,
 // - ret1: transaction response, it can indicate both success and failed transaction.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) BroadcastTx(ctx context.Context, msgs []sdk.Msg, txOpt *types.TxOption, opts ...grpc.CallOption) (*tx.BroadcastTxResponse, error) {
+func (c *Client) BroadcastTx(ctx context.Context, msgs []sdk.Msg, txOpt *types.TxOption, opts ...grpc.CallOption) (_ *tx.BroadcastTxResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.BroadcastTx")
+	defer func() { endSpan(span, err) }()
+
 	if len(msgs) == 0 {
 		return nil, fmt.Errorf("msg is not provided in the transaction")
 	}
@@ -312,11 +358,16 @@ func (c *Client) BroadcastTx(ctx context.Context, msgs []sdk.Msg, txOpt *types.T
 	}
 	resp, err := c.chainClient.BroadcastTx(ctx, msgs, txOpt, opts...)
 	if err != nil {
+		c.metrics.txBroadcasts.Add(1, "error")
 		return nil, err
 	}
+	span.SetAttributes(attrTxHash.String(resp.TxResponse.TxHash))
 	if resp.TxResponse.Code != 0 {
-		return resp, fmt.Errorf("the tx has failed with response code: %d, codespace:%s", resp.TxResponse.Code, resp.TxResponse.Codespace)
+		c.metrics.txBroadcasts.Add(1, "failed")
+		return resp, gosdktypes.NewTxError(resp.TxResponse.Codespace, resp.TxResponse.Code, resp.TxResponse.RawLog,
+			"the tx has failed with response code: %d, codespace:%s", resp.TxResponse.Code, resp.TxResponse.Codespace)
 	}
+	c.metrics.txBroadcasts.Add(1, "success")
 	return resp, nil
 }
 
@@ -333,7 +384,10 @@ func (c *Client) BroadcastTx(ctx context.Context, msgs []sdk.Msg, txOpt *types.T
 // - ret1: The simulation result.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) SimulateTx(ctx context.Context, msgs []sdk.Msg, txOpt types.TxOption, opts ...grpc.CallOption) (*tx.SimulateResponse, error) {
+func (c *Client) SimulateTx(ctx context.Context, msgs []sdk.Msg, txOpt types.TxOption, opts ...grpc.CallOption) (_ *tx.SimulateResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.SimulateTx")
+	defer func() { endSpan(span, err) }()
+
 	return c.chainClient.SimulateTx(ctx, msgs, &txOpt, opts...)
 }
 
@@ -344,7 +398,10 @@ func (c *Client) SimulateTx(ctx context.Context, msgs []sdk.Msg, txOpt types.TxO
 // - ret1: The boolean value which indicates whether the node has caught up the latest block.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetSyncing(ctx context.Context) (bool, error) {
+func (c *Client) GetSyncing(ctx context.Context) (_ bool, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetSyncing")
+	defer func() { endSpan(span, err) }()
+
 	syncing, err := c.chainClient.GetSyncing(ctx, &tmservice.GetSyncingRequest{})
 	if err != nil {
 		return false, err
@@ -361,7 +418,10 @@ func (c *Client) GetSyncing(ctx context.Context) (bool, error) {
 // - ret1: The boolean value which indicates whether the node has caught up the latest block.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetBlockByHeight(ctx context.Context, height int64) (*bfttypes.Block, error) {
+func (c *Client) GetBlockByHeight(ctx context.Context, height int64) (_ *bfttypes.Block, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetBlockByHeight")
+	defer func() { endSpan(span, err) }()
+
 	blockByHeight, err := c.chainClient.GetBlock(ctx, &height)
 	if err != nil {
 		return nil, err
@@ -378,7 +438,10 @@ func (c *Client) GetBlockByHeight(ctx context.Context, height int64) (*bfttypes.
 // - ret1: The boolean value which indicates whether the node has caught up the latest block.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetBlockResultByHeight(ctx context.Context, height int64) (*ctypes.ResultBlockResults, error) {
+func (c *Client) GetBlockResultByHeight(ctx context.Context, height int64) (_ *ctypes.ResultBlockResults, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetBlockResultByHeight")
+	defer func() { endSpan(span, err) }()
+
 	return c.chainClient.GetBlockResults(ctx, &height)
 }
 
@@ -391,7 +454,10 @@ func (c *Client) GetBlockResultByHeight(ctx context.Context, height int64) (*cty
 // - ret2: The list of validators.
 //
 // - ret3: Return error when the request failed, otherwise return nil.
-func (c *Client) GetValidatorSet(ctx context.Context) (int64, []*bfttypes.Validator, error) {
+func (c *Client) GetValidatorSet(ctx context.Context) (_ int64, _ []*bfttypes.Validator, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetValidatorSet")
+	defer func() { endSpan(span, err) }()
+
 	validatorSetResponse, err := c.chainClient.GetValidators(ctx, nil)
 	if err != nil {
 		return 0, nil, err
@@ -408,7 +474,10 @@ func (c *Client) GetValidatorSet(ctx context.Context) (int64, []*bfttypes.Valida
 // - ret1: The list of validators.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetValidatorsByHeight(ctx context.Context, height int64) ([]*bfttypes.Validator, error) {
+func (c *Client) GetValidatorsByHeight(ctx context.Context, height int64) (_ []*bfttypes.Validator, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetValidatorsByHeight")
+	defer func() { endSpan(span, err) }()
+
 	validatorSetResponse, err := c.chainClient.GetValidators(ctx, &height)
 	if err != nil {
 		return nil, err
@@ -423,7 +492,10 @@ func (c *Client) GetValidatorsByHeight(ctx context.Context, height int64) ([]*bf
 // - vote: Contains vote details.
 //
 // - ret: Return error when the request failed, otherwise return nil.
-func (c *Client) BroadcastVote(ctx context.Context, vote votepool.Vote) error {
+func (c *Client) BroadcastVote(ctx context.Context, vote votepool.Vote) (err error) {
+	ctx, span := c.startSpan(ctx, "client.BroadcastVote")
+	defer func() { endSpan(span, err) }()
+
 	return c.chainClient.BroadcastVote(ctx, vote)
 }
 
@@ -438,7 +510,10 @@ func (c *Client) BroadcastVote(ctx context.Context, vote votepool.Vote) error {
 // - ret1: The vote result
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) QueryVote(ctx context.Context, eventType int, eventHash []byte) (*ctypes.ResultQueryVote, error) {
+func (c *Client) QueryVote(ctx context.Context, eventType int, eventHash []byte) (_ *ctypes.ResultQueryVote, err error) {
+	ctx, span := c.startSpan(ctx, "client.QueryVote")
+	defer func() { endSpan(span, err) }()
+
 	return c.chainClient.QueryVote(ctx, eventType, eventHash)
 }
 
@@ -448,7 +523,7 @@ func (c *Client) QueryVote(ctx context.Context, eventType int, eventHash []byte)
 //
 // - ctx: Context variables for the current API call.
 //
-// - resourceGRN: The GRN of resource that needs to set tags
+// - resourceGRN: The GRN of resource that needs to set tags, e.g. types.NewBucketResource(bucketName).String()
 //
 // - tags: the tags to be set for the given resource
 //
@@ -457,9 +532,20 @@ func (c *Client) QueryVote(ctx context.Context, eventType int, eventHash []byte)
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error if SetTag failed, otherwise return nil.
-func (c *Client) SetTag(ctx context.Context, resourceGRN string, tags storageTypes.ResourceTags, opts gosdktypes.SetTagsOptions) (string, error) {
-	msgSetTag := storageTypes.NewMsgSetTag(c.MustGetDefaultAccount().GetAddress(), resourceGRN, &tags)
-	resp, err := c.BroadcastTx(ctx, []sdk.Msg{msgSetTag}, opts.TxOpts)
+func (c *Client) SetTag(ctx context.Context, resourceGRN string, tags storageTypes.ResourceTags, opts gosdktypes.SetTagsOptions) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.SetTag")
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opts.Account)
+	if err != nil {
+		return "", err
+	}
+	// the tags of a bucket are part of its cached info
+	if resource, err := gosdktypes.ParseResource(resourceGRN, false); err == nil && resource.Type == resourcetypes.RESOURCE_TYPE_BUCKET {
+		defer c.cache.invalidateBucket(resource.BucketName)
+	}
+	msgSetTag := storageTypes.NewMsgSetTag(signer.GetAddress(), resourceGRN, &tags)
+	resp, err := c.BroadcastTx(ctx, []sdk.Msg{msgSetTag}, signerTxOpts(opts.TxOpts, signer))
 	if err != nil {
 		return "", err
 	}
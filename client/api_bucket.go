@@ -26,6 +26,7 @@ import (
 
 	gnfdsdk "github.com/bnb-chain/greenfield/sdk/types"
 	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	resourcetypes "github.com/bnb-chain/greenfield/types/resource"
 	"github.com/bnb-chain/greenfield/types/s3util"
 	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
 	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
@@ -47,6 +48,8 @@ type IBucketClient interface {
 	DeleteBucketPolicy(ctx context.Context, bucketName string, principal types.Principal, opt types.DeletePolicyOption) (string, error)
 	GetBucketPolicy(ctx context.Context, bucketName string, principalAddr string) (*permTypes.Policy, error)
 	IsBucketPermissionAllowed(ctx context.Context, userAddr string, bucketName string, action permTypes.ActionType) (permTypes.Effect, error)
+	ApplyPolicyTemplate(ctx context.Context, bucketName string, principal types.Principal, template types.PolicyTemplate, templateOpts types.PolicyTemplateOptions, opt types.PutPolicyOption) (string, error)
+	AuditBucketPermissions(ctx context.Context, bucketName string, opts types.AuditBucketPermissionsOptions) (*types.PermissionAuditReport, error)
 	ListBuckets(ctx context.Context, opts types.ListBucketsOptions) (types.ListBucketsResult, error)
 	ListBucketReadRecord(ctx context.Context, bucketName string, opts types.ListReadRecordOptions) (types.QuotaRecordInfo, error)
 	GetQuotaUpdateTime(ctx context.Context, bucketName string) (int64, error)
@@ -72,7 +75,15 @@ type IBucketClient interface {
 // - ret1: The msg of create bucket which contain the approval signature from the storage provider
 //
 // - ret2: Return error when get approval failed, otherwise return nil.
-func (c *Client) GetCreateBucketApproval(ctx context.Context, createBucketMsg *storageTypes.MsgCreateBucket) (*storageTypes.MsgCreateBucket, error) {
+func (c *Client) GetCreateBucketApproval(ctx context.Context, createBucketMsg *storageTypes.MsgCreateBucket) (_ *storageTypes.MsgCreateBucket, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetCreateBucketApproval")
+	defer func() { endSpan(span, err) }()
+
+	return c.getCreateBucketApproval(ctx, createBucketMsg, nil)
+}
+
+// getCreateBucketApproval sends the approval request signed by the signer, the default account is used if it is nil.
+func (c *Client) getCreateBucketApproval(ctx context.Context, createBucketMsg *storageTypes.MsgCreateBucket, signer *types.Account) (*storageTypes.MsgCreateBucket, error) {
 	unsignedBytes := createBucketMsg.GetSignBytes()
 
 	// set the action type
@@ -84,6 +95,7 @@ func (c *Client) GetCreateBucketApproval(ctx context.Context, createBucketMsg *s
 		urlRelPath:    "get-approval",
 		contentSHA256: types.EmptyStringSHA256,
 		txnMsg:        hex.EncodeToString(unsignedBytes),
+		account:       signer,
 	}
 
 	sendOpt := sendOptions{
@@ -138,7 +150,14 @@ func (c *Client) GetCreateBucketApproval(ctx context.Context, createBucketMsg *s
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error if create bucket failed, otherwise return nil.
-func (c *Client) CreateBucket(ctx context.Context, bucketName string, primaryAddr string, opts types.CreateBucketOptions) (string, error) {
+func (c *Client) CreateBucket(ctx context.Context, bucketName string, primaryAddr string, opts types.CreateBucketOptions) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.CreateBucket", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opts.Account)
+	if err != nil {
+		return "", err
+	}
 	address, err := sdk.AccAddressFromHexUnsafe(primaryAddr)
 	if err != nil {
 		return "", err
@@ -159,7 +178,7 @@ func (c *Client) CreateBucket(ctx context.Context, bucketName string, primaryAdd
 		}
 	}
 
-	createBucketMsg := storageTypes.NewMsgCreateBucket(c.MustGetDefaultAccount().GetAddress(), bucketName, visibility, address, paymentAddr, 0, nil, opts.ChargedQuota)
+	createBucketMsg := storageTypes.NewMsgCreateBucket(signer.GetAddress(), bucketName, visibility, address, paymentAddr, 0, nil, opts.ChargedQuota)
 
 	err = createBucketMsg.ValidateBasic()
 	if err != nil {
@@ -180,7 +199,7 @@ func (c *Client) CreateBucket(ctx context.Context, bucketName string, primaryAdd
 	if err != nil {
 		log.Error().Msg(fmt.Sprintf("failed to query sp vgf:  %s", err.Error()))
 		var signedMsg *storageTypes.MsgCreateBucket
-		signedMsg, err = c.GetCreateBucketApproval(ctx, createBucketMsg)
+		signedMsg, err = c.getCreateBucketApproval(ctx, createBucketMsg, signer)
 		if err != nil {
 			return "", err
 		}
@@ -199,10 +218,10 @@ func (c *Client) CreateBucket(ctx context.Context, bucketName string, primaryAdd
 	if opts.Tags != nil {
 		// Set tag
 		grn := gnfdTypes.NewBucketGRN(bucketName)
-		msgSetTag := storageTypes.NewMsgSetTag(c.MustGetDefaultAccount().GetAddress(), grn.String(), opts.Tags)
+		msgSetTag := storageTypes.NewMsgSetTag(signer.GetAddress(), grn.String(), opts.Tags)
 		msgs = append(msgs, msgSetTag)
 	}
-	resp, err := c.BroadcastTx(ctx, msgs, opts.TxOpts)
+	resp, err := c.BroadcastTx(ctx, msgs, signerTxOpts(opts.TxOpts, signer))
 	if err != nil {
 		return "", err
 	}
@@ -215,7 +234,8 @@ func (c *Client) CreateBucket(ctx context.Context, bucketName string, primaryAdd
 			return txnHash, fmt.Errorf("the transaction has been submitted, please check it later:%v", err)
 		}
 		if txnResponse.TxResult.Code != 0 {
-			return txnHash, fmt.Errorf("the createBucket txn has failed with response code: %d, codespace:%s", txnResponse.TxResult.Code, txnResponse.TxResult.Codespace)
+			return txnHash, types.NewTxError(txnResponse.TxResult.Codespace, txnResponse.TxResult.Code, txnResponse.TxResult.Log,
+				"the createBucket txn has failed with response code: %d, codespace:%s", txnResponse.TxResult.Code, txnResponse.TxResult.Codespace)
 		}
 	}
 	return txnHash, nil
@@ -232,12 +252,21 @@ func (c *Client) CreateBucket(ctx context.Context, bucketName string, primaryAdd
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error if delete bucket failed, otherwise return nil.
-func (c *Client) DeleteBucket(ctx context.Context, bucketName string, opt types.DeleteBucketOption) (string, error) {
+func (c *Client) DeleteBucket(ctx context.Context, bucketName string, opt types.DeleteBucketOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.DeleteBucket", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
 	if err := s3util.CheckValidBucketName(bucketName); err != nil {
 		return "", err
 	}
-	delBucketMsg := storageTypes.NewMsgDeleteBucket(c.MustGetDefaultAccount().GetAddress(), bucketName)
-	return c.sendTxn(ctx, delBucketMsg, opt.TxOpts)
+	defer c.cache.invalidateBucket(bucketName)
+
+	delBucketMsg := storageTypes.NewMsgDeleteBucket(signer.GetAddress(), bucketName)
+	return c.sendTxn(ctx, delBucketMsg, signerTxOpts(opt.TxOpts, signer))
 }
 
 // UpdateBucketVisibility - Update the visibilityType of bucket.
@@ -255,8 +284,18 @@ func (c *Client) DeleteBucket(ctx context.Context, bucketName string, opt types.
 // - ret2: Return error if update visibility failed, otherwise return nil.
 func (c *Client) UpdateBucketVisibility(ctx context.Context, bucketName string,
 	visibility storageTypes.VisibilityType, opt types.UpdateVisibilityOption,
-) (string, error) {
-	bucketInfo, err := c.HeadBucket(ctx, bucketName)
+) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.UpdateBucketVisibility", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	defer c.cache.invalidateBucket(bucketName)
+
+	// query the latest bucket info from the chain since it is modified based on it
+	bucketInfo, err := c.headBucket(ctx, bucketName)
 	if err != nil {
 		return "", err
 	}
@@ -266,8 +305,8 @@ func (c *Client) UpdateBucketVisibility(ctx context.Context, bucketName string,
 		return "", err
 	}
 
-	updateBucketMsg := storageTypes.NewMsgUpdateBucketInfo(c.MustGetDefaultAccount().GetAddress(), bucketName, &bucketInfo.ChargedReadQuota, paymentAddr, visibility)
-	return c.sendTxn(ctx, updateBucketMsg, opt.TxOpts)
+	updateBucketMsg := storageTypes.NewMsgUpdateBucketInfo(signer.GetAddress(), bucketName, &bucketInfo.ChargedReadQuota, paymentAddr, visibility)
+	return c.sendTxn(ctx, updateBucketMsg, signerTxOpts(opt.TxOpts, signer))
 }
 
 // UpdateBucketPaymentAddr - Update the payment address of bucket. It will send the MsgUpdateBucketInfo msg to greenfield to update the meta.
@@ -285,14 +324,24 @@ func (c *Client) UpdateBucketVisibility(ctx context.Context, bucketName string,
 // - ret2: Return error if update payment address failed, otherwise return nil.
 func (c *Client) UpdateBucketPaymentAddr(ctx context.Context, bucketName string,
 	paymentAddr sdk.AccAddress, opt types.UpdatePaymentOption,
-) (string, error) {
-	bucketInfo, err := c.HeadBucket(ctx, bucketName)
+) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.UpdateBucketPaymentAddr", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
 	if err != nil {
 		return "", err
 	}
+	defer c.cache.invalidateBucket(bucketName)
 
-	updateBucketMsg := storageTypes.NewMsgUpdateBucketInfo(c.MustGetDefaultAccount().GetAddress(), bucketName, &bucketInfo.ChargedReadQuota, paymentAddr, bucketInfo.Visibility)
-	return c.sendTxn(ctx, updateBucketMsg, opt.TxOpts)
+	// query the latest bucket info from the chain since it is modified based on it
+	bucketInfo, err := c.headBucket(ctx, bucketName)
+	if err != nil {
+		return "", err
+	}
+
+	updateBucketMsg := storageTypes.NewMsgUpdateBucketInfo(signer.GetAddress(), bucketName, &bucketInfo.ChargedReadQuota, paymentAddr, bucketInfo.Visibility)
+	return c.sendTxn(ctx, updateBucketMsg, signerTxOpts(opt.TxOpts, signer))
 }
 
 // SetBucketFlowRateLimit - Set the flow rate limit of the bucket. It will send the MsgSetBucketFlowRateLimit msg to greenfield to update the meta.
@@ -314,9 +363,16 @@ func (c *Client) UpdateBucketPaymentAddr(ctx context.Context, bucketName string,
 // - ret2: Return error if update flow rate limit failed, otherwise return nil.
 func (c *Client) SetBucketFlowRateLimit(ctx context.Context, bucketName string,
 	paymentAddr, bucketOwner sdk.AccAddress, flowRateLimit sdkmath.Int, opt types.SetBucketFlowRateLimitOption,
-) (string, error) {
-	updateBucketMsg := storageTypes.NewMsgSetBucketFlowRateLimit(c.MustGetDefaultAccount().GetAddress(), bucketOwner, paymentAddr, bucketName, flowRateLimit)
-	return c.sendTxn(ctx, updateBucketMsg, opt.TxOpts)
+) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.SetBucketFlowRateLimit", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	updateBucketMsg := storageTypes.NewMsgSetBucketFlowRateLimit(signer.GetAddress(), bucketOwner, paymentAddr, bucketName, flowRateLimit)
+	return c.sendTxn(ctx, updateBucketMsg, signerTxOpts(opt.TxOpts, signer))
 }
 
 // GetPaymentAccountFlowRateLimit - Get the flow rate limit of the bucket.
@@ -332,7 +388,10 @@ func (c *Client) SetBucketFlowRateLimit(ctx context.Context, bucketName string,
 // - ret1: The flow rate limit of the bucket.
 //
 // - ret2: Return error if get flow rate limit failed, otherwise return nil.
-func (c *Client) GetPaymentAccountFlowRateLimit(ctx context.Context, paymentAddr, bucketOwner sdk.AccAddress, bucketName string) (*storageTypes.QueryPaymentAccountBucketFlowRateLimitResponse, error) {
+func (c *Client) GetPaymentAccountFlowRateLimit(ctx context.Context, paymentAddr, bucketOwner sdk.AccAddress, bucketName string) (_ *storageTypes.QueryPaymentAccountBucketFlowRateLimitResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetPaymentAccountFlowRateLimit", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
 	queryFlowRateLimit := storageTypes.QueryPaymentAccountBucketFlowRateLimitRequest{
 		PaymentAccount: paymentAddr.String(),
 		BucketOwner:    bucketOwner.String(),
@@ -360,8 +419,18 @@ func (c *Client) GetPaymentAccountFlowRateLimit(ctx context.Context, paymentAddr
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error if update bucket meta failed, otherwise return nil.
-func (c *Client) UpdateBucketInfo(ctx context.Context, bucketName string, opts types.UpdateBucketOptions) (string, error) {
-	bucketInfo, err := c.HeadBucket(ctx, bucketName)
+func (c *Client) UpdateBucketInfo(ctx context.Context, bucketName string, opts types.UpdateBucketOptions) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.UpdateBucketInfo", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opts.Account)
+	if err != nil {
+		return "", err
+	}
+	defer c.cache.invalidateBucket(bucketName)
+
+	// query the latest bucket info from the chain since it is modified based on it
+	bucketInfo, err := c.headBucket(ctx, bucketName)
 	if err != nil {
 		return "", err
 	}
@@ -398,7 +467,7 @@ func (c *Client) UpdateBucketInfo(ctx context.Context, bucketName string, opts t
 		chargedReadQuota = bucketInfo.ChargedReadQuota
 	}
 
-	updateBucketMsg := storageTypes.NewMsgUpdateBucketInfo(c.MustGetDefaultAccount().GetAddress(), bucketName,
+	updateBucketMsg := storageTypes.NewMsgUpdateBucketInfo(signer.GetAddress(), bucketName,
 		&chargedReadQuota, paymentAddr, visibility)
 
 	// set the default txn broadcast mode as block mode
@@ -407,21 +476,30 @@ func (c *Client) UpdateBucketInfo(ctx context.Context, bucketName string, opts t
 		opts.TxOpts = &gnfdsdk.TxOption{Mode: &broadcastMode}
 	}
 
-	return c.sendTxn(ctx, updateBucketMsg, opts.TxOpts)
+	return c.sendTxn(ctx, updateBucketMsg, signerTxOpts(opts.TxOpts, signer))
 }
 
 func (c *Client) ToggleSPAsDelegatedAgent(ctx context.Context, bucketName string, opt types.UpdateBucketOptions,
-) (string, error) {
-	_, err := c.HeadBucket(ctx, bucketName)
+) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.ToggleSPAsDelegatedAgent", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	_, err = c.HeadBucket(ctx, bucketName)
 	if err != nil {
 		return "", err
 	}
-	msg := storageTypes.NewMsgToggleSPAsDelegatedAgent(c.MustGetDefaultAccount().GetAddress(), bucketName)
-	return c.sendTxn(ctx, msg, opt.TxOpts)
+	msg := storageTypes.NewMsgToggleSPAsDelegatedAgent(signer.GetAddress(), bucketName)
+	return c.sendTxn(ctx, msg, signerTxOpts(opt.TxOpts, signer))
 }
 
 // HeadBucket - query the bucketInfo on chain by bucket name, return the bucket info if exists.
 //
+// The bucket info is cached for Option.Cache.BucketInfoTTL if the cache is configured.
+//
 // - ctx: Context variables for the current API call.
 //
 // - bucketName: The name of the bucket to query.
@@ -429,7 +507,32 @@ func (c *Client) ToggleSPAsDelegatedAgent(ctx context.Context, bucketName string
 // - ret1: The bucket specific metadata information, including Visibility, payment address, charged quota and so on.
 //
 // - ret2: Return error if bucket not exist, otherwise return nil.
-func (c *Client) HeadBucket(ctx context.Context, bucketName string) (*storageTypes.BucketInfo, error) {
+func (c *Client) HeadBucket(ctx context.Context, bucketName string) (_ *storageTypes.BucketInfo, err error) {
+	ctx, span := c.startSpan(ctx, "client.HeadBucket", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
+	if cached, ok := c.cache.get(cacheKindBucketInfo, bucketName); ok {
+		return copyBucketInfo(cached.(*storageTypes.BucketInfo)), nil
+	}
+	bucketInfo, err := c.headBucket(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.set(cacheKindBucketInfo, bucketName, bucketInfo)
+	return copyBucketInfo(bucketInfo), nil
+}
+
+// copyBucketInfo copies the bucket info including its tags, so the cached info is not changed by the callers.
+func copyBucketInfo(bucketInfo *storageTypes.BucketInfo) *storageTypes.BucketInfo {
+	copied := *bucketInfo
+	if bucketInfo.Tags != nil {
+		copied.Tags = &storageTypes.ResourceTags{Tags: append([]storageTypes.ResourceTags_Tag(nil), bucketInfo.Tags.Tags...)}
+	}
+	return &copied
+}
+
+// headBucket queries the bucket info from the chain without the cache.
+func (c *Client) headBucket(ctx context.Context, bucketName string) (*storageTypes.BucketInfo, error) {
 	queryHeadBucketRequest := storageTypes.QueryHeadBucketRequest{
 		BucketName: bucketName,
 	}
@@ -450,7 +553,10 @@ func (c *Client) HeadBucket(ctx context.Context, bucketName string) (*storageTyp
 // - ret1: The bucket specific metadata information, including Visibility, payment address, charged quota and so on.
 //
 // - ret2: Return error if bucket not exist, otherwise return nil.
-func (c *Client) HeadBucketByID(ctx context.Context, bucketID string) (*storageTypes.BucketInfo, error) {
+func (c *Client) HeadBucketByID(ctx context.Context, bucketID string) (_ *storageTypes.BucketInfo, err error) {
+	ctx, span := c.startSpan(ctx, "client.HeadBucketByID")
+	defer func() { endSpan(span, err) }()
+
 	headBucketRequest := &storageTypes.QueryHeadBucketByIdRequest{
 		BucketId: bucketID,
 	}
@@ -480,17 +586,59 @@ func (c *Client) HeadBucketByID(ctx context.Context, bucketID string) (*storageT
 // - ret2: Return error when the request failed, otherwise return nil.
 func (c *Client) PutBucketPolicy(ctx context.Context, bucketName string, principalStr types.Principal,
 	statements []*permTypes.Statement, opt types.PutPolicyOption,
-) (string, error) {
+) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.PutBucketPolicy", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	if err = types.ValidateStatements(resourcetypes.RESOURCE_TYPE_BUCKET, statements); err != nil {
+		return "", err
+	}
 	resource := gnfdTypes.NewBucketGRN(bucketName)
 	principal := &permTypes.Principal{}
 	if err := principal.Unmarshal([]byte(principalStr)); err != nil {
 		return "", err
 	}
 
-	putPolicyMsg := storageTypes.NewMsgPutPolicy(c.MustGetDefaultAccount().GetAddress(), resource.String(),
+	putPolicyMsg := storageTypes.NewMsgPutPolicy(signer.GetAddress(), resource.String(),
 		principal, statements, opt.PolicyExpireTime)
 
-	return c.sendPutPolicyTxn(ctx, putPolicyMsg, opt.TxOpts)
+	return c.sendPutPolicyTxn(ctx, putPolicyMsg, signerTxOpts(opt.TxOpts, signer))
+}
+
+// ApplyPolicyTemplate - Put the bucket policy produced by the policy template to the principal, return the txn hash.
+// The policy of the principal on the bucket is replaced.
+//
+// - ctx: Context variables for the current API call.
+//
+// - bucketName: The bucket name identifies the bucket.
+//
+// - principalStr: Indicates the marshaled principal content of greenfield permission types, users can generate it by NewPrincipalWithAccount or NewPrincipalWithGroupId method.
+//
+// - template: The policy template, e.g. types.PolicyTemplateDropbox.
+//
+// - templateOpts: The options to set the prefix of the objects, the expiration time of the statements and the size limit.
+//
+// - opt: The options for customizing the policy expiration time and transaction, the policy expires with the
+// statements if the policy expiration time is not set.
+//
+// - ret1: Transaction hash return from blockchain.
+//
+// - ret2: Return error when the template is invalid or the request failed, otherwise return nil.
+func (c *Client) ApplyPolicyTemplate(ctx context.Context, bucketName string, principalStr types.Principal, template types.PolicyTemplate,
+	templateOpts types.PolicyTemplateOptions, opt types.PutPolicyOption,
+) (string, error) {
+	statements, err := types.NewPolicyTemplateStatements(template, bucketName, templateOpts)
+	if err != nil {
+		return "", err
+	}
+	if opt.PolicyExpireTime == nil {
+		opt.PolicyExpireTime = templateOpts.Expiration
+	}
+	return c.PutBucketPolicy(ctx, bucketName, principalStr, statements, opt)
 }
 
 // DeleteBucketPolicy - Delete the bucket policy of the principal.
@@ -506,14 +654,21 @@ func (c *Client) PutBucketPolicy(ctx context.Context, bucketName string, princip
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) DeleteBucketPolicy(ctx context.Context, bucketName string, principalStr types.Principal, opt types.DeletePolicyOption) (string, error) {
+func (c *Client) DeleteBucketPolicy(ctx context.Context, bucketName string, principalStr types.Principal, opt types.DeletePolicyOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.DeleteBucketPolicy", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
 	resource := gnfdTypes.NewBucketGRN(bucketName).String()
 	principal := &permTypes.Principal{}
 	if err := principal.Unmarshal([]byte(principalStr)); err != nil {
 		return "", err
 	}
 
-	return c.sendDelPolicyTxn(ctx, c.MustGetDefaultAccount().GetAddress(), resource, principal, opt.TxOpts)
+	return c.sendDelPolicyTxn(ctx, signer.GetAddress(), resource, principal, signerTxOpts(opt.TxOpts, signer))
 }
 
 // IsBucketPermissionAllowed - Check if the permission of bucket is allowed to the user.
@@ -531,8 +686,11 @@ func (c *Client) DeleteBucketPolicy(ctx context.Context, bucketName string, prin
 // - ret2: Return error when the request failed, otherwise return nil.
 func (c *Client) IsBucketPermissionAllowed(ctx context.Context, userAddr string,
 	bucketName string, action permTypes.ActionType,
-) (permTypes.Effect, error) {
-	_, err := sdk.AccAddressFromHexUnsafe(userAddr)
+) (_ permTypes.Effect, err error) {
+	ctx, span := c.startSpan(ctx, "client.IsBucketPermissionAllowed")
+	defer func() { endSpan(span, err) }()
+
+	_, err = sdk.AccAddressFromHexUnsafe(userAddr)
 	if err != nil {
 		return permTypes.EFFECT_DENY, err
 	}
@@ -559,8 +717,11 @@ func (c *Client) IsBucketPermissionAllowed(ctx context.Context, userAddr string,
 // - ret1: The bucket policy info defined on greenfield.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetBucketPolicy(ctx context.Context, bucketName string, principalAddr string) (*permTypes.Policy, error) {
-	_, err := sdk.AccAddressFromHexUnsafe(principalAddr)
+func (c *Client) GetBucketPolicy(ctx context.Context, bucketName string, principalAddr string) (_ *permTypes.Policy, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetBucketPolicy", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
+	_, err = sdk.AccAddressFromHexUnsafe(principalAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -614,7 +775,10 @@ func (m *listBucketsByIDsResponse) UnmarshalXML(d *xml.Decoder, start xml.StartE
 // - ret1: The result of list bucket under specific user address
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) ListBuckets(ctx context.Context, opts types.ListBucketsOptions) (types.ListBucketsResult, error) {
+func (c *Client) ListBuckets(ctx context.Context, opts types.ListBucketsOptions) (_ types.ListBucketsResult, err error) {
+	ctx, span := c.startSpan(ctx, "client.ListBuckets")
+	defer func() { endSpan(span, err) }()
+
 	params := url.Values{}
 	params.Set("include-removed", strconv.FormatBool(opts.ShowRemovedBucket))
 
@@ -690,7 +854,10 @@ func (c *Client) ListBuckets(ctx context.Context, opts types.ListBucketsOptions)
 // - ret1: The read record info of the bucket returned by SP.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) ListBucketReadRecord(ctx context.Context, bucketName string, opts types.ListReadRecordOptions) (types.QuotaRecordInfo, error) {
+func (c *Client) ListBucketReadRecord(ctx context.Context, bucketName string, opts types.ListReadRecordOptions) (_ types.QuotaRecordInfo, err error) {
+	ctx, span := c.startSpan(ctx, "client.ListBucketReadRecord", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
 	if err := s3util.CheckValidBucketName(bucketName); err != nil {
 		return types.QuotaRecordInfo{}, err
 	}
@@ -766,7 +933,10 @@ func (c *Client) ListBucketReadRecord(ctx context.Context, bucketName string, op
 // - ret1: The info of quota which contains the consumed quota, the charged quota and free quota info of the bucket
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetBucketReadQuota(ctx context.Context, bucketName string) (types.QuotaInfo, error) {
+func (c *Client) GetBucketReadQuota(ctx context.Context, bucketName string) (_ types.QuotaInfo, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetBucketReadQuota", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
 	if err := s3util.CheckValidBucketName(bucketName); err != nil {
 		return types.QuotaInfo{}, err
 	}
@@ -825,7 +995,10 @@ func (c *Client) GetBucketReadQuota(ctx context.Context, bucketName string) (typ
 // - ret1: The update time stamp.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetQuotaUpdateTime(ctx context.Context, bucketName string) (int64, error) {
+func (c *Client) GetQuotaUpdateTime(ctx context.Context, bucketName string) (_ int64, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetQuotaUpdateTime", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
 	resp, err := c.chainClient.QueryQuotaUpdateTime(ctx, &storageTypes.QueryQuoteUpdateTimeRequest{
 		BucketName: bucketName,
 	})
@@ -846,7 +1019,14 @@ func (c *Client) GetQuotaUpdateTime(ctx context.Context, bucketName string) (int
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) BuyQuotaForBucket(ctx context.Context, bucketName string, targetQuota uint64, opt types.BuyQuotaOption) (string, error) {
+func (c *Client) BuyQuotaForBucket(ctx context.Context, bucketName string, targetQuota uint64, opt types.BuyQuotaOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.BuyQuotaForBucket", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
 	bucketInfo, err := c.HeadBucket(ctx, bucketName)
 	if err != nil {
 		return "", err
@@ -856,9 +1036,9 @@ func (c *Client) BuyQuotaForBucket(ctx context.Context, bucketName string, targe
 	if err != nil {
 		return "", err
 	}
-	updateBucketMsg := storageTypes.NewMsgUpdateBucketInfo(c.MustGetDefaultAccount().GetAddress(), bucketName, &targetQuota, paymentAddr, bucketInfo.Visibility)
+	updateBucketMsg := storageTypes.NewMsgUpdateBucketInfo(signer.GetAddress(), bucketName, &targetQuota, paymentAddr, bucketInfo.Visibility)
 
-	resp, err := c.BroadcastTx(ctx, []sdk.Msg{updateBucketMsg}, opt.TxOpts)
+	resp, err := c.BroadcastTx(ctx, []sdk.Msg{updateBucketMsg}, signerTxOpts(opt.TxOpts, signer))
 	if err != nil {
 		return "", err
 	}
@@ -879,7 +1059,10 @@ func (c *Client) BuyQuotaForBucket(ctx context.Context, bucketName string, targe
 // - ret1: The result of bucket info map by given bucket ids.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) ListBucketsByBucketID(ctx context.Context, bucketIds []uint64, opts types.EndPointOptions) (types.ListBucketsByBucketIDResponse, error) {
+func (c *Client) ListBucketsByBucketID(ctx context.Context, bucketIds []uint64, opts types.EndPointOptions) (_ types.ListBucketsByBucketIDResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.ListBucketsByBucketID")
+	defer func() { endSpan(span, err) }()
+
 	const MaximumListBucketsSize = 1000
 	if len(bucketIds) == 0 || len(bucketIds) > MaximumListBucketsSize {
 		return types.ListBucketsByBucketIDResponse{}, nil
@@ -955,7 +1138,15 @@ func (c *Client) ListBucketsByBucketID(ctx context.Context, bucketIds []uint64,
 // - ret1: The msg of migrating bucket which contain the approval signature from the storage provider.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetMigrateBucketApproval(ctx context.Context, migrateBucketMsg *storageTypes.MsgMigrateBucket) (*storageTypes.MsgMigrateBucket, error) {
+func (c *Client) GetMigrateBucketApproval(ctx context.Context, migrateBucketMsg *storageTypes.MsgMigrateBucket) (_ *storageTypes.MsgMigrateBucket, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetMigrateBucketApproval")
+	defer func() { endSpan(span, err) }()
+
+	return c.getMigrateBucketApproval(ctx, migrateBucketMsg, nil)
+}
+
+// getMigrateBucketApproval sends the approval request signed by the signer, the default account is used if it is nil.
+func (c *Client) getMigrateBucketApproval(ctx context.Context, migrateBucketMsg *storageTypes.MsgMigrateBucket, signer *types.Account) (*storageTypes.MsgMigrateBucket, error) {
 	unsignedBytes := migrateBucketMsg.GetSignBytes()
 
 	// set the action type
@@ -967,6 +1158,7 @@ func (c *Client) GetMigrateBucketApproval(ctx context.Context, migrateBucketMsg
 		urlRelPath:    "get-approval",
 		contentSHA256: types.EmptyStringSHA256,
 		txnMsg:        hex.EncodeToString(unsignedBytes),
+		account:       signer,
 	}
 
 	sendOpt := sendOptions{
@@ -1018,14 +1210,24 @@ func (c *Client) GetMigrateBucketApproval(ctx context.Context, migrateBucketMsg
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error when the request of getting approval or sending transaction failed, otherwise return nil.
-func (c *Client) MigrateBucket(ctx context.Context, bucketName string, dstPrimarySPID uint32, opts types.MigrateBucketOptions) (string, error) {
-	migrateBucketMsg := storageTypes.NewMsgMigrateBucket(c.MustGetDefaultAccount().GetAddress(), bucketName, dstPrimarySPID)
+func (c *Client) MigrateBucket(ctx context.Context, bucketName string, dstPrimarySPID uint32, opts types.MigrateBucketOptions) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.MigrateBucket", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
 
-	err := migrateBucketMsg.ValidateBasic()
+	signer, err := c.getSigner(opts.Account)
 	if err != nil {
 		return "", err
 	}
-	signedMsg, err := c.GetMigrateBucketApproval(ctx, migrateBucketMsg)
+	// the primary SP of the bucket changes after the migration
+	defer c.cache.invalidateBucket(bucketName)
+
+	migrateBucketMsg := storageTypes.NewMsgMigrateBucket(signer.GetAddress(), bucketName, dstPrimarySPID)
+
+	err = migrateBucketMsg.ValidateBasic()
+	if err != nil {
+		return "", err
+	}
+	signedMsg, err := c.getMigrateBucketApproval(ctx, migrateBucketMsg, signer)
 	if err != nil {
 		return "", err
 	}
@@ -1036,7 +1238,7 @@ func (c *Client) MigrateBucket(ctx context.Context, bucketName string, dstPrimar
 		opts.TxOpts = &gnfdsdk.TxOption{Mode: &broadcastMode}
 	}
 
-	resp, err := c.BroadcastTx(ctx, []sdk.Msg{signedMsg}, opts.TxOpts)
+	resp, err := c.BroadcastTx(ctx, []sdk.Msg{signedMsg}, signerTxOpts(opts.TxOpts, signer))
 	if err != nil {
 		return "", err
 	}
@@ -1049,7 +1251,8 @@ func (c *Client) MigrateBucket(ctx context.Context, bucketName string, dstPrimar
 			return txnHash, fmt.Errorf("the transaction has been submitted, please check it later:%v", err)
 		}
 		if txnResponse.TxResult.Code != 0 {
-			return txnHash, fmt.Errorf("the migrateBucket txn has failed with response code: %d, codespace:%s", txnResponse.TxResult.Code, txnResponse.TxResult.Codespace)
+			return txnHash, types.NewTxError(txnResponse.TxResult.Codespace, txnResponse.TxResult.Code, txnResponse.TxResult.Log,
+				"the migrateBucket txn has failed with response code: %d, codespace:%s", txnResponse.TxResult.Code, txnResponse.TxResult.Codespace)
 		}
 	}
 	return txnHash, nil
@@ -1066,10 +1269,19 @@ func (c *Client) MigrateBucket(ctx context.Context, bucketName string, dstPrimar
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error when the request of cancel migration failed, otherwise return nil.
-func (c *Client) CancelMigrateBucket(ctx context.Context, bucketName string, opts types.CancelMigrateBucketOptions) (string, error) {
-	cancelMigrateBucketMsg := storageTypes.NewMsgCancelMigrateBucket(c.MustGetDefaultAccount().GetAddress(), bucketName)
+func (c *Client) CancelMigrateBucket(ctx context.Context, bucketName string, opts types.CancelMigrateBucketOptions) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.CancelMigrateBucket", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opts.Account)
+	if err != nil {
+		return "", err
+	}
+	defer c.cache.invalidateBucket(bucketName)
+
+	cancelMigrateBucketMsg := storageTypes.NewMsgCancelMigrateBucket(signer.GetAddress(), bucketName)
 
-	err := cancelMigrateBucketMsg.ValidateBasic()
+	err = cancelMigrateBucketMsg.ValidateBasic()
 	if err != nil {
 		return "", err
 	}
@@ -1080,7 +1292,7 @@ func (c *Client) CancelMigrateBucket(ctx context.Context, bucketName string, opt
 		opts.TxOpts = &gnfdsdk.TxOption{Mode: &broadcastMode}
 	}
 
-	resp, err := c.BroadcastTx(ctx, []sdk.Msg{cancelMigrateBucketMsg}, opts.TxOpts)
+	resp, err := c.BroadcastTx(ctx, []sdk.Msg{cancelMigrateBucketMsg}, signerTxOpts(opts.TxOpts, signer))
 	if err != nil {
 		return "", err
 	}
@@ -1097,7 +1309,8 @@ func (c *Client) CancelMigrateBucket(ctx context.Context, bucketName string, opt
 		}
 
 		if txnResponse.TxResult.Code != 0 {
-			return txnHash, fmt.Errorf("the createBucket txn has failed with response code: %d", txnResponse.TxResult.Code)
+			return txnHash, types.NewTxError(txnResponse.TxResult.Codespace, txnResponse.TxResult.Code, txnResponse.TxResult.Log,
+				"the createBucket txn has failed with response code: %d", txnResponse.TxResult.Code)
 		}
 	}
 
@@ -1117,8 +1330,11 @@ func (c *Client) CancelMigrateBucket(ctx context.Context, bucketName string, opt
 // - ret1: The result of bucket info under specific payment account.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) ListBucketsByPaymentAccount(ctx context.Context, paymentAccount string, opts types.ListBucketsByPaymentAccountOptions) (types.ListBucketsByPaymentAccountResult, error) {
-	_, err := sdk.AccAddressFromHexUnsafe(paymentAccount)
+func (c *Client) ListBucketsByPaymentAccount(ctx context.Context, paymentAccount string, opts types.ListBucketsByPaymentAccountOptions) (_ types.ListBucketsByPaymentAccountResult, err error) {
+	ctx, span := c.startSpan(ctx, "client.ListBucketsByPaymentAccount")
+	defer func() { endSpan(span, err) }()
+
+	_, err = sdk.AccAddressFromHexUnsafe(paymentAccount)
 	if err != nil {
 		return types.ListBucketsByPaymentAccountResult{}, err
 	}
@@ -1180,8 +1396,11 @@ func (c *Client) ListBucketsByPaymentAccount(ctx context.Context, paymentAccount
 // - ret2: Return error when the request failed, otherwise return nil.
 
 // GetBucketMigrationProgress return the status of object including the uploading progress
-func (c *Client) GetBucketMigrationProgress(ctx context.Context, bucketName string, destSP uint32) (types.MigrationProgress, error) {
-	_, err := c.HeadBucket(ctx, bucketName)
+func (c *Client) GetBucketMigrationProgress(ctx context.Context, bucketName string, destSP uint32) (_ types.MigrationProgress, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetBucketMigrationProgress", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
+	_, err = c.HeadBucket(ctx, bucketName)
 	if err != nil {
 		return types.MigrationProgress{}, err
 	}
@@ -1231,7 +1450,10 @@ func (c *Client) getMigrationStateFromSP(ctx context.Context, bucketName string,
 	return migrationProgress, nil
 }
 
-func (c *Client) GetRecommendedVirtualGroupFamilyIDBySPID(ctx context.Context, spID uint32) (uint32, error) {
+func (c *Client) GetRecommendedVirtualGroupFamilyIDBySPID(ctx context.Context, spID uint32) (_ uint32, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetRecommendedVirtualGroupFamilyIDBySPID")
+	defer func() { endSpan(span, err) }()
+
 	endpoint, err := c.getSPUrlByID(spID)
 	if err != nil {
 		log.Error().Msg(fmt.Sprintf("route endpoint by sp ID: %d failed, err: %s", spID, err.Error()))
@@ -52,7 +52,10 @@ type IChallengeClient interface {
 // - ret1: The challenge info includes the piece data, piece hash roots and integrity hash corresponding to the accessed storage provider.
 //
 // - ret2: Return error when getting challenge info failed, otherwise return nil.
-func (c *Client) GetChallengeInfo(ctx context.Context, objectID string, pieceIndex, redundancyIndex int, opts types.GetChallengeInfoOptions) (types.ChallengeResult, error) {
+func (c *Client) GetChallengeInfo(ctx context.Context, objectID string, pieceIndex, redundancyIndex int, opts types.GetChallengeInfoOptions) (_ types.ChallengeResult, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetChallengeInfo")
+	defer func() { endSpan(span, err) }()
+
 	if objectID == "" {
 		return types.ChallengeResult{}, errors.New("fail to get objectId")
 	}
@@ -61,7 +64,6 @@ func (c *Client) GetChallengeInfo(ctx context.Context, objectID string, pieceInd
 		return types.ChallengeResult{}, errors.New("index error, should be 0 to parityShards plus dataShards")
 	}
 
-	var err error
 	dataBlocks, parityBlocks, _, err := c.GetRedundancyParams()
 	if err != nil {
 		return types.ChallengeResult{}, errors.New("fail to get redundancy params:" + err.Error())
@@ -231,7 +233,10 @@ func (c *Client) GetChallengeInfo(ctx context.Context, objectID string, pieceInd
 // - ret1: The response of Greenfield transaction.
 //
 // - ret2: Return error when submitting challenge tx failed, otherwise return nil.
-func (c *Client) SubmitChallenge(ctx context.Context, challengerAddress, spOperatorAddress, bucketName, objectName string, randomIndex bool, segmentIndex uint32, txOption gnfdsdktypes.TxOption) (*sdk.TxResponse, error) {
+func (c *Client) SubmitChallenge(ctx context.Context, challengerAddress, spOperatorAddress, bucketName, objectName string, randomIndex bool, segmentIndex uint32, txOption gnfdsdktypes.TxOption) (_ *sdk.TxResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.SubmitChallenge", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
 	challenger, err := sdk.AccAddressFromHexUnsafe(challengerAddress)
 	if err != nil {
 		return nil, err
@@ -279,7 +284,10 @@ func (c *Client) SubmitChallenge(ctx context.Context, challengerAddress, spOpera
 // - ret2: Return error when submitting attestation tx failed, otherwise return nil.
 func (c *Client) AttestChallenge(ctx context.Context, submitterAddress, challengerAddress, spOperatorAddress string, challengeId uint64, objectId math.Uint,
 	voteResult challengetypes.VoteResult, voteValidatorSet []uint64, VoteAggSignature []byte, txOption gnfdsdktypes.TxOption,
-) (*sdk.TxResponse, error) {
+) (_ *sdk.TxResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.AttestChallenge")
+	defer func() { endSpan(span, err) }()
+
 	submitter, err := sdk.AccAddressFromHexUnsafe(submitterAddress)
 	if err != nil {
 		return nil, err
@@ -314,7 +322,10 @@ func (c *Client) AttestChallenge(ctx context.Context, submitterAddress, challeng
 // - ret1: The latest attested challenges, including challenge id and attestation result.
 //
 // - ret2: Return error when getting latest attested challenges failed, otherwise return nil.
-func (c *Client) LatestAttestedChallenges(ctx context.Context, req *challengetypes.QueryLatestAttestedChallengesRequest) (*challengetypes.QueryLatestAttestedChallengesResponse, error) {
+func (c *Client) LatestAttestedChallenges(ctx context.Context, req *challengetypes.QueryLatestAttestedChallengesRequest) (_ *challengetypes.QueryLatestAttestedChallengesResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.LatestAttestedChallenges")
+	defer func() { endSpan(span, err) }()
+
 	return c.chainClient.LatestAttestedChallenges(ctx, req)
 }
 
@@ -329,7 +340,10 @@ func (c *Client) LatestAttestedChallenges(ctx context.Context, req *challengetyp
 // - ret1: The in-turn validator information, including BLS pubkey of the validator and timeframe to submit attestations.
 //
 // - ret2: Return error when getting in-turn attestation submitter failed, otherwise return nil.
-func (c *Client) InturnAttestationSubmitter(ctx context.Context, req *challengetypes.QueryInturnAttestationSubmitterRequest) (*challengetypes.QueryInturnAttestationSubmitterResponse, error) {
+func (c *Client) InturnAttestationSubmitter(ctx context.Context, req *challengetypes.QueryInturnAttestationSubmitterRequest) (_ *challengetypes.QueryInturnAttestationSubmitterResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.InturnAttestationSubmitter")
+	defer func() { endSpan(span, err) }()
+
 	return c.chainClient.InturnAttestationSubmitter(ctx, req)
 }
 
@@ -342,6 +356,9 @@ func (c *Client) InturnAttestationSubmitter(ctx context.Context, req *challenget
 // - ret1: The parameters of challenge module.
 //
 // - ret2: Return error when getting parameters failed, otherwise return nil.
-func (c *Client) ChallengeParams(ctx context.Context, req *challengetypes.QueryParamsRequest) (*challengetypes.QueryParamsResponse, error) {
+func (c *Client) ChallengeParams(ctx context.Context, req *challengetypes.QueryParamsRequest) (_ *challengetypes.QueryParamsResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.ChallengeParams")
+	defer func() { endSpan(span, err) }()
+
 	return c.chainClient.ChallengeQueryClient.Params(ctx, req)
 }
@@ -15,10 +15,13 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	"google.golang.org/grpc"
 
 	hashlib "github.com/bnb-chain/greenfield-common/go/hash"
@@ -49,6 +52,7 @@ type IClient interface {
 	IFeeGrantClient
 	IVirtualGroupClient
 	IAuthClient
+	IResourceClient
 }
 
 // Client - The implementation for IClient, implement all Client APIs for Greenfield SDK.
@@ -57,7 +61,8 @@ type Client struct {
 	chainClient *sdkclient.GreenfieldClient
 	// The HTTP Client is used to send HTTP requests to the greenfield blockchain and sp
 	httpClient *http.Client
-	// Service provider endpoints
+	// Service provider endpoints, guarded by spMtx since they are refreshed by the background probe
+	spMtx            sync.RWMutex
 	storageProviders map[uint32]*types.StorageProvider
 	// The default account to use when sending transactions.
 	defaultAccount *types.Account
@@ -78,6 +83,23 @@ type Client struct {
 	// forceToUseSpecifiedSpEndpointForDownloadOnly indicates a fixed SP endpoint to which to send the download request
 	// If this option is set, the client can only make download requests, and can only download from the fixed endpoint
 	forceToUseSpecifiedSpEndpointForDownloadOnly *url.URL
+	// retryPolicy defines how to retry the failed requests sent to SP
+	retryPolicy *RetryPolicy
+	// cache stores the metadata queried from the chain, it is nil if the cache is not configured
+	cache *clientCache
+	// tracer creates the spans of the API calls
+	tracer trace.Tracer
+	// metrics records the metrics of the SP requests and the chain calls
+	metrics *clientMetrics
+	// spRequestInterceptors intercept the requests sent to SP before they are signed
+	spRequestInterceptors []SPRequestInterceptor
+	// spHealth records the health of the SPs to prefer the healthy ones
+	spHealth *spHealthTracker
+	// rpcFailover routes the requests to the RPC endpoints of the blockchain, it is nil if the failover is not configured
+	rpcFailover *rpcFailover
+	// closed is closed by Close to stop the background goroutines of the client
+	closed    chan struct{}
+	closeOnce sync.Once
 }
 
 // Option - Configurations for providing optional parameters for the Greenfield SDK Client.
@@ -109,6 +131,29 @@ type Option struct {
 	// ForceToUseSpecifiedSpEndpointForDownloadOnly indicates a fixed SP endpoint to which to send the download request
 	// If this option is set, the client can only make download requests, and can only download from the fixed endpoint
 	ForceToUseSpecifiedSpEndpointForDownloadOnly string
+	// RetryPolicy defines how to retry the failed requests sent to SP, the DefaultRetryPolicy is used if it is not set.
+	// Set its MaxAttempts to 1 to disable the retry.
+	RetryPolicy *RetryPolicy
+	// RPCFailover defines the backup RPC endpoints of the blockchain to fail over to, it can not be used with UseWebSocketConn.
+	RPCFailover *RPCFailoverOption
+	// SPHealthCheckInterval is the interval of probing the storage providers in the background, the probing is disabled if it is not set.
+	SPHealthCheckInterval time.Duration
+	// Cache defines the caching of the metadata queried from the chain, the metadata is not cached if it is not set.
+	Cache *CacheOption
+	// TracerProvider enables the OpenTelemetry tracing of the API calls, the SP requests and the chain queries and broadcasts.
+	// The trace context is propagated to SP in the request headers.
+	TracerProvider trace.TracerProvider
+	// MetricsRegistry enables the metrics of the SP requests, the txs and the chain queries, the metrics are created through it.
+	MetricsRegistry MetricsRegistry
+	// SPRequestInterceptors intercept the requests sent to SP, the first one is the outermost one. The requests are signed after them.
+	SPRequestInterceptors []SPRequestInterceptor
+	// ChainUnaryInterceptors intercept the queries and the broadcasts sent to the chain, the first one is the outermost one.
+	// They can not be used with UseWebSocketConn.
+	ChainUnaryInterceptors []grpc.UnaryClientInterceptor
+	// Cassette records the SP exchanges, the chain query responses and the Tendermint RPC calls to a file, or replays the
+	// recorded ones in tests.
+	// It can not be used with UseWebSocketConn.
+	Cassette *CassetteOption
 }
 
 // OffChainAuthOption - The optional configurations for off-chain-auth.
@@ -159,10 +204,37 @@ func New(chainID string, endpoint string, option Option) (IClient, error) {
 		return nil, errors.New("fail to get grpcAddress and chainID to construct Client")
 	}
 	var (
-		cc  *sdkclient.GreenfieldClient
-		err error
+		cc           *sdkclient.GreenfieldClient
+		failover     *rpcFailover
+		customDialer func(string) (*http.Client, error)
+		err          error
 	)
-	if option.UseWebSocketConn {
+	if option.Cassette != nil && option.UseWebSocketConn {
+		return nil, errors.New("the cassette can not be used with the websocket connection")
+	}
+	cassette, err := newCassette(option.Cassette, option.Transport)
+	if err != nil {
+		return nil, err
+	}
+	if option.RPCFailover != nil {
+		if option.UseWebSocketConn {
+			return nil, errors.New("the rpc failover can not be used with the websocket connection")
+		}
+		failover, err = newRPCFailover(chainID, append([]string{endpoint}, option.RPCFailover.Endpoints...), *option.RPCFailover)
+		if err != nil {
+			return nil, err
+		}
+		failover.checkHealth(context.Background())
+		customDialer = func(string) (*http.Client, error) {
+			return &http.Client{Transport: failover}, nil
+		}
+	}
+	if cassette != nil {
+		// the chain queries are recorded by the interceptor, and the Tendermint RPC calls are recorded by the dialer
+		cc, err = sdkclient.NewCustomGreenfieldClient(endpoint, chainID, cassette.tendermintDialer(customDialer))
+	} else if customDialer != nil {
+		cc, err = sdkclient.NewCustomGreenfieldClient(endpoint, chainID, customDialer)
+	} else if option.UseWebSocketConn {
 		cc, err = sdkclient.NewGreenfieldClient(endpoint, chainID, sdkclient.WithWebSocketClient())
 	} else {
 		cc, err = sdkclient.NewGreenfieldClient(endpoint, chainID)
@@ -174,13 +246,35 @@ func New(chainID string, endpoint string, option Option) (IClient, error) {
 		cc.SetKeyManager(option.DefaultAccount.GetKeyManager())
 	}
 
+	tracerProvider := option.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = noop.NewTracerProvider()
+	}
+	tracer := tracerProvider.Tracer(tracerName, trace.WithInstrumentationVersion(types.Version))
+	metrics := newClientMetrics(option.MetricsRegistry)
+	if len(option.ChainUnaryInterceptors) > 0 && option.UseWebSocketConn {
+		return nil, errors.New("the chain interceptors can not be used with the websocket connection")
+	}
+	transport, chainInterceptors := option.Transport, option.ChainUnaryInterceptors
+	if cassette != nil {
+		// the cassette is the innermost interceptor, so the replayed calls are traced, measured and intercepted as well
+		transport = cassette
+		chainInterceptors = append(append([]grpc.UnaryClientInterceptor{}, chainInterceptors...), cassette.interceptor)
+	}
+	// the websocket client of the chain client can not be shared, so the chain queries are not instrumented with it
+	if (option.TracerProvider != nil || option.MetricsRegistry != nil || len(chainInterceptors) > 0) && !option.UseWebSocketConn {
+		if err = instrumentChainClient(cc, endpoint, customDialer, tracer, metrics, chainInterceptors); err != nil {
+			return nil, err
+		}
+	}
+
 	if option.ExpireSeconds > httplib.MaxExpiryAgeInSec {
 		return nil, errors.New("the configured expire time exceeds max expire time")
 	}
 
 	c := Client{
 		chainClient:      cc,
-		httpClient:       &http.Client{Transport: option.Transport},
+		httpClient:       &http.Client{Transport: transport},
 		userAgent:        types.UserAgent,
 		defaultAccount:   option.DefaultAccount, // it allows to be nil
 		secure:           option.Secure,
@@ -188,6 +282,18 @@ func New(chainID string, endpoint string, option Option) (IClient, error) {
 		storageProviders: make(map[uint32]*types.StorageProvider),
 		useWebsocketConn: option.UseWebSocketConn,
 		expireSeconds:    option.ExpireSeconds,
+		retryPolicy:      option.RetryPolicy,
+		rpcFailover:      failover,
+		closed:           make(chan struct{}),
+		spHealth:         newSPHealthTracker(),
+		cache:            newClientCache(option.Cache),
+		tracer:           tracer,
+		metrics:          metrics,
+
+		spRequestInterceptors: option.SPRequestInterceptors,
+	}
+	if c.retryPolicy == nil {
+		c.retryPolicy = DefaultRetryPolicy()
 	}
 
 	if option.ForceToUseSpecifiedSpEndpointForDownloadOnly != "" {
@@ -220,7 +326,7 @@ func New(chainID string, endpoint string, option Option) (IClient, error) {
 		}
 		c.offChainAuthOption = option.OffChainAuthOption
 		if option.OffChainAuthOption.ShouldRegisterPubKey {
-			for _, sp := range c.storageProviders {
+			for _, sp := range c.storageProviderList() {
 				registerResult, err := c.RegisterEDDSAPublicKey(sp.OperatorAddress.String(), sp.EndPoint.Scheme+"://"+sp.EndPoint.Host)
 				if err != nil {
 					log.Error().Msg(fmt.Sprintf("Fail to RegisterEDDSAPublicKey for sp : %s", sp.EndPoint))
@@ -241,7 +347,7 @@ func New(chainID string, endpoint string, option Option) (IClient, error) {
 
 		c.offChainAuthOptionV2 = option.OffChainAuthOptionV2
 		if option.OffChainAuthOptionV2.ShouldRegisterPubKey {
-			for _, sp := range c.storageProviders {
+			for _, sp := range c.storageProviderList() {
 				registerResult, err := c.RegisterEDDSAPublicKeyV2(sp.EndPoint.Scheme + "://" + sp.EndPoint.Host)
 				if err != nil {
 					log.Error().Msg(fmt.Sprintf("Fail to RegisterEDDSAPublicKeyV2 for sp : %s", sp.EndPoint))
@@ -252,9 +358,25 @@ func New(chainID string, endpoint string, option Option) (IClient, error) {
 		}
 	}
 
+	// the background goroutines are started last so that they are not leaked when New fails, they stop on Close
+	if failover != nil {
+		failover.startHealthCheck(c.closed)
+	}
+	if option.SPHealthCheckInterval > 0 {
+		c.startSPProbe(option.SPHealthCheckInterval)
+	}
 	return &c, nil
 }
 
+// Close - Stop the background goroutines of the client, i.e. the SP health probe and the RPC health check. The client can still be used to send
+// requests after it is closed, and closing it more than once is a no-op.
+//
+// - ret: Return nil, the error is reserved for the resources released in the future.
+func (c *Client) Close() error {
+	c.closeOnce.Do(func() { close(c.closed) })
+	return nil
+}
+
 func (c *Client) getSPUrlByBucket(bucketName string) (*url.URL, error) {
 	sp, err := c.pickStorageProviderByBucket(bucketName)
 	if err != nil {
@@ -264,6 +386,12 @@ func (c *Client) getSPUrlByBucket(bucketName string) (*url.URL, error) {
 }
 
 func (c *Client) pickStorageProviderByBucket(bucketName string) (*types.StorageProvider, error) {
+	if cached, ok := c.cache.get(cacheKindBucketRoute, bucketName); ok {
+		if sp, ok := c.storageProvider(cached.(uint32)); ok {
+			return sp, nil
+		}
+	}
+
 	ctx := context.Background()
 	bucketInfo, err := c.HeadBucket(ctx, bucketName)
 	if err != nil {
@@ -275,8 +403,10 @@ func (c *Client) pickStorageProviderByBucket(bucketName string) (*types.StorageP
 		return nil, err
 	}
 
-	sp, ok := c.storageProviders[familyResp.GlobalVirtualGroupFamily.PrimarySpId]
+	primarySPID := familyResp.GlobalVirtualGroupFamily.PrimarySpId
+	sp, ok := c.storageProvider(primarySPID)
 	if ok {
+		c.cache.set(cacheKindBucketRoute, bucketName, primarySPID)
 		return sp, nil
 	}
 	// refresh the meta from blockchain
@@ -285,16 +415,17 @@ func (c *Client) pickStorageProviderByBucket(bucketName string) (*types.StorageP
 		return nil, err
 	}
 
-	sp, ok = c.storageProviders[familyResp.GlobalVirtualGroupFamily.PrimarySpId]
+	sp, ok = c.storageProvider(primarySPID)
 	if ok {
+		c.cache.set(cacheKindBucketRoute, bucketName, primarySPID)
 		return sp, nil
 	}
-	return nil, fmt.Errorf("the storage provider %d not exists on chain", familyResp.GlobalVirtualGroupFamily.PrimarySpId)
+	return nil, fmt.Errorf("the storage provider %d not exists on chain", primarySPID)
 }
 
 // getSPUrlByID route url of the sp from sp id
 func (c *Client) getSPUrlByID(id uint32) (*url.URL, error) {
-	sp, ok := c.storageProviders[id]
+	sp, ok := c.storageProvider(id)
 	if ok {
 		return sp.EndPoint, nil
 	}
@@ -308,7 +439,7 @@ func (c *Client) getSPUrlByAddr(address string) (*url.URL, error) {
 	if err != nil {
 		return nil, err
 	}
-	for _, sp := range c.storageProviders {
+	for _, sp := range c.storageProviderList() {
 		if sp.OperatorAddress.Equals(acc) {
 			return sp.EndPoint, nil
 		}
@@ -317,7 +448,7 @@ func (c *Client) getSPUrlByAddr(address string) (*url.URL, error) {
 	return nil, fmt.Errorf("the SP endpoint %s not exists on chain", address)
 }
 
-// getInServiceSP return the first SP endpoint which is in service in SP list
+// getInServiceSP return the healthiest SP endpoint which is in service in SP list
 func (c *Client) getInServiceSP() (*url.URL, error) {
 	ctx := context.Background()
 	spList, err := c.ListStorageProviders(ctx, true)
@@ -326,23 +457,26 @@ func (c *Client) getInServiceSP() (*url.URL, error) {
 	}
 
 	if len(spList) == 0 {
-		return nil, errors.New("fail to get SP endpoint")
+		return nil, fmt.Errorf("fail to get SP endpoint: %w", types.ErrSPUnavailable)
 	}
 
-	var useHttps bool
-	SPEndpoint := spList[0].Endpoint
-	if strings.Contains(SPEndpoint, "https") {
-		useHttps = true
-	} else {
-		useHttps = c.secure
-	}
+	endpoints := make([]*url.URL, 0, len(spList))
+	for _, sp := range spList {
+		var useHttps bool
+		if strings.Contains(sp.Endpoint, "https") {
+			useHttps = true
+		} else {
+			useHttps = c.secure
+		}
 
-	urlInfo, urlErr := utils.GetEndpointURL(spList[0].Endpoint, useHttps)
-	if urlErr != nil {
-		return nil, urlErr
+		urlInfo, urlErr := utils.GetEndpointURL(sp.Endpoint, useHttps)
+		if urlErr != nil {
+			return nil, urlErr
+		}
+		endpoints = append(endpoints, urlInfo)
 	}
 
-	return urlInfo, nil
+	return c.spHealth.pick(endpoints), nil
 }
 
 // requestMeta - contains the metadata to construct the http request.
@@ -359,6 +493,7 @@ type requestMeta struct {
 	contentSHA256    string // hex encoded sha256sum
 	pieceInfo        types.QueryPieceInfo
 	userAddress      string
+	account          *types.Account // the account signing the request, the default account is used if it is nil
 }
 
 // SendOptions -  options to use to send the http message
@@ -368,6 +503,7 @@ type sendOptions struct {
 	disableCloseBody bool         // indicate whether to disable automatic calls to resp.Body.Close()
 	txnHash          string       // the transaction hash info
 	adminInfo        AdminAPIInfo // the admin API info
+	retryable        bool         // indicate whether the non-GET request can be retried safely, e.g. it is resumed from the offset
 }
 
 // AdminAPIInfo - the admin api info
@@ -385,7 +521,7 @@ func DefaultDownloadSegmentHook(seg int64) error {
 	return nil
 }
 
-// newRequest constructs the http request, set url, body and headers
+// newRequest constructs the http request, set url, body and headers, the request is signed by signRequest after the interceptors run
 func (c *Client) newRequest(ctx context.Context, method string, meta requestMeta,
 	body interface{}, txnHash string, adminAPIInfo AdminAPIInfo, endpoint *url.URL,
 ) (req *http.Request, err error) {
@@ -507,12 +643,6 @@ func (c *Client) newRequest(ctx context.Context, method string, meta requestMeta
 	// set user-agent
 	req.Header.Set(types.HTTPHeaderUserAgent, c.userAgent)
 
-	// sign the total http request info when auth type v1
-	err = c.signRequest(req)
-	if err != nil {
-		return req, err
-	}
-
 	return
 }
 
@@ -536,13 +666,17 @@ func (c *Client) doAPI(ctx context.Context, req *http.Request, meta requestMeta,
 		}
 		if urlErr, ok := err.(*url.Error); ok {
 			if strings.Contains(urlErr.Err.Error(), "EOF") {
-				return nil, &url.Error{
+				err = &url.Error{
 					Op:  urlErr.Op,
 					URL: urlErr.URL,
-					Err: errors.New("Connection closed by foreign host " + urlErr.URL + ". Retry again."),
+					Err: fmt.Errorf("Connection closed by foreign host %s. Retry again: %w", urlErr.URL, urlErr.Err),
 				}
 			}
 		}
+		// the SP can not be reached if the request fails with a transient network error
+		if types.IsRetryable(err) {
+			err = fmt.Errorf("%w: %w", types.ErrSPUnavailable, err)
+		}
 		return nil, err
 	}
 	defer func() {
@@ -572,19 +706,76 @@ func (c *Client) doAPI(ctx context.Context, req *http.Request, meta requestMeta,
 	return resp, nil
 }
 
-// sendReq sends the message via REST and handles the response
+// sendReq sends the message via REST and handles the response, the transient failures are retried according to the retry policy
 func (c *Client) sendReq(ctx context.Context, metadata requestMeta, opt *sendOptions, endpoint *url.URL) (res *http.Response, err error) {
-	req, err := c.newRequest(ctx, opt.method, metadata, opt.body, opt.txnHash, opt.adminInfo, endpoint)
-	if err != nil {
-		return nil, err
-	}
+	attempts, bodySeeker, bodyOffset := c.retryAttempts(opt)
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && bodySeeker != nil {
+			if _, err = bodySeeker.Seek(bodyOffset, io.SeekStart); err != nil {
+				return nil, err
+			}
+		}
 
-	resp, err := c.doAPI(ctx, req, metadata, !opt.disableCloseBody)
-	if err != nil {
-		log.Error().Msg(fmt.Sprintf("do API error, url: %s, err: %s", req.URL.String(), err))
-		return nil, err
+		// the request is rebuilt on each attempt, so that the date and the signature are refreshed
+		req, err := c.newRequest(ctx, opt.method, metadata, opt.body, opt.txnHash, opt.adminInfo, endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		spanCtx, span := c.tracer.Start(ctx, "sp."+strings.ToLower(opt.method), trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attrSPEndpoint.String(endpoint.Host), attrHTTPMethod.String(opt.method),
+				attrBucket.String(metadata.bucketName), attrObject.String(metadata.objectName),
+				attrBytes.Int64(req.ContentLength), attrAttempt.Int(attempt)))
+		injectTraceContext(spanCtx, req.Header)
+		start := time.Now()
+		handler := chainSPRequestInterceptors(c.spRequestInterceptors, func(req *http.Request) (*http.Response, error) {
+			// sign the total http request info after the interceptors modify it
+			if err := c.signRequest(req, metadata.account); err != nil {
+				return nil, err
+			}
+			return c.doAPI(req.Context(), req, metadata, !opt.disableCloseBody)
+		})
+		resp, err := handler(req.WithContext(spanCtx))
+		latency := time.Since(start)
+		c.spHealth.record(endpoint.Host, latency, err)
+		c.cache.invalidateOnError(metadata.bucketName, err)
+		var (
+			errResp    types.ErrResponse
+			statusCode int
+		)
+		if err == nil {
+			statusCode = resp.StatusCode
+		} else if errors.As(err, &errResp) {
+			statusCode = errResp.StatusCode
+		}
+		span.SetAttributes(attrHTTPStatus.Int(statusCode))
+		endSpan(span, err)
+		api := apiName(ctx)
+		c.metrics.spRequestDuration.Observe(latency.Seconds(), api, endpoint.Host, opt.method, statusLabel(statusCode))
+		if err == nil {
+			if req.ContentLength > 0 {
+				c.metrics.spUploadedBytes.Add(float64(req.ContentLength), api, endpoint.Host)
+			}
+			// the body is read by the caller if it is not closed
+			if opt.disableCloseBody && resp.Body != nil {
+				resp.Body = &countingReadCloser{ReadCloser: resp.Body, counter: c.metrics.spDownloadedBytes, labelValues: []string{api, endpoint.Host}}
+			}
+			return resp, nil
+		}
+		if attempt >= attempts || !c.retryPolicy.isRetryable(err) {
+			log.Error().Msg(fmt.Sprintf("do API error, url: %s, err: %s", req.URL.String(), err))
+			return nil, err
+		}
+
+		delay := c.retryPolicy.backoff(attempt)
+		c.metrics.spRetries.Add(1, apiName(ctx), endpoint.Host)
+		log.Warn().Msg(fmt.Sprintf("do API error, url: %s, err: %s, retry %d in %s", req.URL.String(), err, attempt, delay))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
 	}
-	return resp, nil
 }
 
 func (c *Client) SplitPartInfo(objectSize int64, configuredPartSize uint64) (totalPartsCount int, partSize int64, lastPartSize int64, err error) {
@@ -660,10 +851,19 @@ func (c *Client) generateURL(bucketName string, objectName string, relativePath
 }
 
 // signRequest signs the request and set authorization before send to server
-func (c *Client) signRequest(req *http.Request) error {
+func (c *Client) signRequest(req *http.Request, account *types.Account) error {
+	signer, err := c.getSigner(account)
+	if err != nil {
+		return err
+	}
+	if (c.offChainAuthOption != nil || c.offChainAuthOptionV2 != nil) && account != nil &&
+		(c.defaultAccount == nil || !account.GetAddress().Equals(c.defaultAccount.GetAddress())) {
+		return types.ErrorOffChainAuthAccountOverride
+	}
+
 	// use offChainAuth if OffChainAuthOption is set
 	if c.offChainAuthOption != nil {
-		req.Header.Set("X-Gnfd-User-Address", c.defaultAccount.GetAddress().String())
+		req.Header.Set("X-Gnfd-User-Address", signer.GetAddress().String())
 		req.Header.Set("X-Gnfd-App-Domain", c.offChainAuthOption.Domain)
 		unsignedMsg := httplib.GetMsgToSignInGNFD1Auth(req)
 		authStr := c.OffChainAuthSign(unsignedMsg)
@@ -674,7 +874,7 @@ func (c *Client) signRequest(req *http.Request) error {
 
 	// use offChainAuth if OffChainAuthOptionV2 is set
 	if c.offChainAuthOptionV2 != nil {
-		req.Header.Set("X-Gnfd-User-Address", c.defaultAccount.GetAddress().String())
+		req.Header.Set("X-Gnfd-User-Address", signer.GetAddress().String())
 		req.Header.Set("X-Gnfd-App-Domain", c.offChainAuthOptionV2.Domain)
 		req.Header.Set("X-Gnfd-App-Reg-Public-Key", c.offChainAuthOptionV2.PublicKey)
 		unsignedMsg := httplib.GetMsgToSignInGNFD1Auth(req)
@@ -687,7 +887,7 @@ func (c *Client) signRequest(req *http.Request) error {
 	unsignedMsg := httplib.GetMsgToSignInGNFD1Auth(req)
 
 	// sign the request header info, generate the signature
-	signature, err := c.MustGetDefaultAccount().Sign(unsignedMsg)
+	signature, err := signer.Sign(unsignedMsg)
 	if err != nil {
 		return err
 	}
@@ -40,8 +40,15 @@ type ICrossChainClient interface {
 // - ret1: Transaction response from Greenfield.
 //
 // - ret2: Return error if transaction failed, otherwise return nil.
-func (c *Client) TransferOut(ctx context.Context, toAddress string, amount math.Int, txOption gnfdSdkTypes.TxOption) (*sdk.TxResponse, error) {
-	msgTransferOut := bridgetypes.NewMsgTransferOut(c.MustGetDefaultAccount().GetAddress().String(),
+func (c *Client) TransferOut(ctx context.Context, toAddress string, amount math.Int, txOption gnfdSdkTypes.TxOption) (_ *sdk.TxResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.TransferOut")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return nil, err
+	}
+	msgTransferOut := bridgetypes.NewMsgTransferOut(sender.String(),
 		toAddress,
 		&sdk.Coin{Denom: gnfdSdkTypes.Denom, Amount: amount},
 	)
@@ -77,9 +84,16 @@ func (c *Client) TransferOut(ctx context.Context, toAddress string, amount math.
 // - ret2: Return error if transaction failed, otherwise return nil.
 func (c *Client) Claims(ctx context.Context, srcChainId, destChainId uint32, sequence uint64,
 	timestamp uint64, payload []byte, voteAddrSet []uint64, aggSignature []byte, txOption gnfdSdkTypes.TxOption,
-) (*sdk.TxResponse, error) {
+) (_ *sdk.TxResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.Claims")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return nil, err
+	}
 	msg := oracletypes.NewMsgClaim(
-		c.MustGetDefaultAccount().GetAddress().String(),
+		sender.String(),
 		srcChainId,
 		destChainId,
 		sequence,
@@ -106,7 +120,10 @@ func (c *Client) Claims(ctx context.Context, srcChainId, destChainId uint32, seq
 // - ret1: Send sequence of the channel.
 //
 // - ret2: Return error if the query failed, otherwise return nil.
-func (c *Client) GetChannelSendSequence(ctx context.Context, destChainId sdk.ChainID, channelId uint32) (uint64, error) {
+func (c *Client) GetChannelSendSequence(ctx context.Context, destChainId sdk.ChainID, channelId uint32) (_ uint64, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetChannelSendSequence")
+	defer func() { endSpan(span, err) }()
+
 	resp, err := c.chainClient.CrosschainQueryClient.SendSequence(
 		ctx,
 		&crosschaintypes.QuerySendSequenceRequest{
@@ -131,7 +148,10 @@ func (c *Client) GetChannelSendSequence(ctx context.Context, destChainId sdk.Cha
 // - ret1: Send sequence of the channel.
 //
 // - ret2: Return error if the query failed, otherwise return nil.
-func (c *Client) GetChannelReceiveSequence(ctx context.Context, destChainId sdk.ChainID, channelId uint32) (uint64, error) {
+func (c *Client) GetChannelReceiveSequence(ctx context.Context, destChainId sdk.ChainID, channelId uint32) (_ uint64, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetChannelReceiveSequence")
+	defer func() { endSpan(span, err) }()
+
 	resp, err := c.chainClient.CrosschainQueryClient.ReceiveSequence(
 		ctx,
 		&crosschaintypes.QueryReceiveSequenceRequest{
@@ -154,7 +174,10 @@ func (c *Client) GetChannelReceiveSequence(ctx context.Context, destChainId sdk.
 // - ret1: The response of the `QueryInturnRelayerRequest` query.
 //
 // - ret2: Return error if the query failed, otherwise return nil.
-func (c *Client) GetInturnRelayer(ctx context.Context, req *oracletypes.QueryInturnRelayerRequest) (*oracletypes.QueryInturnRelayerResponse, error) {
+func (c *Client) GetInturnRelayer(ctx context.Context, req *oracletypes.QueryInturnRelayerRequest) (_ *oracletypes.QueryInturnRelayerResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetInturnRelayer")
+	defer func() { endSpan(span, err) }()
+
 	return c.chainClient.InturnRelayer(ctx, req)
 }
 
@@ -171,7 +194,10 @@ func (c *Client) GetInturnRelayer(ctx context.Context, req *oracletypes.QueryInt
 // - ret1: The bytes of the cross-chain package.
 //
 // - ret2: Return error if the query failed, otherwise return nil.
-func (c *Client) GetCrossChainPackage(ctx context.Context, destChainId sdk.ChainID, channelId uint32, sequence uint64) ([]byte, error) {
+func (c *Client) GetCrossChainPackage(ctx context.Context, destChainId sdk.ChainID, channelId uint32, sequence uint64) (_ []byte, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetCrossChainPackage")
+	defer func() { endSpan(span, err) }()
+
 	resp, err := c.chainClient.CrossChainPackage(
 		ctx,
 		&crosschaintypes.QueryCrossChainPackageRequest{
@@ -201,8 +227,15 @@ func (c *Client) GetCrossChainPackage(ctx context.Context, destChainId sdk.Chain
 // - ret1: Transaction response from Greenfield.
 //
 // - ret2: Return error if the transaction failed, otherwise return nil.
-func (c *Client) MirrorGroup(ctx context.Context, destChainId sdk.ChainID, groupId math.Uint, groupName string, txOption gnfdSdkTypes.TxOption) (*sdk.TxResponse, error) {
-	msgMirrorGroup := storagetypes.NewMsgMirrorGroup(c.MustGetDefaultAccount().GetAddress(), destChainId, groupId, groupName)
+func (c *Client) MirrorGroup(ctx context.Context, destChainId sdk.ChainID, groupId math.Uint, groupName string, txOption gnfdSdkTypes.TxOption) (_ *sdk.TxResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.MirrorGroup")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return nil, err
+	}
+	msgMirrorGroup := storagetypes.NewMsgMirrorGroup(sender, destChainId, groupId, groupName)
 	txResp, err := c.BroadcastTx(ctx, []sdk.Msg{msgMirrorGroup}, &txOption)
 	if err != nil {
 		return nil, err
@@ -225,8 +258,15 @@ func (c *Client) MirrorGroup(ctx context.Context, destChainId sdk.ChainID, group
 // - ret1: Transaction response from Greenfield.
 //
 // - ret2: Return error if the transaction failed, otherwise return nil.
-func (c *Client) MirrorBucket(ctx context.Context, destChainId sdk.ChainID, bucketId math.Uint, bucketName string, txOption gnfdSdkTypes.TxOption) (*sdk.TxResponse, error) {
-	msgMirrorBucket := storagetypes.NewMsgMirrorBucket(c.MustGetDefaultAccount().GetAddress(), destChainId, bucketId, bucketName)
+func (c *Client) MirrorBucket(ctx context.Context, destChainId sdk.ChainID, bucketId math.Uint, bucketName string, txOption gnfdSdkTypes.TxOption) (_ *sdk.TxResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.MirrorBucket", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return nil, err
+	}
+	msgMirrorBucket := storagetypes.NewMsgMirrorBucket(sender, destChainId, bucketId, bucketName)
 	txResp, err := c.BroadcastTx(ctx, []sdk.Msg{msgMirrorBucket}, &txOption)
 	if err != nil {
 		return nil, err
@@ -251,8 +291,15 @@ func (c *Client) MirrorBucket(ctx context.Context, destChainId sdk.ChainID, buck
 // - ret1: Transaction response from Greenfield.
 //
 // - ret2: Return error if the transaction failed, otherwise return nil.
-func (c *Client) MirrorObject(ctx context.Context, destChainId sdk.ChainID, objectId math.Uint, bucketName, objectName string, txOption gnfdSdkTypes.TxOption) (*sdk.TxResponse, error) {
-	msgMirrorObject := storagetypes.NewMsgMirrorObject(c.MustGetDefaultAccount().GetAddress(), destChainId, objectId, bucketName, objectName)
+func (c *Client) MirrorObject(ctx context.Context, destChainId sdk.ChainID, objectId math.Uint, bucketName, objectName string, txOption gnfdSdkTypes.TxOption) (_ *sdk.TxResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.MirrorObject", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return nil, err
+	}
+	msgMirrorObject := storagetypes.NewMsgMirrorObject(sender, destChainId, objectId, bucketName, objectName)
 	txResp, err := c.BroadcastTx(ctx, []sdk.Msg{msgMirrorObject}, &txOption)
 	if err != nil {
 		return nil, err
@@ -27,12 +27,19 @@ type IDistributionClient interface {
 // - ret1: Transaction hash of the transaction.
 //
 // - ret2: Return error if the transaction failed, otherwise return nil.
-func (c *Client) SetWithdrawAddress(ctx context.Context, withdrawAddr string, txOption gnfdsdktypes.TxOption) (string, error) {
+func (c *Client) SetWithdrawAddress(ctx context.Context, withdrawAddr string, txOption gnfdsdktypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.SetWithdrawAddress")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
 	withdraw, err := sdk.AccAddressFromHexUnsafe(withdrawAddr)
 	if err != nil {
 		return "", err
 	}
-	msg := distrtypes.NewMsgSetWithdrawAddress(c.MustGetDefaultAccount().GetAddress(), withdraw)
+	msg := distrtypes.NewMsgSetWithdrawAddress(sender, withdraw)
 	resp, err := c.BroadcastTx(ctx, []sdk.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
@@ -49,8 +56,15 @@ func (c *Client) SetWithdrawAddress(ctx context.Context, withdrawAddr string, tx
 // - ret1: Transaction hash of the transaction.
 //
 // - ret2: Return error if the transaction failed, otherwise return nil.
-func (c *Client) WithdrawValidatorCommission(ctx context.Context, txOption gnfdsdktypes.TxOption) (string, error) {
-	msg := distrtypes.NewMsgWithdrawValidatorCommission(c.MustGetDefaultAccount().GetAddress())
+func (c *Client) WithdrawValidatorCommission(ctx context.Context, txOption gnfdsdktypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.WithdrawValidatorCommission")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
+	msg := distrtypes.NewMsgWithdrawValidatorCommission(sender)
 	resp, err := c.BroadcastTx(ctx, []sdk.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
@@ -69,12 +83,19 @@ func (c *Client) WithdrawValidatorCommission(ctx context.Context, txOption gnfds
 // - ret1: Transaction hash of the transaction.
 //
 // - ret2: Return error if the transaction failed, otherwise return nil.
-func (c *Client) WithdrawDelegatorReward(ctx context.Context, validatorAddr string, txOption gnfdsdktypes.TxOption) (string, error) {
+func (c *Client) WithdrawDelegatorReward(ctx context.Context, validatorAddr string, txOption gnfdsdktypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.WithdrawDelegatorReward")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
 	validator, err := sdk.AccAddressFromHexUnsafe(validatorAddr)
 	if err != nil {
 		return "", err
 	}
-	msg := distrtypes.NewMsgWithdrawDelegatorReward(c.MustGetDefaultAccount().GetAddress(), validator)
+	msg := distrtypes.NewMsgWithdrawDelegatorReward(sender, validator)
 	resp, err := c.BroadcastTx(ctx, []sdk.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
@@ -93,8 +114,15 @@ func (c *Client) WithdrawDelegatorReward(ctx context.Context, validatorAddr stri
 // - ret1: Transaction hash of the transaction.
 //
 // - ret2: Return error if the transaction failed, otherwise return nil.
-func (c *Client) FundCommunityPool(ctx context.Context, amount math.Int, txOption gnfdsdktypes.TxOption) (string, error) {
-	msg := distrtypes.NewMsgFundCommunityPool(sdk.Coins{sdk.Coin{Denom: gnfdsdktypes.Denom, Amount: amount}}, c.MustGetDefaultAccount().GetAddress())
+func (c *Client) FundCommunityPool(ctx context.Context, amount math.Int, txOption gnfdsdktypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.FundCommunityPool")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
+	msg := distrtypes.NewMsgFundCommunityPool(sdk.Coins{sdk.Coin{Denom: gnfdsdktypes.Denom, Amount: amount}}, sender)
 	resp, err := c.BroadcastTx(ctx, []sdk.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
@@ -23,7 +23,14 @@ type IFeeGrantClient interface {
 }
 
 // GrantBasicAllowance grants the grantee the BasicAllowance with specified amount and expiration.
-func (c *Client) GrantBasicAllowance(ctx context.Context, granteeAddr string, feeAllowanceAmount math.Int, expiration *time.Time, txOption gnfdsdktypes.TxOption) (string, error) {
+func (c *Client) GrantBasicAllowance(ctx context.Context, granteeAddr string, feeAllowanceAmount math.Int, expiration *time.Time, txOption gnfdsdktypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.GrantBasicAllowance")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
 	grantee, err := sdk.AccAddressFromHexUnsafe(granteeAddr)
 	if err != nil {
 		return "", err
@@ -33,7 +40,7 @@ func (c *Client) GrantBasicAllowance(ctx context.Context, granteeAddr string, fe
 		SpendLimit: bnb,
 		Expiration: expiration,
 	}
-	msg, err := feegrant.NewMsgGrantAllowance(&allowance, c.defaultAccount.GetAddress(), grantee)
+	msg, err := feegrant.NewMsgGrantAllowance(&allowance, sender, grantee)
 	if err != nil {
 		return "", err
 	}
@@ -45,12 +52,19 @@ func (c *Client) GrantBasicAllowance(ctx context.Context, granteeAddr string, fe
 }
 
 // GrantAllowance provides a generic way to grant different types of allowance(BasicAllowance, PeriodicAllowance, AllowedMsgAllowance), the user needs to construct the desired type of allowance
-func (c *Client) GrantAllowance(ctx context.Context, granteeAddr string, allowance feegrant.FeeAllowanceI, txOption gnfdsdktypes.TxOption) (string, error) {
+func (c *Client) GrantAllowance(ctx context.Context, granteeAddr string, allowance feegrant.FeeAllowanceI, txOption gnfdsdktypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.GrantAllowance")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
 	grantee, err := sdk.AccAddressFromHexUnsafe(granteeAddr)
 	if err != nil {
 		return "", err
 	}
-	msg, err := feegrant.NewMsgGrantAllowance(allowance, c.defaultAccount.GetAddress(), grantee)
+	msg, err := feegrant.NewMsgGrantAllowance(allowance, sender, grantee)
 	if err != nil {
 		return "", err
 	}
@@ -62,12 +76,19 @@ func (c *Client) GrantAllowance(ctx context.Context, granteeAddr string, allowan
 }
 
 // RevokeAllowance revokes allowance on a grantee by the granter
-func (c *Client) RevokeAllowance(ctx context.Context, granteeAddr string, txOption gnfdsdktypes.TxOption) (string, error) {
+func (c *Client) RevokeAllowance(ctx context.Context, granteeAddr string, txOption gnfdsdktypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.RevokeAllowance")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
 	grantee, err := sdk.AccAddressFromHexUnsafe(granteeAddr)
 	if err != nil {
 		return "", err
 	}
-	msg := feegrant.NewMsgRevokeAllowance(c.defaultAccount.GetAddress(), grantee)
+	msg := feegrant.NewMsgRevokeAllowance(sender, grantee)
 	if err != nil {
 		return "", err
 	}
@@ -79,7 +100,10 @@ func (c *Client) RevokeAllowance(ctx context.Context, granteeAddr string, txOpti
 }
 
 // QueryBasicAllowance queries the BasicAllowance
-func (c *Client) QueryBasicAllowance(ctx context.Context, granterAddr, granteeAddr string) (*feegrant.BasicAllowance, error) {
+func (c *Client) QueryBasicAllowance(ctx context.Context, granterAddr, granteeAddr string) (_ *feegrant.BasicAllowance, err error) {
+	ctx, span := c.startSpan(ctx, "client.QueryBasicAllowance")
+	defer func() { endSpan(span, err) }()
+
 	allowance, err := c.QueryAllowance(ctx, granterAddr, granteeAddr)
 	if err != nil {
 		return nil, err
@@ -91,8 +115,11 @@ func (c *Client) QueryBasicAllowance(ctx context.Context, granterAddr, granteeAd
 	return basicAllowance, nil
 }
 
-func (c *Client) QueryAllowance(ctx context.Context, granterAddr, granteeAddr string) (*feegrant.Grant, error) {
-	_, err := sdk.AccAddressFromHexUnsafe(granterAddr)
+func (c *Client) QueryAllowance(ctx context.Context, granterAddr, granteeAddr string) (_ *feegrant.Grant, err error) {
+	ctx, span := c.startSpan(ctx, "client.QueryAllowance")
+	defer func() { endSpan(span, err) }()
+
+	_, err = sdk.AccAddressFromHexUnsafe(granterAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -111,8 +138,11 @@ func (c *Client) QueryAllowance(ctx context.Context, granterAddr, granteeAddr st
 	return response.Allowance, nil
 }
 
-func (c *Client) QueryAllowances(ctx context.Context, granteeAddr string) ([]*feegrant.Grant, error) {
-	_, err := sdk.AccAddressFromHexUnsafe(granteeAddr)
+func (c *Client) QueryAllowances(ctx context.Context, granteeAddr string) (_ []*feegrant.Grant, err error) {
+	ctx, span := c.startSpan(ctx, "client.QueryAllowances")
+	defer func() { endSpan(span, err) }()
+
+	_, err = sdk.AccAddressFromHexUnsafe(granteeAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -126,8 +156,11 @@ func (c *Client) QueryAllowances(ctx context.Context, granteeAddr string) ([]*fe
 	return response.Allowances, nil
 }
 
-func (c *Client) QueryGranterAllowances(ctx context.Context, granterAddr string) ([]*feegrant.Grant, error) {
-	_, err := sdk.AccAddressFromHexUnsafe(granterAddr)
+func (c *Client) QueryGranterAllowances(ctx context.Context, granterAddr string) (_ []*feegrant.Grant, err error) {
+	ctx, span := c.startSpan(ctx, "client.QueryGranterAllowances")
+	defer func() { endSpan(span, err) }()
+
+	_, err = sdk.AccAddressFromHexUnsafe(granterAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -21,6 +21,7 @@ import (
 	"github.com/bnb-chain/greenfield-go-sdk/types"
 	gnfdsdk "github.com/bnb-chain/greenfield/sdk/types"
 	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	resourcetypes "github.com/bnb-chain/greenfield/types/resource"
 	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
 	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
 )
@@ -34,6 +35,7 @@ type IGroupClient interface {
 	LeaveGroup(ctx context.Context, groupName string, groupOwnerAddr string, opt types.LeaveGroupOption) (string, error)
 	HeadGroup(ctx context.Context, groupName string, groupOwnerAddr string) (*storageTypes.GroupInfo, error)
 	HeadGroupMember(ctx context.Context, groupName string, groupOwner, headMember string) bool
+	GetGroupMember(ctx context.Context, groupName string, groupOwnerAddr, memberAddr string) (*permTypes.GroupMember, error)
 	PutGroupPolicy(ctx context.Context, groupName string, principalAddr string, statements []*permTypes.Statement, opt types.PutPolicyOption) (string, error)
 	DeleteGroupPolicy(ctx context.Context, groupName string, principalAddr string, opt types.DeletePolicyOption) (string, error)
 	GetBucketPolicyOfGroup(ctx context.Context, bucketName string, groupId uint64) (*permTypes.Policy, error)
@@ -45,6 +47,10 @@ type IGroupClient interface {
 	ListGroupsByAccount(ctx context.Context, opts types.GroupsPaginationOptions) (*types.GroupsResult, error)
 	ListGroupsByOwner(ctx context.Context, opts types.GroupsOwnerPaginationOptions) (*types.GroupsResult, error)
 	ListGroupsByGroupID(ctx context.Context, groupIDs []uint64, opts types.EndPointOptions) (types.ListGroupsByGroupIDResponse, error)
+	BulkUpdateGroupMember(ctx context.Context, groupName, groupOwnerAddr string, addMembers []types.DesiredMember,
+		removeAddresses []string, opts types.BulkUpdateGroupMemberOptions) (*types.BulkGroupMemberUpdateResult, error)
+	SyncGroupMembers(ctx context.Context, groupOwnerAddr, groupName string, desired []types.DesiredMember,
+		opts types.SyncGroupMembersOptions) (*types.GroupMemberSyncReport, error)
 }
 
 // CreateGroup - Create a new group without group members on Greenfield blockchain, and group members can be added by UpdateGroupMember transaction.
@@ -70,8 +76,15 @@ type IGroupClient interface {
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret3: Return error when the request failed, otherwise return nil.
-func (c *Client) CreateGroup(ctx context.Context, groupName string, opt types.CreateGroupOptions) (string, error) {
-	createGroupMsg := storageTypes.NewMsgCreateGroup(c.MustGetDefaultAccount().GetAddress(), groupName, opt.Extra)
+func (c *Client) CreateGroup(ctx context.Context, groupName string, opt types.CreateGroupOptions) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.CreateGroup")
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	createGroupMsg := storageTypes.NewMsgCreateGroup(signer.GetAddress(), groupName, opt.Extra)
 	// set the default txn broadcast mode as block mode
 	if opt.TxOpts == nil {
 		broadcastMode := tx.BroadcastMode_BROADCAST_MODE_SYNC
@@ -81,12 +94,12 @@ func (c *Client) CreateGroup(ctx context.Context, groupName string, opt types.Cr
 
 	if opt.Tags != nil {
 		// Set tag
-		grn := gnfdTypes.NewGroupGRN(c.MustGetDefaultAccount().GetAddress(), groupName)
-		msgSetTag := storageTypes.NewMsgSetTag(c.MustGetDefaultAccount().GetAddress(), grn.String(), opt.Tags)
+		grn := gnfdTypes.NewGroupGRN(signer.GetAddress(), groupName)
+		msgSetTag := storageTypes.NewMsgSetTag(signer.GetAddress(), grn.String(), opt.Tags)
 		msgs = append(msgs, msgSetTag)
 	}
 
-	resp, err := c.BroadcastTx(ctx, msgs, opt.TxOpts)
+	resp, err := c.BroadcastTx(ctx, msgs, signerTxOpts(opt.TxOpts, signer))
 	if err != nil {
 		return "", err
 	}
@@ -108,9 +121,16 @@ func (c *Client) CreateGroup(ctx context.Context, groupName string, opt types.Cr
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret3: Return error when the request failed, otherwise return nil.
-func (c *Client) DeleteGroup(ctx context.Context, groupName string, opt types.DeleteGroupOption) (string, error) {
-	deleteGroupMsg := storageTypes.NewMsgDeleteGroup(c.MustGetDefaultAccount().GetAddress(), groupName)
-	return c.sendTxn(ctx, deleteGroupMsg, opt.TxOpts)
+func (c *Client) DeleteGroup(ctx context.Context, groupName string, opt types.DeleteGroupOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.DeleteGroup")
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	deleteGroupMsg := storageTypes.NewMsgDeleteGroup(signer.GetAddress(), groupName)
+	return c.sendTxn(ctx, deleteGroupMsg, signerTxOpts(opt.TxOpts, signer))
 }
 
 // UpdateGroupMember - Update a group by adding or removing members. The sender can be the group owner or any individual account(Principle) that
@@ -136,7 +156,14 @@ func (c *Client) DeleteGroup(ctx context.Context, groupName string, opt types.De
 // - ret2: Return error when the request failed, otherwise return nil.
 func (c *Client) UpdateGroupMember(ctx context.Context, groupName string, groupOwnerAddr string,
 	addAddresses, removeAddresses []string, opts types.UpdateGroupMemberOption,
-) (string, error) {
+) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.UpdateGroupMember")
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opts.Account)
+	if err != nil {
+		return "", err
+	}
 	groupOwner, err := sdk.AccAddressFromHexUnsafe(groupOwnerAddr)
 	if err != nil {
 		return "", err
@@ -178,9 +205,9 @@ func (c *Client) UpdateGroupMember(ctx context.Context, groupName string, groupO
 		removeMembers = append(removeMembers, member)
 	}
 
-	updateGroupMsg := storageTypes.NewMsgUpdateGroupMember(c.MustGetDefaultAccount().GetAddress(), groupOwner, groupName, addMembers, removeMembers)
+	updateGroupMsg := storageTypes.NewMsgUpdateGroupMember(signer.GetAddress(), groupOwner, groupName, addMembers, removeMembers)
 
-	return c.sendTxn(ctx, updateGroupMsg, opts.TxOpts)
+	return c.sendTxn(ctx, updateGroupMsg, signerTxOpts(opts.TxOpts, signer))
 }
 
 // LeaveGroup - Leave a group. A group member initially leaves a group.
@@ -196,13 +223,20 @@ func (c *Client) UpdateGroupMember(ctx context.Context, groupName string, groupO
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) LeaveGroup(ctx context.Context, groupName string, groupOwnerAddr string, opt types.LeaveGroupOption) (string, error) {
+func (c *Client) LeaveGroup(ctx context.Context, groupName string, groupOwnerAddr string, opt types.LeaveGroupOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.LeaveGroup")
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
 	groupOwner, err := sdk.AccAddressFromHexUnsafe(groupOwnerAddr)
 	if err != nil {
 		return "", err
 	}
-	leaveGroupMsg := storageTypes.NewMsgLeaveGroup(c.MustGetDefaultAccount().GetAddress(), groupOwner, groupName)
-	return c.sendTxn(ctx, leaveGroupMsg, opt.TxOpts)
+	leaveGroupMsg := storageTypes.NewMsgLeaveGroup(signer.GetAddress(), groupOwner, groupName)
+	return c.sendTxn(ctx, leaveGroupMsg, signerTxOpts(opt.TxOpts, signer))
 }
 
 // HeadGroup - Query the groupInfo on chain, return the group info if exists otherwise error.
@@ -216,7 +250,10 @@ func (c *Client) LeaveGroup(ctx context.Context, groupName string, groupOwnerAdd
 // - ret1: The group info details
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) HeadGroup(ctx context.Context, groupName string, groupOwnerAddr string) (*storageTypes.GroupInfo, error) {
+func (c *Client) HeadGroup(ctx context.Context, groupName string, groupOwnerAddr string) (_ *storageTypes.GroupInfo, err error) {
+	ctx, span := c.startSpan(ctx, "client.HeadGroup")
+	defer func() { endSpan(span, err) }()
+
 	headGroupRequest := storageTypes.QueryHeadGroupRequest{
 		GroupOwner: groupOwnerAddr,
 		GroupName:  groupName,
@@ -242,6 +279,9 @@ func (c *Client) HeadGroup(ctx context.Context, groupName string, groupOwnerAddr
 //
 // - ret: The boolean value indicates whether the group member exists
 func (c *Client) HeadGroupMember(ctx context.Context, groupName string, groupOwnerAddr, headMemberAddr string) bool {
+	ctx, span := c.startSpan(ctx, "client.HeadGroupMember")
+	defer span.End()
+
 	headGroupRequest := storageTypes.QueryHeadGroupMemberRequest{
 		GroupName:  groupName,
 		GroupOwner: groupOwnerAddr,
@@ -252,6 +292,34 @@ func (c *Client) HeadGroupMember(ctx context.Context, groupName string, groupOwn
 	return err == nil
 }
 
+// GetGroupMember - Query the group member info on chain, including the expiration time of the member.
+//
+// - ctx: Context variables for the current API call.
+//
+// - groupName: The group name identifies the group.
+//
+// - groupOwnerAddr: The HEX-encoded string of the group owner address.
+//
+// - memberAddr: The HEX-encoded string of the group member address.
+//
+// - ret1: The group member info.
+//
+// - ret2: Return error when the query failed, types.IsNotFound reports true if the account is not a member.
+func (c *Client) GetGroupMember(ctx context.Context, groupName string, groupOwnerAddr, memberAddr string) (_ *permTypes.GroupMember, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetGroupMember")
+	defer func() { endSpan(span, err) }()
+
+	resp, err := c.chainClient.HeadGroupMember(ctx, &storageTypes.QueryHeadGroupMemberRequest{
+		GroupName:  groupName,
+		GroupOwner: groupOwnerAddr,
+		Member:     memberAddr,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GroupMember, nil
+}
+
 // PutGroupPolicy - Apply group policy to user specified by principalAddr, the sender needs to be the owner of the group.
 //
 // - ctx: Context variables for the current API call.
@@ -269,8 +337,18 @@ func (c *Client) HeadGroupMember(ctx context.Context, groupName string, groupOwn
 // - ret2: Return error when the request failed, otherwise return nil.
 func (c *Client) PutGroupPolicy(ctx context.Context, groupName string, principalAddr string,
 	statements []*permTypes.Statement, opt types.PutPolicyOption,
-) (string, error) {
-	sender := c.MustGetDefaultAccount().GetAddress()
+) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.PutGroupPolicy")
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	sender := signer.GetAddress()
+	if err = types.ValidateStatements(resourcetypes.RESOURCE_TYPE_GROUP, statements); err != nil {
+		return "", err
+	}
 
 	resource := gnfdTypes.NewGroupGRN(sender, groupName)
 
@@ -282,7 +360,7 @@ func (c *Client) PutGroupPolicy(ctx context.Context, groupName string, principal
 	putPolicyMsg := storageTypes.NewMsgPutPolicy(sender, resource.String(),
 		permTypes.NewPrincipalWithAccount(principal), statements, opt.PolicyExpireTime)
 
-	return c.sendPutPolicyTxn(ctx, putPolicyMsg, opt.TxOpts)
+	return c.sendPutPolicyTxn(ctx, putPolicyMsg, signerTxOpts(opt.TxOpts, signer))
 }
 
 // GetBucketPolicyOfGroup - Get the bucket policy info of the group.
@@ -296,7 +374,10 @@ func (c *Client) PutGroupPolicy(ctx context.Context, groupName string, principal
 // - ret1: The bucket policy.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetBucketPolicyOfGroup(ctx context.Context, bucketName string, groupId uint64) (*permTypes.Policy, error) {
+func (c *Client) GetBucketPolicyOfGroup(ctx context.Context, bucketName string, groupId uint64) (_ *permTypes.Policy, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetBucketPolicyOfGroup", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
 	resource := gnfdTypes.NewBucketGRN(bucketName).String()
 
 	queryPolicy := storageTypes.QueryPolicyForGroupRequest{
@@ -325,7 +406,10 @@ func (c *Client) GetBucketPolicyOfGroup(ctx context.Context, bucketName string,
 // - ret1: The object policy
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetObjectPolicyOfGroup(ctx context.Context, bucketName, objectName string, groupId uint64) (*permTypes.Policy, error) {
+func (c *Client) GetObjectPolicyOfGroup(ctx context.Context, bucketName, objectName string, groupId uint64) (_ *permTypes.Policy, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetObjectPolicyOfGroup", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
 	resource := gnfdTypes.NewObjectGRN(bucketName, objectName)
 	queryPolicy := storageTypes.QueryPolicyForGroupRequest{
 		Resource:         resource.String(),
@@ -353,8 +437,15 @@ func (c *Client) GetObjectPolicyOfGroup(ctx context.Context, bucketName, objectN
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) DeleteGroupPolicy(ctx context.Context, groupName string, principalAddr string, opt types.DeletePolicyOption) (string, error) {
-	sender := c.MustGetDefaultAccount().GetAddress()
+func (c *Client) DeleteGroupPolicy(ctx context.Context, groupName string, principalAddr string, opt types.DeletePolicyOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.DeleteGroupPolicy")
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	sender := signer.GetAddress()
 	resource := gnfdTypes.NewGroupGRN(sender, groupName).String()
 
 	addr, err := sdk.AccAddressFromHexUnsafe(principalAddr)
@@ -364,7 +455,7 @@ func (c *Client) DeleteGroupPolicy(ctx context.Context, groupName string, princi
 
 	principal := permTypes.NewPrincipalWithAccount(addr)
 
-	return c.sendDelPolicyTxn(ctx, sender, resource, principal, opt.TxOpts)
+	return c.sendDelPolicyTxn(ctx, sender, resource, principal, signerTxOpts(opt.TxOpts, signer))
 }
 
 // GetGroupPolicy - Get the group policy info of the user.
@@ -378,13 +469,19 @@ func (c *Client) DeleteGroupPolicy(ctx context.Context, groupName string, princi
 // - ret1: The group policy.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetGroupPolicy(ctx context.Context, groupName string, principalAddr string) (*permTypes.Policy, error) {
-	_, err := sdk.AccAddressFromHexUnsafe(principalAddr)
+func (c *Client) GetGroupPolicy(ctx context.Context, groupName string, principalAddr string) (_ *permTypes.Policy, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetGroupPolicy")
+	defer func() { endSpan(span, err) }()
+
+	_, err = sdk.AccAddressFromHexUnsafe(principalAddr)
 	if err != nil {
 		return nil, err
 	}
-	sender := c.MustGetDefaultAccount().GetAddress()
-	resource := gnfdTypes.NewGroupGRN(sender, groupName).String()
+	owner, err := c.GetDefaultAccount()
+	if err != nil {
+		return nil, err
+	}
+	resource := gnfdTypes.NewGroupGRN(owner.GetAddress(), groupName).String()
 
 	queryPolicy := storageTypes.QueryPolicyForAccountRequest{
 		Resource:         resource,
@@ -408,7 +505,10 @@ func (c *Client) GetGroupPolicy(ctx context.Context, groupName string, principal
 // - ret1: The groups response.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) ListGroup(ctx context.Context, name, prefix string, opts types.ListGroupsOptions) (types.ListGroupsResult, error) {
+func (c *Client) ListGroup(ctx context.Context, name, prefix string, opts types.ListGroupsOptions) (_ types.ListGroupsResult, err error) {
+	ctx, span := c.startSpan(ctx, "client.ListGroup")
+	defer func() { endSpan(span, err) }()
+
 	const (
 		MaximumGetGroupListLimit  = 1000
 		MaximumGetGroupListOffset = 100000
@@ -510,7 +610,14 @@ func (c *Client) ListGroup(ctx context.Context, name, prefix string, opts types.
 // - ret2: Return error when the request failed, otherwise return nil.
 func (c *Client) RenewGroupMember(ctx context.Context, groupOwnerAddr, groupName string,
 	memberAddresses []string, opts types.RenewGroupMemberOption,
-) (string, error) {
+) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.RenewGroupMember")
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opts.Account)
+	if err != nil {
+		return "", err
+	}
 	groupOwner, err := sdk.AccAddressFromHexUnsafe(groupOwnerAddr)
 	if err != nil {
 		return "", err
@@ -539,8 +646,8 @@ func (c *Client) RenewGroupMember(ctx context.Context, groupOwnerAddr, groupName
 		}
 		renewMembers = append(renewMembers, m)
 	}
-	msg := storageTypes.NewMsgRenewGroupMember(c.MustGetDefaultAccount().GetAddress(), groupOwner, groupName, renewMembers)
-	return c.sendTxn(ctx, msg, opts.TxOpts)
+	msg := storageTypes.NewMsgRenewGroupMember(signer.GetAddress(), groupOwner, groupName, renewMembers)
+	return c.sendTxn(ctx, msg, signerTxOpts(opts.TxOpts, signer))
 }
 
 // ListGroupMembers - List members within a group, including those for which the user's expiration time has already elapsed.
@@ -550,7 +657,10 @@ func (c *Client) RenewGroupMember(ctx context.Context, groupOwnerAddr, groupName
 // - ret1: Group members detail.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) ListGroupMembers(ctx context.Context, groupID int64, opts types.GroupMembersPaginationOptions) (*types.GroupMembersResult, error) {
+func (c *Client) ListGroupMembers(ctx context.Context, groupID int64, opts types.GroupMembersPaginationOptions) (_ *types.GroupMembersResult, err error) {
+	ctx, span := c.startSpan(ctx, "client.ListGroupMembers")
+	defer func() { endSpan(span, err) }()
+
 	params := url.Values{}
 	params.Set("group-members", "")
 	params.Set("group-id", strconv.FormatInt(groupID, 10))
@@ -611,7 +721,10 @@ func (c *Client) ListGroupMembers(ctx context.Context, groupID int64, opts types
 // - ret1: Groups details.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) ListGroupsByAccount(ctx context.Context, opts types.GroupsPaginationOptions) (*types.GroupsResult, error) {
+func (c *Client) ListGroupsByAccount(ctx context.Context, opts types.GroupsPaginationOptions) (_ *types.GroupsResult, err error) {
+	ctx, span := c.startSpan(ctx, "client.ListGroupsByAccount")
+	defer func() { endSpan(span, err) }()
+
 	params := url.Values{}
 	params.Set("user-groups", "")
 	params.Set("start-after", opts.StartAfter)
@@ -682,7 +795,10 @@ func (c *Client) ListGroupsByAccount(ctx context.Context, opts types.GroupsPagin
 // - ret1: Groups details.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) ListGroupsByOwner(ctx context.Context, opts types.GroupsOwnerPaginationOptions) (*types.GroupsResult, error) {
+func (c *Client) ListGroupsByOwner(ctx context.Context, opts types.GroupsOwnerPaginationOptions) (_ *types.GroupsResult, err error) {
+	ctx, span := c.startSpan(ctx, "client.ListGroupsByOwner")
+	defer func() { endSpan(span, err) }()
+
 	params := url.Values{}
 	params.Set("owned-groups", "")
 	params.Set("start-after", opts.StartAfter)
@@ -782,7 +898,10 @@ func (m *gfSpListGroupsByGroupIDsResponse) UnmarshalXML(d *xml.Decoder, start xm
 // - ret1: The result of group info map by given group ids.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) ListGroupsByGroupID(ctx context.Context, groupIDs []uint64, opts types.EndPointOptions) (types.ListGroupsByGroupIDResponse, error) {
+func (c *Client) ListGroupsByGroupID(ctx context.Context, groupIDs []uint64, opts types.EndPointOptions) (_ types.ListGroupsByGroupIDResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.ListGroupsByGroupID")
+	defer func() { endSpan(span, err) }()
+
 	const MaximumListBucketsSize = 1000
 	if len(groupIDs) == 0 || len(groupIDs) > MaximumListBucketsSize {
 		return types.ListGroupsByGroupIDResponse{}, nil
@@ -26,6 +26,7 @@ import (
 	"github.com/bnb-chain/greenfield-go-sdk/types"
 	gnfdsdk "github.com/bnb-chain/greenfield/sdk/types"
 	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	resourcetypes "github.com/bnb-chain/greenfield/types/resource"
 	"github.com/bnb-chain/greenfield/types/s3util"
 	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
 	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
@@ -68,25 +69,28 @@ type IObjectClient interface {
 // GetRedundancyParams query and return the data shards, parity shards and segment size of redundancy
 // configuration on chain
 func (c *Client) GetRedundancyParams() (uint32, uint32, uint64, error) {
-	query := storageTypes.QueryParamsRequest{}
-	queryResp, err := c.chainClient.StorageQueryClient.Params(context.Background(), &query)
+	params, err := c.GetParams()
 	if err != nil {
 		return 0, 0, 0, err
 	}
 
-	versionedParams := queryResp.Params.VersionedParams
+	versionedParams := params.VersionedParams
 	return versionedParams.GetRedundantDataChunkNum(), versionedParams.GetRedundantParityChunkNum(), versionedParams.GetMaxSegmentSize(), nil
 }
 
 // GetParams query and return the data shards, parity shards and segment size of redundancy
 // configuration on chain
 func (c *Client) GetParams() (storageTypes.Params, error) {
+	if cached, ok := c.cache.get(cacheKindParams, ""); ok {
+		return cached.(storageTypes.Params), nil
+	}
 	query := storageTypes.QueryParamsRequest{}
 	queryResp, err := c.chainClient.StorageQueryClient.Params(context.Background(), &query)
 	if err != nil {
 		return storageTypes.Params{}, err
 	}
 
+	c.cache.set(cacheKindParams, "", queryResp.Params)
 	return queryResp.Params, nil
 }
 
@@ -107,7 +111,14 @@ func (c *Client) ComputeHashRoots(reader io.Reader, isSerial bool) ([][]byte, in
 // it returns the transaction hash value and error
 func (c *Client) CreateObject(ctx context.Context, bucketName, objectName string,
 	reader io.Reader, opts types.CreateObjectOptions,
-) (string, error) {
+) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.CreateObject", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opts.Account)
+	if err != nil {
+		return "", err
+	}
 	if reader == nil {
 		return "", errors.New("fail to compute hash of payload, reader is nil")
 	}
@@ -144,7 +155,7 @@ func (c *Client) CreateObject(ctx context.Context, bucketName, objectName string
 		visibility = opts.Visibility
 	}
 
-	createObjectMsg := storageTypes.NewMsgCreateObject(c.MustGetDefaultAccount().GetAddress(), bucketName, objectName,
+	createObjectMsg := storageTypes.NewMsgCreateObject(signer.GetAddress(), bucketName, objectName,
 		uint64(size), visibility, expectCheckSums, contentType, redundancyType, math.MaxUint, nil)
 
 	err = createObjectMsg.ValidateBasic()
@@ -162,11 +173,11 @@ func (c *Client) CreateObject(ctx context.Context, bucketName, objectName string
 	if opts.Tags != nil {
 		// Set tag
 		grn := gnfdTypes.NewObjectGRN(bucketName, objectName)
-		msgSetTag := storageTypes.NewMsgSetTag(c.MustGetDefaultAccount().GetAddress(), grn.String(), opts.Tags)
+		msgSetTag := storageTypes.NewMsgSetTag(signer.GetAddress(), grn.String(), opts.Tags)
 		msgs = append(msgs, msgSetTag)
 	}
 
-	resp, err := c.BroadcastTx(ctx, msgs, opts.TxOpts)
+	resp, err := c.BroadcastTx(ctx, msgs, signerTxOpts(opts.TxOpts, signer))
 	if err != nil {
 		return "", err
 	}
@@ -180,7 +191,8 @@ func (c *Client) CreateObject(ctx context.Context, bucketName, objectName string
 			return txnHash, fmt.Errorf("the transaction has been submitted, please check it later:%v", err)
 		}
 		if txnResponse.TxResult.Code != 0 {
-			return txnHash, fmt.Errorf("the createObject txn has failed with response code: %d, codespace:%s", txnResponse.TxResult.Code, txnResponse.TxResult.Codespace)
+			return txnHash, types.NewTxError(txnResponse.TxResult.Codespace, txnResponse.TxResult.Code, txnResponse.TxResult.Log,
+				"the createObject txn has failed with response code: %d, codespace:%s", txnResponse.TxResult.Code, txnResponse.TxResult.Codespace)
 		}
 	}
 	return txnHash, nil
@@ -190,7 +202,14 @@ func (c *Client) CreateObject(ctx context.Context, bucketName, objectName string
 // it returns the transaction hash value and error
 func (c *Client) UpdateObjectContent(ctx context.Context, bucketName, objectName string,
 	reader io.Reader, opts types.UpdateObjectOptions,
-) (string, error) {
+) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.UpdateObjectContent", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opts.Account)
+	if err != nil {
+		return "", err
+	}
 	if reader == nil {
 		return "", errors.New("fail to compute hash of payload, reader is nil")
 	}
@@ -206,13 +225,13 @@ func (c *Client) UpdateObjectContent(ctx context.Context, bucketName, objectName
 	if err != nil {
 		return "", err
 	}
-	updateObjectContentMsg := storageTypes.NewMsgUpdateObjectContent(c.MustGetDefaultAccount().GetAddress(), bucketName, objectName,
+	updateObjectContentMsg := storageTypes.NewMsgUpdateObjectContent(signer.GetAddress(), bucketName, objectName,
 		uint64(size), expectCheckSums)
 	if opts.TxOpts == nil {
 		broadcastMode := tx.BroadcastMode_BROADCAST_MODE_SYNC
 		opts.TxOpts = &gnfdsdk.TxOption{Mode: &broadcastMode}
 	}
-	resp, err := c.BroadcastTx(ctx, []sdk.Msg{updateObjectContentMsg}, opts.TxOpts)
+	resp, err := c.BroadcastTx(ctx, []sdk.Msg{updateObjectContentMsg}, signerTxOpts(opts.TxOpts, signer))
 	if err != nil {
 		return "", err
 	}
@@ -225,7 +244,8 @@ func (c *Client) UpdateObjectContent(ctx context.Context, bucketName, objectName
 			return txnHash, fmt.Errorf("the transaction has been submitted, please check it later:%v", err)
 		}
 		if txnResponse.TxResult.Code != 0 {
-			return txnHash, fmt.Errorf("the updateObjectContent txn has failed with response code: %d, codespace:%s", txnResponse.TxResult.Code, txnResponse.TxResult.Codespace)
+			return txnHash, types.NewTxError(txnResponse.TxResult.Codespace, txnResponse.TxResult.Code, txnResponse.TxResult.Log,
+				"the updateObjectContent txn has failed with response code: %d, codespace:%s", txnResponse.TxResult.Code, txnResponse.TxResult.Codespace)
 		}
 	}
 	return txnHash, nil
@@ -233,7 +253,14 @@ func (c *Client) UpdateObjectContent(ctx context.Context, bucketName, objectName
 
 // CancelUpdateObjectContent sends CancelUpdateObjectContent tx to greenfield chain,
 // it returns the transaction hash value and error
-func (c *Client) CancelUpdateObjectContent(ctx context.Context, bucketName, objectName string, opts types.CancelUpdateObjectOption) (string, error) {
+func (c *Client) CancelUpdateObjectContent(ctx context.Context, bucketName, objectName string, opts types.CancelUpdateObjectOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.CancelUpdateObjectContent", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opts.Account)
+	if err != nil {
+		return "", err
+	}
 	if err := s3util.CheckValidBucketName(bucketName); err != nil {
 		return "", err
 	}
@@ -242,8 +269,8 @@ func (c *Client) CancelUpdateObjectContent(ctx context.Context, bucketName, obje
 		return "", err
 	}
 
-	msg := storageTypes.NewMsgCancelUpdateObjectContent(c.MustGetDefaultAccount().GetAddress(), bucketName, objectName)
-	return c.sendTxn(ctx, msg, opts.TxOpts)
+	msg := storageTypes.NewMsgCancelUpdateObjectContent(signer.GetAddress(), bucketName, objectName)
+	return c.sendTxn(ctx, msg, signerTxOpts(opts.TxOpts, signer))
 }
 
 // DeleteObject - Send DeleteObject msg to greenfield chain and return txn hash.
@@ -259,7 +286,14 @@ func (c *Client) CancelUpdateObjectContent(ctx context.Context, bucketName, obje
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error if delete bucket failed, otherwise return nil.
-func (c *Client) DeleteObject(ctx context.Context, bucketName, objectName string, opt types.DeleteObjectOption) (string, error) {
+func (c *Client) DeleteObject(ctx context.Context, bucketName, objectName string, opt types.DeleteObjectOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.DeleteObject", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
 	if err := s3util.CheckValidBucketName(bucketName); err != nil {
 		return "", err
 	}
@@ -268,12 +302,19 @@ func (c *Client) DeleteObject(ctx context.Context, bucketName, objectName string
 		return "", err
 	}
 
-	delObjectMsg := storageTypes.NewMsgDeleteObject(c.MustGetDefaultAccount().GetAddress(), bucketName, objectName)
-	return c.sendTxn(ctx, delObjectMsg, opt.TxOpts)
+	delObjectMsg := storageTypes.NewMsgDeleteObject(signer.GetAddress(), bucketName, objectName)
+	return c.sendTxn(ctx, delObjectMsg, signerTxOpts(opt.TxOpts, signer))
 }
 
 // CancelCreateObject send CancelCreateObject txn to greenfield chain
-func (c *Client) CancelCreateObject(ctx context.Context, bucketName, objectName string, opt types.CancelCreateOption) (string, error) {
+func (c *Client) CancelCreateObject(ctx context.Context, bucketName, objectName string, opt types.CancelCreateOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.CancelCreateObject", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
 	if err := s3util.CheckValidBucketName(bucketName); err != nil {
 		return "", err
 	}
@@ -282,8 +323,8 @@ func (c *Client) CancelCreateObject(ctx context.Context, bucketName, objectName
 		return "", err
 	}
 
-	cancelCreateMsg := storageTypes.NewMsgCancelCreateObject(c.MustGetDefaultAccount().GetAddress(), bucketName, objectName)
-	return c.sendTxn(ctx, cancelCreateMsg, opt.TxOpts)
+	cancelCreateMsg := storageTypes.NewMsgCancelCreateObject(signer.GetAddress(), bucketName, objectName)
+	return c.sendTxn(ctx, cancelCreateMsg, signerTxOpts(opt.TxOpts, signer))
 }
 
 // PutObject supports the second stage of uploading the object to bucket.
@@ -291,6 +332,9 @@ func (c *Client) CancelCreateObject(ctx context.Context, bucketName, objectName
 func (c *Client) PutObject(ctx context.Context, bucketName, objectName string, objectSize int64,
 	reader io.Reader, opts types.PutObjectOptions,
 ) (err error) {
+	ctx, span := c.startSpan(ctx, "client.PutObject", attrBucket.String(bucketName), attrObject.String(objectName), attrBytes.Int64(objectSize))
+	defer func() { endSpan(span, err) }()
+
 	if objectSize <= 0 {
 		return errors.New("object size should be more than 0")
 	}
@@ -319,7 +363,7 @@ func (c *Client) putObject(ctx context.Context, bucketName, objectName string, o
 	reader io.Reader, opts types.PutObjectOptions,
 ) (err error) {
 	if !opts.Delegated {
-		if err := c.headSPObjectInfo(ctx, bucketName, objectName); err != nil {
+		if err := c.headSPObjectInfo(ctx, bucketName, objectName, opts.Account); err != nil {
 			log.Error().Msg(fmt.Sprintf("fail to head object %s , err %v ", objectName, err))
 			return err
 		}
@@ -349,6 +393,7 @@ func (c *Client) putObject(ctx context.Context, bucketName, objectName string, o
 		contentLength: objectSize,
 		contentType:   contentType,
 		urlValues:     urlValues,
+		account:       opts.Account,
 	}
 
 	var sendOpt sendOptions
@@ -399,6 +444,7 @@ func (c *Client) delegateCreateFolder(ctx context.Context, bucketName, objectNam
 		contentLength: 0,
 		contentType:   contentType,
 		urlValues:     urlValues,
+		account:       opts.Account,
 	}
 
 	sendOpt := sendOptions{
@@ -434,10 +480,10 @@ func (c *Client) putObjectResumable(ctx context.Context, bucketName, objectName
 	var offset uint64
 
 	if !opts.Delegated {
-		if err = c.headSPObjectInfo(ctx, bucketName, objectName); err != nil {
+		if err = c.headSPObjectInfo(ctx, bucketName, objectName, opts.Account); err != nil {
 			return err
 		}
-		offset, err = c.getObjectResumableUploadOffset(ctx, bucketName, objectName)
+		offset, err = c.getObjectResumableUploadOffset(ctx, bucketName, objectName, opts.Account)
 		if err != nil {
 			return err
 		}
@@ -524,19 +570,23 @@ func (c *Client) putObjectResumable(ctx context.Context, bucketName, objectName
 			contentLength: int64(length),
 			contentType:   contentType,
 			urlValues:     urlValues,
+			account:       opts.Account,
 		}
 
+		// the part is uploaded to a fixed offset, so it is safe to retry it
 		var sendOpt sendOptions
 		if opts.TxnHash != "" {
 			sendOpt = sendOptions{
-				method:  http.MethodPost,
-				body:    rd,
-				txnHash: opts.TxnHash,
+				method:    http.MethodPost,
+				body:      rd,
+				txnHash:   opts.TxnHash,
+				retryable: true,
 			}
 		} else {
 			sendOpt = sendOptions{
-				method: http.MethodPost,
-				body:   rd,
+				method:    http.MethodPost,
+				body:      rd,
+				retryable: true,
 			}
 		}
 
@@ -547,7 +597,10 @@ func (c *Client) putObjectResumable(ctx context.Context, bucketName, objectName
 		}
 
 		// Proceed to upload the part.
-		_, err = c.sendReq(ctx, reqMeta, &sendOpt, endpoint)
+		partCtx, partSpan := c.startSpan(ctx, "client.UploadPart", attrBucket.String(bucketName), attrObject.String(objectName),
+			attrPartNumber.Int(partNumber), attrBytes.Int(length))
+		_, err = c.sendReq(partCtx, reqMeta, &sendOpt, endpoint)
+		endSpan(partSpan, err)
 		if err != nil {
 			return err
 		}
@@ -568,10 +621,10 @@ func (c *Client) putObjectResumable(ctx context.Context, bucketName, objectName
 	return nil
 }
 
-func (c *Client) headSPObjectInfo(ctx context.Context, bucketName, objectName string) error {
+func (c *Client) headSPObjectInfo(ctx context.Context, bucketName, objectName string, signer *types.Account) error {
 	backoffDelay := types.HeadBackOffDelay
 	for retry := 0; retry < types.MaxHeadTryTime; retry++ {
-		_, err := c.getObjectStatusFromSP(ctx, bucketName, objectName)
+		_, err := c.getObjectStatusFromSP(ctx, bucketName, objectName, signer)
 		if err == nil {
 			return nil
 		}
@@ -593,6 +646,9 @@ func (c *Client) headSPObjectInfo(ctx context.Context, bucketName, objectName st
 
 // FPutObject supports uploading object from local file
 func (c *Client) FPutObject(ctx context.Context, bucketName, objectName, filePath string, opts types.PutObjectOptions) (err error) {
+	ctx, span := c.startSpan(ctx, "client.FPutObject", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
 	fReader, err := os.Open(filePath)
 	// If any error fail quickly here.
 	if err != nil {
@@ -612,8 +668,10 @@ func (c *Client) FPutObject(ctx context.Context, bucketName, objectName, filePat
 // GetObject download s3 object payload and return the related object info
 func (c *Client) GetObject(ctx context.Context, bucketName, objectName string,
 	opts types.GetObjectOptions,
-) (io.ReadCloser, types.ObjectStat, error) {
-	var err error
+) (_ io.ReadCloser, _ types.ObjectStat, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetObject", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
 	if err = s3util.CheckValidBucketName(bucketName); err != nil {
 		return nil, types.ObjectStat{}, err
 	}
@@ -626,6 +684,7 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string,
 		bucketName:    bucketName,
 		objectName:    objectName,
 		contentSHA256: types.EmptyStringSHA256,
+		account:       opts.Account,
 	}
 
 	if opts.Range != "" {
@@ -664,7 +723,10 @@ func (c *Client) GetObject(ctx context.Context, bucketName, objectName string,
 }
 
 // FGetObject download s3 object payload adn write the object content into local file specified by filePath
-func (c *Client) FGetObject(ctx context.Context, bucketName, objectName, filePath string, opts types.GetObjectOptions) error {
+func (c *Client) FGetObject(ctx context.Context, bucketName, objectName, filePath string, opts types.GetObjectOptions) (err error) {
+	ctx, span := c.startSpan(ctx, "client.FGetObject", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
 	// Verify if destination already exists.
 	st, err := os.Stat(filePath)
 	if err == nil {
@@ -704,14 +766,21 @@ func getSegmentEnd(begin int64, total int64, per int64) int64 {
 }
 
 // FGetObjectResumable download s3 object payload with resumable download
-func (c *Client) FGetObjectResumable(ctx context.Context, bucketName, objectName, filePath string, opts types.GetObjectOptions) error {
+func (c *Client) FGetObjectResumable(ctx context.Context, bucketName, objectName, filePath string, opts types.GetObjectOptions) (err error) {
+	ctx, span := c.startSpan(ctx, "client.FGetObjectResumable", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
 	// Get the object detailed meta for object whole size
 	meta, err := c.HeadObject(ctx, bucketName, objectName)
 	if err != nil {
 		return err
 	}
 
-	tempFilePath := filePath + "_" + c.defaultAccount.GetAddress().String() + opts.Range + types.TempFileSuffix
+	signer, err := c.getSigner(opts.Account)
+	if err != nil {
+		return err
+	}
+	tempFilePath := filePath + "_" + signer.GetAddress().String() + opts.Range + types.TempFileSuffix
 
 	var (
 		startOffset    int64
@@ -757,7 +826,7 @@ func (c *Client) FGetObjectResumable(ctx context.Context, bucketName, objectName
 	// 2)prepare and check temp file
 	fileInfo, err := os.Stat(tempFilePath)
 	if err != nil {
-		if !strings.Contains(err.Error(), "no such file or directory") {
+		if !errors.Is(err, os.ErrNotExist) {
 			return err
 		}
 	} else {
@@ -895,7 +964,10 @@ func getObjInfo(objectName string, h http.Header) (types.ObjectStat, error) {
 
 // HeadObject query the objectInfo on chain to check th object id, return the object info if exists
 // return err info if object not exist
-func (c *Client) HeadObject(ctx context.Context, bucketName, objectName string) (*types.ObjectDetail, error) {
+func (c *Client) HeadObject(ctx context.Context, bucketName, objectName string) (_ *types.ObjectDetail, err error) {
+	ctx, span := c.startSpan(ctx, "client.HeadObject", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
 	queryHeadObjectRequest := storageTypes.QueryHeadObjectRequest{
 		BucketName: bucketName,
 		ObjectName: objectName,
@@ -913,7 +985,10 @@ func (c *Client) HeadObject(ctx context.Context, bucketName, objectName string)
 
 // HeadObjectByID query the objectInfo on chain by object id, return the object info if exists
 // return err info if object not exist
-func (c *Client) HeadObjectByID(ctx context.Context, objID string) (*types.ObjectDetail, error) {
+func (c *Client) HeadObjectByID(ctx context.Context, objID string) (_ *types.ObjectDetail, err error) {
+	ctx, span := c.startSpan(ctx, "client.HeadObjectByID")
+	defer func() { endSpan(span, err) }()
+
 	headObjectRequest := storageTypes.QueryHeadObjectByIdRequest{
 		ObjectId: objID,
 	}
@@ -931,7 +1006,17 @@ func (c *Client) HeadObjectByID(ctx context.Context, objID string) (*types.Objec
 // PutObjectPolicy apply object policy to the principal, return the txn hash
 func (c *Client) PutObjectPolicy(ctx context.Context, bucketName, objectName string, principalStr types.Principal,
 	statements []*permTypes.Statement, opt types.PutPolicyOption,
-) (string, error) {
+) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.PutObjectPolicy", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	if err = types.ValidateStatements(resourcetypes.RESOURCE_TYPE_OBJECT, statements); err != nil {
+		return "", err
+	}
 	resource := gnfdTypes.NewObjectGRN(bucketName, objectName)
 
 	principal := &permTypes.Principal{}
@@ -939,28 +1024,38 @@ func (c *Client) PutObjectPolicy(ctx context.Context, bucketName, objectName str
 		return "", err
 	}
 
-	putPolicyMsg := storageTypes.NewMsgPutPolicy(c.MustGetDefaultAccount().GetAddress(), resource.String(),
+	putPolicyMsg := storageTypes.NewMsgPutPolicy(signer.GetAddress(), resource.String(),
 		principal, statements, opt.PolicyExpireTime)
 
-	return c.sendPutPolicyTxn(ctx, putPolicyMsg, opt.TxOpts)
+	return c.sendPutPolicyTxn(ctx, putPolicyMsg, signerTxOpts(opt.TxOpts, signer))
 }
 
 // DeleteObjectPolicy delete the object policy of the principal
-func (c *Client) DeleteObjectPolicy(ctx context.Context, bucketName, objectName string, principalStr types.Principal, opt types.DeletePolicyOption) (string, error) {
+func (c *Client) DeleteObjectPolicy(ctx context.Context, bucketName, objectName string, principalStr types.Principal, opt types.DeletePolicyOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.DeleteObjectPolicy", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
 	principal := &permTypes.Principal{}
 	if err := principal.Unmarshal([]byte(principalStr)); err != nil {
 		return "", err
 	}
 
 	resource := gnfdTypes.NewObjectGRN(bucketName, objectName)
-	return c.sendDelPolicyTxn(ctx, c.MustGetDefaultAccount().GetAddress(), resource.String(), principal, opt.TxOpts)
+	return c.sendDelPolicyTxn(ctx, signer.GetAddress(), resource.String(), principal, signerTxOpts(opt.TxOpts, signer))
 }
 
 // IsObjectPermissionAllowed check if the permission of the object is allowed to the user
 func (c *Client) IsObjectPermissionAllowed(ctx context.Context, userAddr string,
 	bucketName, objectName string, action permTypes.ActionType,
-) (permTypes.Effect, error) {
-	_, err := sdk.AccAddressFromHexUnsafe(userAddr)
+) (_ permTypes.Effect, err error) {
+	ctx, span := c.startSpan(ctx, "client.IsObjectPermissionAllowed", attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
+	_, err = sdk.AccAddressFromHexUnsafe(userAddr)
 	if err != nil {
 		return permTypes.EFFECT_DENY, err
 	}
@@ -980,8 +1075,11 @@ func (c *Client) IsObjectPermissionAllowed(ctx context.Context, userAddr string,
 }
 
 // GetObjectPolicy get the object policy info of the user specified by principalAddr
-func (c *Client) GetObjectPolicy(ctx context.Context, bucketName, objectName string, principalAddr string) (*permTypes.Policy, error) {
-	_, err := sdk.AccAddressFromHexUnsafe(principalAddr)
+func (c *Client) GetObjectPolicy(ctx context.Context, bucketName, objectName string, principalAddr string) (_ *permTypes.Policy, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetObjectPolicy", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
+	_, err = sdk.AccAddressFromHexUnsafe(principalAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -1011,7 +1109,10 @@ func (c *Client) GetObjectPolicy(ctx context.Context, bucketName, objectName str
 // - ret1: The result of list objects under specific bucket
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) ListObjects(ctx context.Context, bucketName string, opts types.ListObjectsOptions) (types.ListObjectsResult, error) {
+func (c *Client) ListObjects(ctx context.Context, bucketName string, opts types.ListObjectsOptions) (_ types.ListObjectsResult, err error) {
+	ctx, span := c.startSpan(ctx, "client.ListObjects", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
 	if err := s3util.CheckValidBucketName(bucketName); err != nil {
 		return types.ListObjectsResult{}, err
 	}
@@ -1115,7 +1216,10 @@ func (c *Client) ListObjects(ctx context.Context, bucketName string, opts types.
 }
 
 // Deprecated: GetCreateObjectApproval returns the signature info for the approval of preCreating resources
-func (c *Client) GetCreateObjectApproval(ctx context.Context, createObjectMsg *storageTypes.MsgCreateObject) (*storageTypes.MsgCreateObject, error) {
+func (c *Client) GetCreateObjectApproval(ctx context.Context, createObjectMsg *storageTypes.MsgCreateObject) (_ *storageTypes.MsgCreateObject, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetCreateObjectApproval")
+	defer func() { endSpan(span, err) }()
+
 	unsignedBytes := createObjectMsg.GetSignBytes()
 
 	// set the action type
@@ -1168,7 +1272,10 @@ func (c *Client) GetCreateObjectApproval(ctx context.Context, createObjectMsg *s
 }
 
 // CreateFolder send create empty object txn to greenfield chain
-func (c *Client) CreateFolder(ctx context.Context, bucketName, objectName string, opts types.CreateObjectOptions) (string, error) {
+func (c *Client) CreateFolder(ctx context.Context, bucketName, objectName string, opts types.CreateObjectOptions) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.CreateFolder", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
 	if !strings.HasSuffix(objectName, "/") {
 		return "", errors.New("failed to create folder. Folder names must end with a forward slash (/) character")
 	}
@@ -1179,7 +1286,10 @@ func (c *Client) CreateFolder(ctx context.Context, bucketName, objectName string
 }
 
 // DelegateCreateFolder send create empty object txn to greenfield chain
-func (c *Client) DelegateCreateFolder(ctx context.Context, bucketName, objectName string, opts types.PutObjectOptions) error {
+func (c *Client) DelegateCreateFolder(ctx context.Context, bucketName, objectName string, opts types.PutObjectOptions) (err error) {
+	ctx, span := c.startSpan(ctx, "client.DelegateCreateFolder", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
 	if !strings.HasSuffix(objectName, "/") {
 		return errors.New("failed to create folder. Folder names must end with a forward slash (/) character")
 	}
@@ -1189,7 +1299,10 @@ func (c *Client) DelegateCreateFolder(ctx context.Context, bucketName, objectNam
 }
 
 // GetObjectUploadProgress return the status of object including the uploading progress
-func (c *Client) GetObjectUploadProgress(ctx context.Context, bucketName, objectName string) (string, error) {
+func (c *Client) GetObjectUploadProgress(ctx context.Context, bucketName, objectName string) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetObjectUploadProgress", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
 	status, err := c.HeadObject(ctx, bucketName, objectName)
 	if err != nil {
 		return "", err
@@ -1197,7 +1310,7 @@ func (c *Client) GetObjectUploadProgress(ctx context.Context, bucketName, object
 
 	// get object status from sp
 	if status.ObjectInfo.ObjectStatus == storageTypes.OBJECT_STATUS_CREATED {
-		uploadProgressInfo, err := c.getObjectStatusFromSP(ctx, bucketName, objectName)
+		uploadProgressInfo, err := c.getObjectStatusFromSP(ctx, bucketName, objectName, nil)
 		if err != nil {
 			return "", errors.New("fail to fetch object uploading progress from sp" + err.Error())
 		}
@@ -1208,7 +1321,7 @@ func (c *Client) GetObjectUploadProgress(ctx context.Context, bucketName, object
 }
 
 // getObjectResumableUploadOffset return the status of object including the uploading progress
-func (c *Client) getObjectResumableUploadOffset(ctx context.Context, bucketName, objectName string) (uint64, error) {
+func (c *Client) getObjectResumableUploadOffset(ctx context.Context, bucketName, objectName string, signer *types.Account) (uint64, error) {
 	status, err := c.HeadObject(ctx, bucketName, objectName)
 	if err != nil {
 		return 0, err
@@ -1216,7 +1329,7 @@ func (c *Client) getObjectResumableUploadOffset(ctx context.Context, bucketName,
 
 	// get object status from sp
 	if status.ObjectInfo.ObjectStatus == storageTypes.OBJECT_STATUS_CREATED {
-		uploadOffsetInfo, err := c.getObjectOffsetFromSP(ctx, bucketName, objectName)
+		uploadOffsetInfo, err := c.getObjectOffsetFromSP(ctx, bucketName, objectName, signer)
 		if err != nil {
 			return 0, errors.New("fail to fetch object uploading offset from sp" + err.Error())
 		}
@@ -1228,7 +1341,7 @@ func (c *Client) getObjectResumableUploadOffset(ctx context.Context, bucketName,
 	return 0, nil
 }
 
-func (c *Client) getObjectOffsetFromSP(ctx context.Context, bucketName, objectName string) (types.UploadOffset, error) {
+func (c *Client) getObjectOffsetFromSP(ctx context.Context, bucketName, objectName string, signer *types.Account) (types.UploadOffset, error) {
 	params := url.Values{}
 	params.Set("upload-context", "")
 
@@ -1236,6 +1349,7 @@ func (c *Client) getObjectOffsetFromSP(ctx context.Context, bucketName, objectNa
 		urlValues:  params,
 		bucketName: bucketName,
 		objectName: objectName,
+		account:    signer,
 	}
 
 	sendOpt := sendOptions{
@@ -1270,7 +1384,7 @@ func (c *Client) getObjectOffsetFromSP(ctx context.Context, bucketName, objectNa
 	return objectOffset, nil
 }
 
-func (c *Client) getObjectStatusFromSP(ctx context.Context, bucketName, objectName string) (types.UploadProgress, error) {
+func (c *Client) getObjectStatusFromSP(ctx context.Context, bucketName, objectName string, signer *types.Account) (types.UploadProgress, error) {
 	params := url.Values{}
 	params.Set("upload-progress", "")
 
@@ -1279,6 +1393,7 @@ func (c *Client) getObjectStatusFromSP(ctx context.Context, bucketName, objectNa
 		bucketName:    bucketName,
 		objectName:    objectName,
 		contentSHA256: types.EmptyStringSHA256,
+		account:       signer,
 	}
 
 	sendOpt := sendOptions{
@@ -1310,17 +1425,24 @@ func (c *Client) getObjectStatusFromSP(ctx context.Context, bucketName, objectNa
 
 func (c *Client) UpdateObjectVisibility(ctx context.Context, bucketName, objectName string,
 	visibility storageTypes.VisibilityType, opt types.UpdateObjectOption,
-) (string, error) {
+) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.UpdateObjectVisibility", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
 	object, err := c.HeadObject(ctx, bucketName, objectName)
 	if err != nil {
-		return "", fmt.Errorf("object:%s not exists: %s\n", objectName, err.Error())
+		return "", fmt.Errorf("object:%s not exists: %w\n", objectName, err)
 	}
 
 	if object.ObjectInfo.GetVisibility() == visibility {
 		return "", fmt.Errorf("the visibility of object:%s is already %s \n", objectName, visibility.String())
 	}
 
-	updateObjectMsg := storageTypes.NewMsgUpdateObjectInfo(c.MustGetDefaultAccount().GetAddress(), bucketName, objectName, visibility)
+	updateObjectMsg := storageTypes.NewMsgUpdateObjectInfo(signer.GetAddress(), bucketName, objectName, visibility)
 
 	// set the default txn broadcast mode as sync mode
 	if opt.TxOpts == nil {
@@ -1328,7 +1450,7 @@ func (c *Client) UpdateObjectVisibility(ctx context.Context, bucketName, objectN
 		opt.TxOpts = &gnfdsdk.TxOption{Mode: &broadcastMode}
 	}
 
-	return c.sendTxn(ctx, updateObjectMsg, opt.TxOpts)
+	return c.sendTxn(ctx, updateObjectMsg, signerTxOpts(opt.TxOpts, signer))
 }
 
 type listObjectsByIDsResponse map[uint64]*types.ObjectMeta
@@ -1368,7 +1490,10 @@ func (m *listObjectsByIDsResponse) UnmarshalXML(d *xml.Decoder, start xml.StartE
 // - ret1: The result of object info map by given object ids.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) ListObjectsByObjectID(ctx context.Context, objectIds []uint64, opts types.EndPointOptions) (types.ListObjectsByObjectIDResponse, error) {
+func (c *Client) ListObjectsByObjectID(ctx context.Context, objectIds []uint64, opts types.EndPointOptions) (_ types.ListObjectsByObjectIDResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.ListObjectsByObjectID")
+	defer func() { endSpan(span, err) }()
+
 	const MaximumListObjectsSize = 100
 	if len(objectIds) == 0 || len(objectIds) > MaximumListObjectsSize {
 		return types.ListObjectsByObjectIDResponse{}, nil
@@ -1467,7 +1592,10 @@ func (c *Client) ListObjectsByObjectID(ctx context.Context, objectIds []uint64,
 // - ret1: The result of object policy meta
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) ListObjectPolicies(ctx context.Context, objectName, bucketName string, actionType uint32, opts types.ListObjectPoliciesOptions) (types.ListObjectPoliciesResponse, error) {
+func (c *Client) ListObjectPolicies(ctx context.Context, objectName, bucketName string, actionType uint32, opts types.ListObjectPoliciesOptions) (_ types.ListObjectPoliciesResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.ListObjectPolicies", attrBucket.String(bucketName), attrObject.String(objectName))
+	defer func() { endSpan(span, err) }()
+
 	params := url.Values{}
 	params.Set("object-policies", "")
 	// StartAfter is used to input the policy id for pagination purposes
@@ -1526,6 +1654,9 @@ func (c *Client) ListObjectPolicies(ctx context.Context, objectName, bucketName
 func (c *Client) DelegatePutObject(ctx context.Context, bucketName, objectName string, objectSize int64,
 	reader io.Reader, opts types.PutObjectOptions,
 ) (err error) {
+	ctx, span := c.startSpan(ctx, "client.DelegatePutObject", attrBucket.String(bucketName), attrObject.String(objectName), attrBytes.Int64(objectSize))
+	defer func() { endSpan(span, err) }()
+
 	if objectSize <= 0 {
 		return errors.New("object size should be more than 0")
 	}
@@ -1554,6 +1685,9 @@ func (c *Client) DelegatePutObject(ctx context.Context, bucketName, objectName s
 func (c *Client) DelegateUpdateObjectContent(ctx context.Context, bucketName, objectName string, objectSize int64,
 	reader io.Reader, opts types.PutObjectOptions,
 ) (err error) {
+	ctx, span := c.startSpan(ctx, "client.DelegateUpdateObjectContent", attrBucket.String(bucketName), attrObject.String(objectName), attrBytes.Int64(objectSize))
+	defer func() { endSpan(span, err) }()
+
 	opts.IsUpdate = true
 	return c.DelegatePutObject(ctx, bucketName, objectName, objectSize, reader, opts)
 }
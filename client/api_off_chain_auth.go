@@ -94,8 +94,12 @@ type DeleteUserPublicKeyV2Resp struct {
 //
 // - ret2: Return error when getting next nonce failed, otherwise return nil.
 func (c *Client) GetNextNonce(spEndpoint string) (string, error) {
+	account, err := c.GetDefaultAccount()
+	if err != nil {
+		return "0", err
+	}
 	header := make(map[string]string)
-	header["X-Gnfd-User-Address"] = c.defaultAccount.GetAddress().String()
+	header["X-Gnfd-User-Address"] = account.GetAddress().String()
 	header["X-Gnfd-App-Domain"] = c.offChainAuthOption.Domain
 
 	response, err := httpGetWithHeader(spEndpoint+"/auth/request_nonce", header)
@@ -156,6 +160,10 @@ Expiration Time: %s`
 //
 // - ret2: Return error when registering failed, otherwise return nil.
 func (c *Client) RegisterEDDSAPublicKey(spAddress string, spEndpoint string) (string, error) {
+	account, err := c.GetDefaultAccount()
+	if err != nil {
+		return "", err
+	}
 	appDomain := c.offChainAuthOption.Domain
 	eddsaSeed := c.offChainAuthOption.Seed
 	nextNonce, err := c.GetNextNonce(spEndpoint)
@@ -170,10 +178,10 @@ func (c *Client) RegisterEDDSAPublicKey(spAddress string, spEndpoint string) (st
 	// ExpiryDate format := "2023-06-27T06:35:24Z"
 	ExpiryDate := time.Now().Add(time.Hour * 24).Format(time.RFC3339)
 
-	unSignedContent := fmt.Sprintf(unsignedContentTemplate, appDomain, c.defaultAccount.GetAddress().String(), userEddsaPublicKeyStr, appDomain, IssueDate, ExpiryDate, spAddress, nextNonce)
+	unSignedContent := fmt.Sprintf(unsignedContentTemplate, appDomain, account.GetAddress().String(), userEddsaPublicKeyStr, appDomain, IssueDate, ExpiryDate, spAddress, nextNonce)
 
 	unSignedContentHash := accounts.TextHash([]byte(unSignedContent))
-	sig, _ := c.defaultAccount.GetKeyManager().Sign(unSignedContentHash)
+	sig, _ := account.GetKeyManager().Sign(unSignedContentHash)
 	authString := fmt.Sprintf("%s,SignedMsg=%s,Signature=%s", httplib.Gnfd1EthPersonalSign, unSignedContent, hexutil.Encode(sig))
 	authString = strings.ReplaceAll(authString, "\n", "\\n")
 	headers := make(map[string]string)
@@ -183,7 +191,7 @@ func (c *Client) RegisterEDDSAPublicKey(spAddress string, spEndpoint string) (st
 	headers["X-Gnfd-Expiry-Timestamp"] = ExpiryDate
 	headers["authorization"] = authString
 	headers["origin"] = appDomain
-	headers["x-gnfd-user-address"] = c.defaultAccount.GetAddress().String()
+	headers["x-gnfd-user-address"] = account.GetAddress().String()
 	jsonResult, error1 := httpPostWithHeader(spEndpoint+"/auth/update_key", "{}", headers)
 
 	return jsonResult, error1
@@ -206,6 +214,10 @@ func (c *Client) RegisterEDDSAPublicKey(spAddress string, spEndpoint string) (st
 //
 // - ret2: Return error when registering failed, otherwise return nil.
 func (c *Client) RegisterEDDSAPublicKeyV2(spEndpoint string) (string, error) {
+	account, err := c.GetDefaultAccount()
+	if err != nil {
+		return "", err
+	}
 	appDomain := c.offChainAuthOptionV2.Domain
 	eddsaSeed := c.offChainAuthOptionV2.Seed
 
@@ -218,10 +230,10 @@ func (c *Client) RegisterEDDSAPublicKeyV2(spEndpoint string) (string, error) {
 	// ExpiryDate format := "2023-06-27T06:35:24Z"
 	ExpiryDate := time.Now().Add(time.Hour * 24).Format(time.RFC3339)
 
-	unSignedContent := fmt.Sprintf(unsignedContentTemplateV2, appDomain, c.defaultAccount.GetAddress().String(), userEddsaPublicKeyStr, appDomain, IssueDate, ExpiryDate)
+	unSignedContent := fmt.Sprintf(unsignedContentTemplateV2, appDomain, account.GetAddress().String(), userEddsaPublicKeyStr, appDomain, IssueDate, ExpiryDate)
 
 	unSignedContentHash := accounts.TextHash([]byte(unSignedContent))
-	sig, _ := c.defaultAccount.GetKeyManager().Sign(unSignedContentHash)
+	sig, _ := account.GetKeyManager().Sign(unSignedContentHash)
 	authString := fmt.Sprintf("%s,SignedMsg=%s,Signature=%s", httplib.Gnfd1EthPersonalSign, unSignedContent, hexutil.Encode(sig))
 	authString = strings.ReplaceAll(authString, "\n", "\\n")
 	headers := make(map[string]string)
@@ -230,7 +242,7 @@ func (c *Client) RegisterEDDSAPublicKeyV2(spEndpoint string) (string, error) {
 	headers["X-Gnfd-Expiry-Timestamp"] = ExpiryDate
 	headers["authorization"] = authString
 	headers["origin"] = appDomain
-	headers["x-gnfd-user-address"] = c.defaultAccount.GetAddress().String()
+	headers["x-gnfd-user-address"] = account.GetAddress().String()
 	jsonResult, error1 := httpPostWithHeader(spEndpoint+"/auth/update_key_v2", "{}", headers)
 
 	return jsonResult, error1
@@ -247,8 +259,12 @@ func (c *Client) RegisterEDDSAPublicKeyV2(spEndpoint string) (string, error) {
 //
 // - ret2: Return error when ListUserPublicKeyV2 runs into failure.
 func (c *Client) ListUserPublicKeyV2(spEndpoint string, domain string) ([]string, error) {
+	account, err := c.GetDefaultAccount()
+	if err != nil {
+		return nil, err
+	}
 	header := make(map[string]string)
-	header["X-Gnfd-User-Address"] = c.defaultAccount.GetAddress().String()
+	header["X-Gnfd-User-Address"] = account.GetAddress().String()
 	header["X-Gnfd-App-Domain"] = domain
 
 	response, err := httpGetWithHeader(spEndpoint+"/auth/keys_v2", header)
@@ -277,8 +293,12 @@ func (c *Client) ListUserPublicKeyV2(spEndpoint string, domain string) ([]string
 //
 // - ret2: Return error when DeleteUserPublicKeyV2 runs into failure.
 func (c *Client) DeleteUserPublicKeyV2(spEndpoint string, domain string, publicKeys []string) (bool, error) {
+	account, err := c.GetDefaultAccount()
+	if err != nil {
+		return false, err
+	}
 	header := make(map[string]string)
-	header["X-Gnfd-User-Address"] = c.defaultAccount.GetAddress().String()
+	header["X-Gnfd-User-Address"] = account.GetAddress().String()
 	header["X-Gnfd-App-Domain"] = domain
 	stNow := time.Now().UTC()
 	header[httplib.HTTPHeaderExpiryTimestamp] = stNow.Add(time.Second * types.DefaultExpireSeconds).Format(types.Iso8601DateFormatSecond)
@@ -290,7 +310,7 @@ func (c *Client) DeleteUserPublicKeyV2(spEndpoint string, domain string, publicK
 		req.Header.Set(key, value)
 	}
 	// sign the total http request info when auth type v1
-	err = c.signRequest(req)
+	err = c.signRequest(req, nil)
 	if err != nil {
 		return false, err
 	}
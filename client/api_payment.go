@@ -38,7 +38,10 @@ type IPaymentClient interface {
 // - ret1: The stream record information, including balances and net flow rate.
 //
 // - ret2: Return error when getting challenge info failed, otherwise return nil.
-func (c *Client) GetStreamRecord(ctx context.Context, streamAddress string) (*paymentTypes.StreamRecord, error) {
+func (c *Client) GetStreamRecord(ctx context.Context, streamAddress string) (_ *paymentTypes.StreamRecord, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetStreamRecord")
+	defer func() { endSpan(span, err) }()
+
 	accAddress, err := sdk.AccAddressFromHexUnsafe(streamAddress)
 	if err != nil {
 		return nil, err
@@ -63,13 +66,20 @@ func (c *Client) GetStreamRecord(ctx context.Context, streamAddress string) (*pa
 // - ret1: The response of Greenfield transaction.
 //
 // - ret2: Return error when deposit tx failed, otherwise return nil.
-func (c *Client) Deposit(ctx context.Context, toAddress string, amount math.Int, txOption gnfdSdkTypes.TxOption) (string, error) {
+func (c *Client) Deposit(ctx context.Context, toAddress string, amount math.Int, txOption gnfdSdkTypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.Deposit")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
 	accAddress, err := sdk.AccAddressFromHexUnsafe(toAddress)
 	if err != nil {
 		return "", err
 	}
 	msgDeposit := &paymentTypes.MsgDeposit{
-		Creator: c.MustGetDefaultAccount().GetAddress().String(),
+		Creator: sender.String(),
 		To:      accAddress.String(),
 		Amount:  amount,
 	}
@@ -98,13 +108,20 @@ func (c *Client) Deposit(ctx context.Context, toAddress string, amount math.Int,
 // - ret1: The response of Greenfield transaction.
 //
 // - ret2: Return error when withdrawal tx failed, otherwise return nil.
-func (c *Client) Withdraw(ctx context.Context, fromAddress string, amount math.Int, txOption gnfdSdkTypes.TxOption) (string, error) {
+func (c *Client) Withdraw(ctx context.Context, fromAddress string, amount math.Int, txOption gnfdSdkTypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.Withdraw")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
 	accAddress, err := sdk.AccAddressFromHexUnsafe(fromAddress)
 	if err != nil {
 		return "", err
 	}
 	msgWithdraw := &paymentTypes.MsgWithdraw{
-		Creator: c.MustGetDefaultAccount().GetAddress().String(),
+		Creator: sender.String(),
 		From:    accAddress.String(),
 		Amount:  amount,
 	}
@@ -128,13 +145,20 @@ func (c *Client) Withdraw(ctx context.Context, fromAddress string, amount math.I
 // - ret1: The response of Greenfield transaction.
 //
 // - ret2: Return error when disable refund tx failed, otherwise return nil.
-func (c *Client) DisableRefund(ctx context.Context, paymentAddress string, txOption gnfdSdkTypes.TxOption) (string, error) {
+func (c *Client) DisableRefund(ctx context.Context, paymentAddress string, txOption gnfdSdkTypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.DisableRefund")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
 	accAddress, err := sdk.AccAddressFromHexUnsafe(paymentAddress)
 	if err != nil {
 		return "", err
 	}
 	msgDisableRefund := &paymentTypes.MsgDisableRefund{
-		Owner: c.MustGetDefaultAccount().GetAddress().String(),
+		Owner: sender.String(),
 		Addr:  accAddress.String(),
 	}
 	tx, err := c.BroadcastTx(ctx, []sdk.Msg{msgDisableRefund}, &txOption)
@@ -153,7 +177,10 @@ func (c *Client) DisableRefund(ctx context.Context, paymentAddress string, txOpt
 // - ret1: The response of streams records for the user address.
 //
 // - ret2: Return error when querying payment accounts failed, otherwise return nil.
-func (c *Client) ListUserPaymentAccounts(ctx context.Context, opts types.ListUserPaymentAccountsOptions) (types.ListUserPaymentAccountsResult, error) {
+func (c *Client) ListUserPaymentAccounts(ctx context.Context, opts types.ListUserPaymentAccountsOptions) (_ types.ListUserPaymentAccountsResult, err error) {
+	ctx, span := c.startSpan(ctx, "client.ListUserPaymentAccounts")
+	defer func() { endSpan(span, err) }()
+
 	params := url.Values{}
 	params.Set("user-payments", "")
 
@@ -38,8 +38,15 @@ type IProposalClient interface {
 // - ret2: Transaction hash of the transaction.
 //
 // - ret3: Return error if the transaction failed, otherwise return nil.
-func (c *Client) SubmitProposal(ctx context.Context, msgs []sdk.Msg, depositAmount math.Int, title, summary string, opts types.SubmitProposalOptions) (uint64, string, error) {
-	msgSubmitProposal, err := govTypesV1.NewMsgSubmitProposal(msgs, sdk.NewCoins(sdk.NewCoin(gnfdSdkTypes.Denom, depositAmount)), c.defaultAccount.GetAddress().String(), opts.Metadata, title, summary)
+func (c *Client) SubmitProposal(ctx context.Context, msgs []sdk.Msg, depositAmount math.Int, title, summary string, opts types.SubmitProposalOptions) (_ uint64, _ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.SubmitProposal")
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opts.Account)
+	if err != nil {
+		return 0, "", err
+	}
+	msgSubmitProposal, err := govTypesV1.NewMsgSubmitProposal(msgs, sdk.NewCoins(sdk.NewCoin(gnfdSdkTypes.Denom, depositAmount)), signer.GetAddress().String(), opts.Metadata, title, summary)
 	if err != nil {
 		return 0, "", err
 	}
@@ -47,7 +54,7 @@ func (c *Client) SubmitProposal(ctx context.Context, msgs []sdk.Msg, depositAmou
 	if err != nil {
 		return 0, "", err
 	}
-	txResp, err := c.BroadcastTx(ctx, []sdk.Msg{msgSubmitProposal}, &opts.TxOpts)
+	txResp, err := c.BroadcastTx(ctx, []sdk.Msg{msgSubmitProposal}, signerTxOpts(&opts.TxOpts, signer))
 	if err != nil {
 		return 0, "", err
 	}
@@ -85,9 +92,16 @@ func (c *Client) SubmitProposal(ctx context.Context, msgs []sdk.Msg, depositAmou
 // - ret1: Transaction hash of the transaction.
 //
 // - ret2: Return error if the transaction failed, otherwise return nil.
-func (c *Client) VoteProposal(ctx context.Context, proposalID uint64, voteOption govTypesV1.VoteOption, opts types.VoteProposalOptions) (string, error) {
-	msgVote := govTypesV1.NewMsgVote(c.MustGetDefaultAccount().GetAddress(), proposalID, voteOption, opts.Metadata)
-	resp, err := c.BroadcastTx(ctx, []sdk.Msg{msgVote}, &opts.TxOpts)
+func (c *Client) VoteProposal(ctx context.Context, proposalID uint64, voteOption govTypesV1.VoteOption, opts types.VoteProposalOptions) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.VoteProposal")
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opts.Account)
+	if err != nil {
+		return "", err
+	}
+	msgVote := govTypesV1.NewMsgVote(signer.GetAddress(), proposalID, voteOption, opts.Metadata)
+	resp, err := c.BroadcastTx(ctx, []sdk.Msg{msgVote}, signerTxOpts(&opts.TxOpts, signer))
 	if err != nil {
 		return "", err
 	}
@@ -103,7 +117,10 @@ func (c *Client) VoteProposal(ctx context.Context, proposalID uint64, voteOption
 // - ret1: Proposal by the queried proposal id.
 //
 // - ret2: Return error if the query failed, otherwise return nil.
-func (c *Client) GetProposal(ctx context.Context, proposalID uint64) (*govTypesV1.Proposal, error) {
+func (c *Client) GetProposal(ctx context.Context, proposalID uint64) (_ *govTypesV1.Proposal, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetProposal")
+	defer func() { endSpan(span, err) }()
+
 	resp, err := c.chainClient.GovQueryClientV1.Proposal(ctx, &govTypesV1.QueryProposalRequest{ProposalId: proposalID})
 	if err != nil {
 		return nil, nil
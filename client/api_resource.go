@@ -0,0 +1,186 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	resourcetypes "github.com/bnb-chain/greenfield/types/resource"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// IResourceClient interface defines functions addressing a bucket, an object or a group by its resource.
+type IResourceClient interface {
+	PutPolicy(ctx context.Context, resource types.Resource, principalStr types.Principal, statements []*permTypes.Statement,
+		opt types.PutPolicyOption) (string, error)
+	DeletePolicy(ctx context.Context, resource types.Resource, principalStr types.Principal, opt types.DeletePolicyOption) (string, error)
+	GetPolicy(ctx context.Context, resource types.Resource, principalStr types.Principal) (*permTypes.Policy, error)
+	GetTags(ctx context.Context, resource types.Resource) (*storageTypes.ResourceTags, error)
+}
+
+// PutPolicy - Apply a policy to the principal on the bucket, the object or the group, return the txn hash.
+// The policy of the principal on the resource is replaced.
+//
+// - ctx: Context variables for the current API call.
+//
+// - resource: The resource the policy is put to, e.g. types.NewObjectResource(bucketName, objectName).
+//
+// - principalStr: Indicates the marshaled principal content of greenfield permission types, users can generate it by NewPrincipalWithAccount or NewPrincipalWithGroupId method.
+//
+// - statements: Policies outline the specific details of permissions, including the Effect, ActionList, and Resources.
+//
+// - opt: The options for customizing the policy expiration time and transaction.
+//
+// - ret1: Transaction hash return from blockchain.
+//
+// - ret2: Return error when the request failed, otherwise return nil.
+func (c *Client) PutPolicy(ctx context.Context, resource types.Resource, principalStr types.Principal,
+	statements []*permTypes.Statement, opt types.PutPolicyOption,
+) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.PutPolicy")
+	defer func() { endSpan(span, err) }()
+
+	if err := resource.Validate(false); err != nil {
+		return "", err
+	}
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	if err = types.ValidateStatements(resource.Type, statements); err != nil {
+		return "", err
+	}
+	principal := &permTypes.Principal{}
+	if err = principal.Unmarshal([]byte(principalStr)); err != nil {
+		return "", err
+	}
+
+	putPolicyMsg := storageTypes.NewMsgPutPolicy(signer.GetAddress(), resource.String(),
+		principal, statements, opt.PolicyExpireTime)
+
+	return c.sendPutPolicyTxn(ctx, putPolicyMsg, signerTxOpts(opt.TxOpts, signer))
+}
+
+// DeletePolicy - Delete the policy of the principal on the bucket, the object or the group, return the txn hash.
+//
+// - ctx: Context variables for the current API call.
+//
+// - resource: The resource the policy is deleted from.
+//
+// - principalStr: Indicates the marshaled principal content of greenfield permission types, users can generate it by NewPrincipalWithAccount or NewPrincipalWithGroupId method.
+//
+// - opt: The options for customizing the transaction.
+//
+// - ret1: Transaction hash return from blockchain.
+//
+// - ret2: Return error when the request failed, otherwise return nil.
+func (c *Client) DeletePolicy(ctx context.Context, resource types.Resource, principalStr types.Principal,
+	opt types.DeletePolicyOption,
+) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.DeletePolicy")
+	defer func() { endSpan(span, err) }()
+
+	if err := resource.Validate(false); err != nil {
+		return "", err
+	}
+	signer, err := c.getSigner(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	principal := &permTypes.Principal{}
+	if err = principal.Unmarshal([]byte(principalStr)); err != nil {
+		return "", err
+	}
+
+	return c.sendDelPolicyTxn(ctx, signer.GetAddress(), resource.String(), principal, signerTxOpts(opt.TxOpts, signer))
+}
+
+// GetPolicy - Get the policy of the principal on the bucket, the object or the group.
+//
+// - ctx: Context variables for the current API call.
+//
+// - resource: The resource the policy is put to.
+//
+// - principalStr: Indicates the marshaled principal content of greenfield permission types, the principal is either
+// an account or a group.
+//
+// - ret1: The policy of the principal on the resource.
+//
+// - ret2: Return error when the policy does not exist or the request failed, otherwise return nil.
+func (c *Client) GetPolicy(ctx context.Context, resource types.Resource, principalStr types.Principal) (_ *permTypes.Policy, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetPolicy")
+	defer func() { endSpan(span, err) }()
+
+	if err := resource.Validate(false); err != nil {
+		return nil, err
+	}
+	principal := &permTypes.Principal{}
+	if err := principal.Unmarshal([]byte(principalStr)); err != nil {
+		return nil, err
+	}
+
+	switch principal.Type {
+	case permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT:
+		queryPolicyResp, err := c.chainClient.QueryPolicyForAccount(ctx, &storageTypes.QueryPolicyForAccountRequest{
+			Resource:         resource.String(),
+			PrincipalAddress: principal.Value,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return queryPolicyResp.Policy, nil
+	case permTypes.PRINCIPAL_TYPE_GNFD_GROUP:
+		queryPolicyResp, err := c.chainClient.QueryPolicyForGroup(ctx, &storageTypes.QueryPolicyForGroupRequest{
+			Resource:         resource.String(),
+			PrincipalGroupId: principal.Value,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return queryPolicyResp.Policy, nil
+	default:
+		return nil, fmt.Errorf("unknown principal type %s", principal.Type)
+	}
+}
+
+// GetTags - Get the tags of the bucket, the object or the group, the tags are always queried from the chain rather
+// than the cache.
+//
+// - ctx: Context variables for the current API call.
+//
+// - resource: The resource whose tags are returned.
+//
+// - ret1: The tags of the resource, it is nil if the resource has no tags.
+//
+// - ret2: Return error when the resource does not exist or the request failed, otherwise return nil.
+func (c *Client) GetTags(ctx context.Context, resource types.Resource) (_ *storageTypes.ResourceTags, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetTags")
+	defer func() { endSpan(span, err) }()
+
+	if err := resource.Validate(false); err != nil {
+		return nil, err
+	}
+	switch resource.Type {
+	case resourcetypes.RESOURCE_TYPE_BUCKET:
+		bucketInfo, err := c.headBucket(ctx, resource.BucketName)
+		if err != nil {
+			return nil, err
+		}
+		return bucketInfo.Tags, nil
+	case resourcetypes.RESOURCE_TYPE_OBJECT:
+		objectDetail, err := c.HeadObject(ctx, resource.BucketName, resource.ObjectName)
+		if err != nil {
+			return nil, err
+		}
+		return objectDetail.ObjectInfo.Tags, nil
+	case resourcetypes.RESOURCE_TYPE_GROUP:
+		groupInfo, err := c.HeadGroup(ctx, resource.GroupName, resource.GroupOwner)
+		if err != nil {
+			return nil, err
+		}
+		return groupInfo.Tags, nil
+	default:
+		return nil, fmt.Errorf("unknown resource type %s", resource.Type)
+	}
+}
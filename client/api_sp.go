@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	math2 "math"
+	"sort"
 	"strings"
 	"time"
 
@@ -30,6 +31,8 @@ type ISPClient interface {
 	CreateStorageProvider(ctx context.Context, fundingAddr, sealAddr, approvalAddr, gcAddr, maintenanceAddr, blsPubKey, blsProof, endpoint string, depositAmount math.Int, description spTypes.Description, opts types.CreateStorageProviderOptions) (uint64, string, error)
 	UpdateSpStoragePrice(ctx context.Context, spAddr string, readPrice, storePrice sdk.Dec, freeReadQuota uint64, txOption gnfdSdkTypes.TxOption) (string, error)
 	UpdateSpStatus(ctx context.Context, spAddr string, status spTypes.Status, duration int64, txOption gnfdSdkTypes.TxOption) (string, error)
+	ProbeStorageProviders(ctx context.Context) error
+	GetStorageProvidersHealth() []types.SPHealthScore
 }
 
 // GetStoragePrice - Get the storage price details for a particular storage provider, including update time, read price, store price and .etc.
@@ -41,7 +44,10 @@ type ISPClient interface {
 // - ret1: The specified storage provider price detail.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetStoragePrice(ctx context.Context, spAddr string) (*spTypes.SpStoragePrice, error) {
+func (c *Client) GetStoragePrice(ctx context.Context, spAddr string) (_ *spTypes.SpStoragePrice, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetStoragePrice")
+	defer func() { endSpan(span, err) }()
+
 	spAcc, err := sdk.AccAddressFromHexUnsafe(spAddr)
 	if err != nil {
 		return nil, err
@@ -62,7 +68,10 @@ func (c *Client) GetStoragePrice(ctx context.Context, spAddr string) (*spTypes.S
 // - ret1: The global storage provider price detail.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetGlobalSpStorePrice(ctx context.Context) (*spTypes.GlobalSpStorePrice, error) {
+func (c *Client) GetGlobalSpStorePrice(ctx context.Context) (_ *spTypes.GlobalSpStorePrice, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetGlobalSpStorePrice")
+	defer func() { endSpan(span, err) }()
+
 	resp, err := c.chainClient.QueryGlobalSpStorePriceByTime(ctx, &spTypes.QueryGlobalSpStorePriceByTimeRequest{
 		Timestamp: 0,
 	})
@@ -81,14 +90,23 @@ func (c *Client) GetGlobalSpStorePrice(ctx context.Context) (*spTypes.GlobalSpSt
 // - ret1: The global storage provider price detail.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) ListStorageProviders(ctx context.Context, isInService bool) ([]spTypes.StorageProvider, error) {
-	request := &spTypes.QueryStorageProvidersRequest{}
-	gnfdRep, err := c.chainClient.StorageProviders(ctx, request)
-	if err != nil {
-		return nil, err
+func (c *Client) ListStorageProviders(ctx context.Context, isInService bool) (_ []spTypes.StorageProvider, err error) {
+	ctx, span := c.startSpan(ctx, "client.ListStorageProviders")
+	defer func() { endSpan(span, err) }()
+
+	var spList []*spTypes.StorageProvider
+	if cached, ok := c.cache.get(cacheKindStorageProviders, ""); ok {
+		spList = cached.([]*spTypes.StorageProvider)
+	} else {
+		request := &spTypes.QueryStorageProvidersRequest{}
+		gnfdRep, err := c.chainClient.StorageProviders(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		spList = gnfdRep.GetSps()
+		c.cache.set(cacheKindStorageProviders, "", spList)
 	}
 
-	spList := gnfdRep.GetSps()
 	spInfoList := make([]spTypes.StorageProvider, 0)
 	for _, info := range spList {
 		if isInService && info.Status != spTypes.STATUS_IN_SERVICE {
@@ -109,7 +127,10 @@ func (c *Client) ListStorageProviders(ctx context.Context, isInService bool) ([]
 // - ret1: The Storage provider detail.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GetStorageProviderInfo(ctx context.Context, spAddr sdk.AccAddress) (*spTypes.StorageProvider, error) {
+func (c *Client) GetStorageProviderInfo(ctx context.Context, spAddr sdk.AccAddress) (_ *spTypes.StorageProvider, err error) {
+	ctx, span := c.startSpan(ctx, "client.GetStorageProviderInfo")
+	defer func() { endSpan(span, err) }()
+
 	request := &spTypes.QueryStorageProviderByOperatorAddressRequest{
 		OperatorAddress: spAddr.String(),
 	}
@@ -127,6 +148,7 @@ func (c *Client) refreshStorageProviders(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	sps := make([]*types.StorageProvider, 0, len(gnfdRep.Sps))
 	for _, spInfo := range gnfdRep.Sps {
 		var useHttps bool
 		if strings.Contains(spInfo.Endpoint, "https") {
@@ -149,11 +171,36 @@ func (c *Client) refreshStorageProviders(ctx context.Context) error {
 			Description:     spInfo.Description,
 			BlsKey:          spInfo.BlsKey,
 		}
+		sps = append(sps, sp)
+	}
+	c.spMtx.Lock()
+	defer c.spMtx.Unlock()
+	for _, sp := range sps {
 		c.storageProviders[sp.Id] = sp
 	}
 	return nil
 }
 
+// storageProvider returns the storage provider of the id.
+func (c *Client) storageProvider(id uint32) (*types.StorageProvider, bool) {
+	c.spMtx.RLock()
+	defer c.spMtx.RUnlock()
+	sp, ok := c.storageProviders[id]
+	return sp, ok
+}
+
+// storageProviderList returns a snapshot of the storage providers in the order of their ids.
+func (c *Client) storageProviderList() []*types.StorageProvider {
+	c.spMtx.RLock()
+	sps := make([]*types.StorageProvider, 0, len(c.storageProviders))
+	for _, sp := range c.storageProviders {
+		sps = append(sps, sp)
+	}
+	c.spMtx.RUnlock()
+	sort.Slice(sps, func(i, j int) bool { return sps[i].Id < sps[j].Id })
+	return sps
+}
+
 // CreateStorageProvider - Submit a CreateStorageProvider proposal and return proposalID, TxHash and err if it has.
 //
 // - ctx: Context variables for the current API call.
@@ -185,8 +232,14 @@ func (c *Client) refreshStorageProviders(ctx context.Context) error {
 // - ret2: Transaction hash return from blockchain.
 //
 // - ret3: Return error when the request failed, otherwise return nil.
-func (c *Client) CreateStorageProvider(ctx context.Context, fundingAddr, sealAddr, approvalAddr, gcAddr, maintenanceAddr, blsPubKey, blsProof, endpoint string, depositAmount math.Int, description spTypes.Description, opts types.CreateStorageProviderOptions) (uint64, string, error) {
-	defaultAccount := c.MustGetDefaultAccount()
+func (c *Client) CreateStorageProvider(ctx context.Context, fundingAddr, sealAddr, approvalAddr, gcAddr, maintenanceAddr, blsPubKey, blsProof, endpoint string, depositAmount math.Int, description spTypes.Description, opts types.CreateStorageProviderOptions) (_ uint64, _ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.CreateStorageProvider")
+	defer func() { endSpan(span, err) }()
+
+	signer, err := c.getSigner(opts.Account)
+	if err != nil {
+		return 0, "", err
+	}
 	govModuleAddress, err := c.GetModuleAccountByName(ctx, govTypes.ModuleName)
 	if err != nil {
 		return 0, "", err
@@ -231,7 +284,7 @@ func (c *Client) CreateStorageProvider(ctx context.Context, fundingAddr, sealAdd
 	}
 	msgCreateStorageProvider, err := spTypes.NewMsgCreateStorageProvider(
 		govModuleAddress.GetAddress(),
-		defaultAccount.GetAddress(),
+		signer.GetAddress(),
 		fundingAcc, sealAcc, approvalAcc, gcAcc, maintenanceAcc,
 		description,
 		endpoint,
@@ -250,7 +303,7 @@ func (c *Client) CreateStorageProvider(ctx context.Context, fundingAddr, sealAdd
 		return 0, "", err
 	}
 
-	return c.SubmitProposal(ctx, []sdk.Msg{msgCreateStorageProvider}, opts.ProposalDepositAmount, opts.ProposalTitle, opts.ProposalSummary, types.SubmitProposalOptions{Metadata: opts.ProposalMetaData, TxOpts: opts.TxOpts})
+	return c.SubmitProposal(ctx, []sdk.Msg{msgCreateStorageProvider}, opts.ProposalDepositAmount, opts.ProposalTitle, opts.ProposalSummary, types.SubmitProposalOptions{Metadata: opts.ProposalMetaData, TxOpts: opts.TxOpts, Account: signer})
 }
 
 // GrantDepositForStorageProvider - Grant transaction to allow Gov module account to deduct the specified number of tokens.
@@ -266,8 +319,14 @@ func (c *Client) CreateStorageProvider(ctx context.Context, fundingAddr, sealAdd
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) GrantDepositForStorageProvider(ctx context.Context, spAddr string, depositAmount math.Int, opts types.GrantDepositForStorageProviderOptions) (string, error) {
-	granter := c.MustGetDefaultAccount()
+func (c *Client) GrantDepositForStorageProvider(ctx context.Context, spAddr string, depositAmount math.Int, opts types.GrantDepositForStorageProviderOptions) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.GrantDepositForStorageProvider")
+	defer func() { endSpan(span, err) }()
+
+	granter, err := c.getSigner(opts.Account)
+	if err != nil {
+		return "", err
+	}
 	govModuleAddress, err := c.GetModuleAccountByName(ctx, govTypes.ModuleName)
 	if err != nil {
 		return "", err
@@ -287,7 +346,7 @@ func (c *Client) GrantDepositForStorageProvider(ctx context.Context, spAddr stri
 	if err != nil {
 		return "", err
 	}
-	resp, err := c.BroadcastTx(ctx, []sdk.Msg{msgGrant}, &opts.TxOpts)
+	resp, err := c.BroadcastTx(ctx, []sdk.Msg{msgGrant}, signerTxOpts(&opts.TxOpts, granter))
 	if err != nil {
 		return "", err
 	}
@@ -311,7 +370,10 @@ func (c *Client) GrantDepositForStorageProvider(ctx context.Context, spAddr stri
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) UpdateSpStoragePrice(ctx context.Context, spAddr string, readPrice, storePrice sdk.Dec, freeReadQuota uint64, TxOption gnfdSdkTypes.TxOption) (string, error) {
+func (c *Client) UpdateSpStoragePrice(ctx context.Context, spAddr string, readPrice, storePrice sdk.Dec, freeReadQuota uint64, TxOption gnfdSdkTypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.UpdateSpStoragePrice")
+	defer func() { endSpan(span, err) }()
+
 	spAcc, err := sdk.AccAddressFromHexUnsafe(spAddr)
 	if err != nil {
 		return "", err
@@ -344,7 +406,10 @@ func (c *Client) UpdateSpStoragePrice(ctx context.Context, spAddr string, readPr
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) UpdateSpStatus(ctx context.Context, spAddr string, status spTypes.Status, duration int64, TxOption gnfdSdkTypes.TxOption) (string, error) {
+func (c *Client) UpdateSpStatus(ctx context.Context, spAddr string, status spTypes.Status, duration int64, TxOption gnfdSdkTypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.UpdateSpStatus")
+	defer func() { endSpan(span, err) }()
+
 	spAcc, err := sdk.AccAddressFromHexUnsafe(spAddr)
 	if err != nil {
 		return "", err
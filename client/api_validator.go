@@ -44,7 +44,10 @@ type IValidatorClient interface {
 // - ret1: The information of validators.
 //
 // - ret2: Return error when getting validators failed, otherwise return nil.
-func (c *Client) ListValidators(ctx context.Context, status string) (*stakingtypes.QueryValidatorsResponse, error) {
+func (c *Client) ListValidators(ctx context.Context, status string) (_ *stakingtypes.QueryValidatorsResponse, err error) {
+	ctx, span := c.startSpan(ctx, "client.ListValidators")
+	defer func() { endSpan(span, err) }()
+
 	return c.chainClient.StakingQueryClient.Validators(ctx, &stakingtypes.QueryValidatorsRequest{Status: status})
 }
 
@@ -90,7 +93,10 @@ func (c *Client) ListValidators(ctx context.Context, status string) (*stakingtyp
 func (c *Client) CreateValidator(ctx context.Context, description stakingtypes.Description, commission stakingtypes.CommissionRates,
 	selfDelegation math.Int, validatorAddress string, ed25519PubKey string, selfDelAddr string, relayerAddr string, challengerAddr string, blsKey, blsProof string,
 	proposalDepositAmount math.Int, proposalTitle, proposalSummary, proposalMetadata string, txOption gnfdsdktypes.TxOption,
-) (uint64, string, error) {
+) (_ uint64, _ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.CreateValidator")
+	defer func() { endSpan(span, err) }()
+
 	govModule, err := c.GetModuleAccountByName(ctx, govTypes.ModuleName)
 	if err != nil {
 		return 0, "", err
@@ -152,7 +158,14 @@ func (c *Client) CreateValidator(ctx context.Context, description stakingtypes.D
 // - ret2: Return error when edit validator tx failed, otherwise return nil.
 func (c *Client) EditValidator(ctx context.Context, description stakingtypes.Description,
 	newRate *sdktypes.Dec, newMinSelfDelegation *math.Int, newRelayerAddr, newChallengerAddr, newBlsKey, newBlsProof string, txOption gnfdsdktypes.TxOption,
-) (string, error) {
+) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.EditValidator")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
 	relayer, err := sdktypes.AccAddressFromHexUnsafe(newRelayerAddr)
 	if err != nil {
 		return "", err
@@ -161,7 +174,7 @@ func (c *Client) EditValidator(ctx context.Context, description stakingtypes.Des
 	if err != nil {
 		return "", err
 	}
-	msg := stakingtypes.NewMsgEditValidator(c.MustGetDefaultAccount().GetAddress(), description, newRate, newMinSelfDelegation, relayer, challenger, newBlsKey, newBlsProof)
+	msg := stakingtypes.NewMsgEditValidator(sender, description, newRate, newMinSelfDelegation, relayer, challenger, newBlsKey, newBlsProof)
 	resp, err := c.BroadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
@@ -182,12 +195,19 @@ func (c *Client) EditValidator(ctx context.Context, description stakingtypes.Des
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error when delegation tx failed, otherwise return nil.
-func (c *Client) DelegateValidator(ctx context.Context, validatorAddr string, amount math.Int, txOption gnfdsdktypes.TxOption) (string, error) {
+func (c *Client) DelegateValidator(ctx context.Context, validatorAddr string, amount math.Int, txOption gnfdsdktypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.DelegateValidator")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
 	validator, err := sdktypes.AccAddressFromHexUnsafe(validatorAddr)
 	if err != nil {
 		return "", err
 	}
-	msg := stakingtypes.NewMsgDelegate(c.MustGetDefaultAccount().GetAddress(), validator, sdktypes.NewCoin(gnfdsdktypes.Denom, amount))
+	msg := stakingtypes.NewMsgDelegate(sender, validator, sdktypes.NewCoin(gnfdsdktypes.Denom, amount))
 	resp, err := c.BroadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
@@ -210,7 +230,14 @@ func (c *Client) DelegateValidator(ctx context.Context, validatorAddr string, am
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error when re-delegation tx failed, otherwise return nil.
-func (c *Client) BeginRedelegate(ctx context.Context, validatorSrcAddr, validatorDestAddr string, amount math.Int, txOption gnfdsdktypes.TxOption) (string, error) {
+func (c *Client) BeginRedelegate(ctx context.Context, validatorSrcAddr, validatorDestAddr string, amount math.Int, txOption gnfdsdktypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.BeginRedelegate")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
 	validatorSrc, err := sdktypes.AccAddressFromHexUnsafe(validatorSrcAddr)
 	if err != nil {
 		return "", err
@@ -219,7 +246,7 @@ func (c *Client) BeginRedelegate(ctx context.Context, validatorSrcAddr, validato
 	if err != nil {
 		return "", err
 	}
-	msg := stakingtypes.NewMsgBeginRedelegate(c.MustGetDefaultAccount().GetAddress(), validatorSrc, validatorDest, sdktypes.NewCoin(gnfdsdktypes.Denom, amount))
+	msg := stakingtypes.NewMsgBeginRedelegate(sender, validatorSrc, validatorDest, sdktypes.NewCoin(gnfdsdktypes.Denom, amount))
 	resp, err := c.BroadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
@@ -240,12 +267,19 @@ func (c *Client) BeginRedelegate(ctx context.Context, validatorSrcAddr, validato
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error when un-delegation tx failed, otherwise return nil.
-func (c *Client) Undelegate(ctx context.Context, validatorAddr string, amount math.Int, txOption gnfdsdktypes.TxOption) (string, error) {
+func (c *Client) Undelegate(ctx context.Context, validatorAddr string, amount math.Int, txOption gnfdsdktypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.Undelegate")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
 	validator, err := sdktypes.AccAddressFromHexUnsafe(validatorAddr)
 	if err != nil {
 		return "", err
 	}
-	msg := stakingtypes.NewMsgUndelegate(c.MustGetDefaultAccount().GetAddress(), validator, sdktypes.NewCoin(gnfdsdktypes.Denom, amount))
+	msg := stakingtypes.NewMsgUndelegate(sender, validator, sdktypes.NewCoin(gnfdsdktypes.Denom, amount))
 	resp, err := c.BroadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
@@ -268,12 +302,19 @@ func (c *Client) Undelegate(ctx context.Context, validatorAddr string, amount ma
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error when cancel unbonding delegation tx failed, otherwise return nil.
-func (c *Client) CancelUnbondingDelegation(ctx context.Context, validatorAddr string, creationHeight int64, amount math.Int, txOption gnfdsdktypes.TxOption) (string, error) {
+func (c *Client) CancelUnbondingDelegation(ctx context.Context, validatorAddr string, creationHeight int64, amount math.Int, txOption gnfdsdktypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.CancelUnbondingDelegation")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
 	validator, err := sdktypes.AccAddressFromHexUnsafe(validatorAddr)
 	if err != nil {
 		return "", err
 	}
-	msg := stakingtypes.NewMsgCancelUnbondingDelegation(c.MustGetDefaultAccount().GetAddress(), validator, creationHeight, sdktypes.NewCoin(gnfdsdktypes.Denom, amount))
+	msg := stakingtypes.NewMsgCancelUnbondingDelegation(sender, validator, creationHeight, sdktypes.NewCoin(gnfdsdktypes.Denom, amount))
 	resp, err := c.BroadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
@@ -292,20 +333,27 @@ func (c *Client) CancelUnbondingDelegation(ctx context.Context, validatorAddr st
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error when grant delegation tx failed, otherwise return nil.
-func (c *Client) GrantDelegationForValidator(ctx context.Context, delegationAmount math.Int, txOption gnfdsdktypes.TxOption) (string, error) {
+func (c *Client) GrantDelegationForValidator(ctx context.Context, delegationAmount math.Int, txOption gnfdsdktypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.GrantDelegationForValidator")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
 	govModule, err := c.GetModuleAccountByName(ctx, govTypes.ModuleName)
 	if err != nil {
 		return "", err
 	}
 	delegationCoin := sdktypes.NewCoin(gnfdsdktypes.Denom, delegationAmount)
-	authorization, err := stakingtypes.NewStakeAuthorization([]sdktypes.AccAddress{c.MustGetDefaultAccount().GetAddress()},
+	authorization, err := stakingtypes.NewStakeAuthorization([]sdktypes.AccAddress{sender},
 		nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
 		&delegationCoin)
 	if err != nil {
 		return "", err
 	}
 
-	msgGrant, err := authz.NewMsgGrant(c.MustGetDefaultAccount().GetAddress(),
+	msgGrant, err := authz.NewMsgGrant(sender,
 		govModule.GetAddress(),
 		authorization, nil)
 	if err != nil {
@@ -330,8 +378,15 @@ func (c *Client) GrantDelegationForValidator(ctx context.Context, delegationAmou
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error when unjail validator tx failed, otherwise return nil.
-func (c *Client) UnJailValidator(ctx context.Context, txOption gnfdsdktypes.TxOption) (string, error) {
-	msg := slashingtypes.NewMsgUnjail(c.MustGetDefaultAccount().GetAddress())
+func (c *Client) UnJailValidator(ctx context.Context, txOption gnfdsdktypes.TxOption) (_ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.UnJailValidator")
+	defer func() { endSpan(span, err) }()
+
+	sender, err := c.getTxSignerAddr(&txOption)
+	if err != nil {
+		return "", err
+	}
+	msg := slashingtypes.NewMsgUnjail(sender)
 	resp, err := c.BroadcastTx(ctx, []sdktypes.Msg{msg}, &txOption)
 	if err != nil {
 		return "", err
@@ -358,7 +413,10 @@ func (c *Client) UnJailValidator(ctx context.Context, txOption gnfdsdktypes.TxOp
 // - ret1: Transaction hash return from blockchain.
 //
 // - ret2: Return error when unjail validator tx failed, otherwise return nil.
-func (c *Client) ImpeachValidator(ctx context.Context, validatorAddr string, proposalDepositAmount math.Int, proposalTitle, proposalSummary, proposalMetadata string, txOption gnfdsdktypes.TxOption) (uint64, string, error) {
+func (c *Client) ImpeachValidator(ctx context.Context, validatorAddr string, proposalDepositAmount math.Int, proposalTitle, proposalSummary, proposalMetadata string, txOption gnfdsdktypes.TxOption) (_ uint64, _ string, err error) {
+	ctx, span := c.startSpan(ctx, "client.ImpeachValidator")
+	defer func() { endSpan(span, err) }()
+
 	validator, err := sdktypes.AccAddressFromHexUnsafe(validatorAddr)
 	if err != nil {
 		return 0, "", err
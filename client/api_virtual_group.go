@@ -25,7 +25,10 @@ type IVirtualGroupClient interface {
 // - ret1: The virtual group family detail.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) QueryVirtualGroupFamily(ctx context.Context, globalVirtualGroupFamilyID uint32) (*types.GlobalVirtualGroupFamily, error) {
+func (c *Client) QueryVirtualGroupFamily(ctx context.Context, globalVirtualGroupFamilyID uint32) (_ *types.GlobalVirtualGroupFamily, err error) {
+	ctx, span := c.startSpan(ctx, "client.QueryVirtualGroupFamily")
+	defer func() { endSpan(span, err) }()
+
 	queryResponse, err := c.chainClient.GlobalVirtualGroupFamily(ctx, &types.QueryGlobalVirtualGroupFamilyRequest{
 		FamilyId: globalVirtualGroupFamilyID,
 	})
@@ -46,7 +49,10 @@ func (c *Client) QueryVirtualGroupFamily(ctx context.Context, globalVirtualGroup
 // - ret1: The virtual group family detail.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) QuerySpAvailableGlobalVirtualGroupFamilies(ctx context.Context, spID uint32) ([]uint32, error) {
+func (c *Client) QuerySpAvailableGlobalVirtualGroupFamilies(ctx context.Context, spID uint32) (_ []uint32, err error) {
+	ctx, span := c.startSpan(ctx, "client.QuerySpAvailableGlobalVirtualGroupFamilies")
+	defer func() { endSpan(span, err) }()
+
 	queryResponse, err := c.chainClient.QuerySpAvailableGlobalVirtualGroupFamilies(ctx, &types.QuerySPAvailableGlobalVirtualGroupFamiliesRequest{
 		SpId: spID,
 	})
@@ -67,7 +73,10 @@ func (c *Client) QuerySpAvailableGlobalVirtualGroupFamilies(ctx context.Context,
 // - ret1: The virtual group family detail.
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) QuerySpOptimalGlobalVirtualGroupFamily(ctx context.Context, spID uint32, strategy types.PickVGFStrategy) (uint32, error) {
+func (c *Client) QuerySpOptimalGlobalVirtualGroupFamily(ctx context.Context, spID uint32, strategy types.PickVGFStrategy) (_ uint32, err error) {
+	ctx, span := c.startSpan(ctx, "client.QuerySpOptimalGlobalVirtualGroupFamily")
+	defer func() { endSpan(span, err) }()
+
 	queryResponse, err := c.chainClient.QuerySpOptimalGlobalVirtualGroupFamily(ctx, &types.QuerySpOptimalGlobalVirtualGroupFamilyRequest{
 		SpId:            spID,
 		PickVgfStrategy: strategy,
@@ -87,7 +96,10 @@ func (c *Client) QuerySpOptimalGlobalVirtualGroupFamily(ctx context.Context, spI
 // - ret1: Params holds all the parameters of this module..
 //
 // - ret2: Return error when the request failed, otherwise return nil.
-func (c *Client) QueryVirtualGroupParams(ctx context.Context) (*types.Params, error) {
+func (c *Client) QueryVirtualGroupParams(ctx context.Context) (_ *types.Params, err error) {
+	ctx, span := c.startSpan(ctx, "client.QueryVirtualGroupParams")
+	defer func() { endSpan(span, err) }()
+
 	queryResponse, err := c.chainClient.VirtualGroupQueryClient.Params(ctx, &types.QueryParamsRequest{})
 	if err != nil {
 		return nil, err
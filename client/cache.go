@@ -0,0 +1,207 @@
+package client
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// Cache - The storage of the metadata cached by the Client, it can be replaced by another in-process cache.
+//
+// The values are the Go values of the metadata and are not encoded, so the implementation should store them as they
+// are rather than in an external store such as redis. The implementation should be safe for concurrent use, and an
+// expired entry should not be returned by Get.
+type Cache interface {
+	// Get returns the value of the key, ok is false if the key does not exist or has expired.
+	Get(key string) (value interface{}, ok bool)
+	// Set stores the value of the key, the entry expires after ttl.
+	Set(key string, value interface{}, ttl time.Duration)
+	// Delete removes the key.
+	Delete(key string)
+}
+
+// CacheOption - The configurations for caching the metadata queried from the chain.
+//
+// The cached entries may be stale until they expire, so the TTLs should be short if the metadata is updated by others.
+type CacheOption struct {
+	// Cache is the storage of the entries, an in-memory cache is used if it is not set.
+	Cache Cache
+	// ParamsTTL is the TTL of the storage params, the default value is 10m.
+	ParamsTTL time.Duration
+	// StorageProvidersTTL is the TTL of the SP list, the default value is 1m.
+	StorageProvidersTTL time.Duration
+	// BucketRouteTTL is the TTL of the primary SP of a bucket, the default value is 5m.
+	BucketRouteTTL time.Duration
+	// BucketInfoTTL is the TTL of the HeadBucket results, the default value is 30s.
+	BucketInfoTTL time.Duration
+}
+
+type memoryCacheEntry struct {
+	value    interface{}
+	expireAt time.Time
+}
+
+// memoryCache is the in-memory Cache used by default.
+type memoryCache struct {
+	mtx     sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+// NewMemoryCache - Create an in-memory Cache, the expired entries are removed when they are read.
+func NewMemoryCache() Cache {
+	return &memoryCache{entries: make(map[string]memoryCacheEntry)}
+}
+
+func (m *memoryCache) Get(key string) (interface{}, bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	entry, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expireAt) {
+		delete(m.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (m *memoryCache) Set(key string, value interface{}, ttl time.Duration) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.entries[key] = memoryCacheEntry{value: value, expireAt: time.Now().Add(ttl)}
+}
+
+func (m *memoryCache) Delete(key string) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	delete(m.entries, key)
+}
+
+const (
+	cacheKindParams           = "params"
+	cacheKindStorageProviders = "sps"
+	cacheKindBucketRoute      = "route"
+	cacheKindBucketInfo       = "bucket"
+)
+
+type cacheCounter struct {
+	hits   uint64
+	misses uint64
+}
+
+func (c *cacheCounter) stats() types.CacheCounter {
+	return types.CacheCounter{Hits: atomic.LoadUint64(&c.hits), Misses: atomic.LoadUint64(&c.misses)}
+}
+
+// clientCache wraps the Cache with the TTL and the hit/miss counter of each kind of metadata. A nil clientCache
+// disables the caching.
+type clientCache struct {
+	cache    Cache
+	ttls     map[string]time.Duration
+	counters map[string]*cacheCounter
+}
+
+func newClientCache(opt *CacheOption) *clientCache {
+	if opt == nil {
+		return nil
+	}
+	cache := opt.Cache
+	if cache == nil {
+		cache = NewMemoryCache()
+	}
+	ttl := func(configured, defaultTTL time.Duration) time.Duration {
+		if configured > 0 {
+			return configured
+		}
+		return defaultTTL
+	}
+	return &clientCache{
+		cache: cache,
+		ttls: map[string]time.Duration{
+			cacheKindParams:           ttl(opt.ParamsTTL, types.DefaultCacheParamsTTL),
+			cacheKindStorageProviders: ttl(opt.StorageProvidersTTL, types.DefaultCacheStorageProvidersTTL),
+			cacheKindBucketRoute:      ttl(opt.BucketRouteTTL, types.DefaultCacheBucketRouteTTL),
+			cacheKindBucketInfo:       ttl(opt.BucketInfoTTL, types.DefaultCacheBucketInfoTTL),
+		},
+		counters: map[string]*cacheCounter{
+			cacheKindParams:           {},
+			cacheKindStorageProviders: {},
+			cacheKindBucketRoute:      {},
+			cacheKindBucketInfo:       {},
+		},
+	}
+}
+
+func cacheKey(kind, name string) string {
+	return strings.Join([]string{"gnfd", kind, name}, "/")
+}
+
+func (cc *clientCache) get(kind, name string) (interface{}, bool) {
+	if cc == nil {
+		return nil, false
+	}
+	value, ok := cc.cache.Get(cacheKey(kind, name))
+	if ok {
+		atomic.AddUint64(&cc.counters[kind].hits, 1)
+	} else {
+		atomic.AddUint64(&cc.counters[kind].misses, 1)
+	}
+	return value, ok
+}
+
+func (cc *clientCache) set(kind, name string, value interface{}) {
+	if cc == nil {
+		return
+	}
+	cc.cache.Set(cacheKey(kind, name), value, cc.ttls[kind])
+}
+
+// invalidateBucket removes the routing and the info of the bucket.
+func (cc *clientCache) invalidateBucket(bucketName string) {
+	if cc == nil {
+		return
+	}
+	cc.cache.Delete(cacheKey(cacheKindBucketRoute, bucketName))
+	cc.cache.Delete(cacheKey(cacheKindBucketInfo, bucketName))
+}
+
+// invalidateOnError removes the cached bucket if the SP responds that the bucket does not exist on it, e.g. the bucket
+// has been migrated to another SP.
+func (cc *clientCache) invalidateOnError(bucketName string, err error) {
+	var errResp types.ErrResponse
+	if cc == nil || bucketName == "" || !errors.As(err, &errResp) {
+		return
+	}
+	if errResp.Code == "NoSuchBucket" {
+		cc.invalidateBucket(bucketName)
+	}
+}
+
+// InvalidateBucketCache - Remove the cached primary SP and info of the bucket, the next request queries them from the chain.
+//
+// - bucketName: The name of the bucket.
+func (c *Client) InvalidateBucketCache(bucketName string) {
+	c.cache.invalidateBucket(bucketName)
+}
+
+// GetCacheStats - Get the hit/miss counters of the metadata cache.
+//
+// - ret1: The counters of each kind of the cached metadata.
+//
+// - ret2: Return error when the cache is not configured, otherwise return nil.
+func (c *Client) GetCacheStats() (types.CacheStats, error) {
+	if c.cache == nil {
+		return types.CacheStats{}, errors.New("the cache is not configured")
+	}
+	return types.CacheStats{
+		Params:           c.cache.counters[cacheKindParams].stats(),
+		StorageProviders: c.cache.counters[cacheKindStorageProviders].stats(),
+		BucketRoutes:     c.cache.counters[cacheKindBucketRoute].stats(),
+		BucketInfos:      c.cache.counters[cacheKindBucketInfo].stats(),
+	}, nil
+}
@@ -0,0 +1,521 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	httplib "github.com/bnb-chain/greenfield-common/go/http"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// CassetteMode - The mode of the cassette, either recording the traffic or replaying the recorded traffic.
+type CassetteMode int
+
+const (
+	// CassetteRecord sends the requests to SP and the chain, and records the exchanges to the cassette file.
+	CassetteRecord CassetteMode = iota + 1
+	// CassetteReplay serves the exchanges recorded in the cassette file without sending any request.
+	CassetteReplay
+)
+
+// CassetteOption - The configurations for recording the SP HTTP exchanges and the chain query responses to a file, and
+// replaying them in the deterministic tests.
+//
+// A request is matched by its method, URL, headers and the SHA256 of its body, the volatile headers such as the date,
+// the expiry timestamp, the authorization and the trace context are ignored. The identical requests are replayed in
+// the recorded order, and the last exchange is replayed again once they are exhausted.
+// The chain queries and broadcasts are matched by the gRPC method and the encoded request, and the Tendermint RPC calls
+// such as the status, block and tx queries waiting for the txs are matched by the JSON-RPC method and params. They are
+// only recorded if the Client is not connected to the chain via websocket.
+type CassetteOption struct {
+	// Mode is either CassetteRecord or CassetteReplay.
+	Mode CassetteMode
+	// Path is the file of the cassette, it is truncated when the recording starts and each recorded exchange is appended
+	// to it as a line of JSON.
+	Path string
+	// IgnoreHeaders are the extra request headers ignored by the matching, e.g. the headers set by the interceptors.
+	IgnoreHeaders []string
+}
+
+// cassetteVolatileHeaders are the request headers ignored by the matching as they change on each request.
+var cassetteVolatileHeaders = []string{
+	types.HTTPHeaderAuthorization,
+	types.HTTPHeaderDate,
+	httplib.HTTPHeaderExpiryTimestamp,
+	types.HTTPHeaderUserAgent,
+	"Traceparent",
+	"Tracestate",
+}
+
+// cassetteEntry is a line of the cassette file holding one recorded exchange.
+type cassetteEntry struct {
+	SP         *spExchange         `json:"sp,omitempty"`
+	Chain      *chainExchange      `json:"chain,omitempty"`
+	Tendermint *tendermintExchange `json:"tendermint,omitempty"`
+}
+
+// cassetteFile is the JSON document of the cassettes recorded before the exchanges were appended as the lines, they
+// are still replayed.
+type cassetteFile struct {
+	SPExchanges    []*spExchange    `json:"sp_exchanges"`
+	ChainExchanges []*chainExchange `json:"chain_exchanges"`
+	// TendermintExchanges is omitted in the cassettes recorded before the Tendermint RPC calls were recorded
+	TendermintExchanges []*tendermintExchange `json:"tendermint_exchanges,omitempty"`
+}
+
+// spExchange is a recorded HTTP exchange with SP, Error is set instead of the response if the request failed to be sent.
+type spExchange struct {
+	Method         string      `json:"method"`
+	URL            string      `json:"url"`
+	Header         http.Header `json:"header"`
+	BodySHA256     string      `json:"body_sha256"`
+	StatusCode     int         `json:"status_code,omitempty"`
+	ResponseHeader http.Header `json:"response_header,omitempty"`
+	ResponseBody   []byte      `json:"response_body,omitempty"`
+	Error          string      `json:"error,omitempty"`
+}
+
+// chainExchange is a recorded gRPC call to the chain, the request and the reply are encoded in protobuf.
+type chainExchange struct {
+	Method    string     `json:"method"`
+	Request   []byte     `json:"request"`
+	Reply     []byte     `json:"reply,omitempty"`
+	ErrorCode codes.Code `json:"error_code,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// tendermintExchange is a recorded Tendermint JSON-RPC call, the response is replayed with the id of the request.
+type tendermintExchange struct {
+	Method       string          `json:"method"`
+	Params       json.RawMessage `json:"params,omitempty"`
+	StatusCode   int             `json:"status_code,omitempty"`
+	ResponseBody []byte          `json:"response_body,omitempty"`
+	Error        string          `json:"error,omitempty"`
+}
+
+// jsonRPCRequest is the JSON-RPC request sent by the Tendermint RPC client.
+type jsonRPCRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// cassette records or replays the exchanges of a Client.
+type cassette struct {
+	mode          CassetteMode
+	path          string
+	ignoreHeaders map[string]bool
+	transport     http.RoundTripper
+
+	mtx sync.Mutex
+	// replayed is the number of the replayed exchanges of each matching key
+	replayed map[string]int
+	// spIndex, chainIndex and tendermintIndex are the recorded exchanges of each matching key in the recorded order
+	spIndex         map[string][]*spExchange
+	chainIndex      map[string][]*chainExchange
+	tendermintIndex map[string][]*tendermintExchange
+}
+
+// newCassette creates the cassette, the cassette file is loaded in the replay mode. The transport sends the SP
+// requests in the record mode, http.DefaultTransport is used if it is nil.
+func newCassette(opt *CassetteOption, transport http.RoundTripper) (*cassette, error) {
+	if opt == nil {
+		return nil, nil
+	}
+	if opt.Path == "" {
+		return nil, errors.New("the path of the cassette can not be empty")
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	c := &cassette{
+		mode:          opt.Mode,
+		path:          opt.Path,
+		ignoreHeaders: make(map[string]bool),
+		transport:     transport,
+		replayed:      make(map[string]int),
+		spIndex:       make(map[string][]*spExchange),
+		chainIndex:    make(map[string][]*chainExchange),
+
+		tendermintIndex: make(map[string][]*tendermintExchange),
+	}
+	for _, header := range append(cassetteVolatileHeaders, opt.IgnoreHeaders...) {
+		c.ignoreHeaders[http.CanonicalHeaderKey(header)] = true
+	}
+
+	switch opt.Mode {
+	case CassetteRecord:
+		if err := os.WriteFile(opt.Path, nil, 0o644); err != nil {
+			return nil, fmt.Errorf("fail to create the cassette %s: %v", opt.Path, err)
+		}
+		return c, nil
+	case CassetteReplay:
+		f, err := os.Open(opt.Path)
+		if err != nil {
+			return nil, fmt.Errorf("fail to read the cassette %s: %v", opt.Path, err)
+		}
+		defer f.Close()
+		// a value of the file is either an entry or the whole document of a cassette recorded in the former format
+		decoder := json.NewDecoder(f)
+		for {
+			var value struct {
+				cassetteEntry
+				cassetteFile
+			}
+			if err = decoder.Decode(&value); err == io.EOF {
+				return c, nil
+			} else if err != nil {
+				return nil, fmt.Errorf("fail to parse the cassette %s: %v", opt.Path, err)
+			}
+			c.load(value.cassetteEntry)
+			for _, exchange := range value.SPExchanges {
+				c.load(cassetteEntry{SP: exchange})
+			}
+			for _, exchange := range value.ChainExchanges {
+				c.load(cassetteEntry{Chain: exchange})
+			}
+			for _, exchange := range value.TendermintExchanges {
+				c.load(cassetteEntry{Tendermint: exchange})
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown cassette mode %d", opt.Mode)
+	}
+}
+
+// RoundTrip records the SP exchange or replays the recorded one.
+func (c *cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	bodySum := sha256.Sum256(body)
+	exchange := &spExchange{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		Header:     c.matchedHeader(req.Header),
+		BodySHA256: hex.EncodeToString(bodySum[:]),
+	}
+	key := spExchangeKey(exchange.Method, exchange.URL, exchange.Header, exchange.BodySHA256)
+
+	if c.mode == CassetteReplay {
+		c.mtx.Lock()
+		recorded := c.spIndex[key]
+		var replay *spExchange
+		if len(recorded) > 0 {
+			replay = recorded[c.next(key, len(recorded))]
+		}
+		c.mtx.Unlock()
+		if replay == nil {
+			return nil, fmt.Errorf("no recorded SP exchange matches %s %s", req.Method, exchange.URL)
+		}
+		if replay.Error != "" {
+			return nil, errors.New(replay.Error)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", replay.StatusCode, http.StatusText(replay.StatusCode)),
+			StatusCode:    replay.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        replay.ResponseHeader.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(replay.ResponseBody)),
+			ContentLength: int64(len(replay.ResponseBody)),
+			Request:       req,
+		}, nil
+	}
+
+	sent := req.Clone(req.Context())
+	sent.Body, sent.ContentLength = http.NoBody, 0
+	if len(body) > 0 {
+		sent.Body, sent.ContentLength = io.NopCloser(bytes.NewReader(body)), int64(len(body))
+	}
+	resp, err := c.transport.RoundTrip(sent)
+	if err != nil {
+		exchange.Error = err.Error()
+		if recordErr := c.record(cassetteEntry{SP: exchange}); recordErr != nil {
+			return nil, recordErr
+		}
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	exchange.StatusCode, exchange.ResponseHeader, exchange.ResponseBody = resp.StatusCode, resp.Header.Clone(), respBody
+	if err = c.record(cassetteEntry{SP: exchange}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// interceptor records the chain queries and broadcasts or replays the recorded ones, it is the innermost interceptor.
+func (c *cassette) interceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+) error {
+	reqMsg, ok := req.(proto.Message)
+	if !ok {
+		return fmt.Errorf("the request of %s is not a protobuf message", method)
+	}
+	replyMsg, ok := reply.(proto.Message)
+	if !ok {
+		return fmt.Errorf("the reply of %s is not a protobuf message", method)
+	}
+	reqBytes, err := proto.Marshal(reqMsg)
+	if err != nil {
+		return err
+	}
+	key := chainExchangeKey(method, reqBytes)
+
+	if c.mode == CassetteReplay {
+		c.mtx.Lock()
+		recorded := c.chainIndex[key]
+		var replay *chainExchange
+		if len(recorded) > 0 {
+			replay = recorded[c.next(key, len(recorded))]
+		}
+		c.mtx.Unlock()
+		if replay == nil {
+			return fmt.Errorf("no recorded chain exchange matches %s", method)
+		}
+		if replay.Error != "" {
+			return status.Error(replay.ErrorCode, replay.Error)
+		}
+		return proto.Unmarshal(replay.Reply, replyMsg)
+	}
+
+	exchange := &chainExchange{Method: method, Request: reqBytes}
+	invokeErr := invoker(ctx, method, req, reply, cc, opts...)
+	if invokeErr != nil {
+		st, _ := status.FromError(invokeErr)
+		exchange.ErrorCode, exchange.Error = st.Code(), st.Message()
+	} else if exchange.Reply, err = proto.Marshal(replyMsg); err != nil {
+		return err
+	}
+
+	if err = c.record(cassetteEntry{Chain: exchange}); err != nil {
+		return err
+	}
+	return invokeErr
+}
+
+// tendermintDialer returns the dialer of the Tendermint RPC client of the chain client, the client records the calls
+// sent by the given dialer, or by the default client of Tendermint if it is nil, or replays the recorded ones.
+func (c *cassette) tendermintDialer(dialer func(string) (*http.Client, error)) func(string) (*http.Client, error) {
+	return func(remote string) (*http.Client, error) {
+		if dialer == nil {
+			dialer = jsonrpcclient.DefaultHTTPClient
+		}
+		client, err := dialer(remote)
+		if err != nil {
+			return nil, err
+		}
+		next := client.Transport
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		return &http.Client{Transport: tendermintTransport{cassette: c, next: next}}, nil
+	}
+}
+
+// tendermintTransport records the Tendermint JSON-RPC calls sent by next or replays the recorded ones.
+type tendermintTransport struct {
+	cassette *cassette
+	next     http.RoundTripper
+}
+
+// RoundTrip records the Tendermint JSON-RPC call or replays the recorded one, the batched calls are not supported.
+func (t tendermintTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c := t.cassette
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	var rpcReq jsonRPCRequest
+	if err := json.Unmarshal(body, &rpcReq); err != nil {
+		return nil, fmt.Errorf("the cassette only supports the single Tendermint JSON-RPC calls: %v", err)
+	}
+	exchange := &tendermintExchange{Method: rpcReq.Method}
+	if len(rpcReq.Params) > 0 {
+		var params bytes.Buffer
+		if err := json.Compact(&params, rpcReq.Params); err != nil {
+			return nil, err
+		}
+		exchange.Params = params.Bytes()
+	}
+	key := tendermintExchangeKey(exchange.Method, exchange.Params)
+
+	if c.mode == CassetteReplay {
+		c.mtx.Lock()
+		recorded := c.tendermintIndex[key]
+		var replay *tendermintExchange
+		if len(recorded) > 0 {
+			replay = recorded[c.next(key, len(recorded))]
+		}
+		c.mtx.Unlock()
+		if replay == nil {
+			return nil, fmt.Errorf("no recorded Tendermint exchange matches %s", rpcReq.Method)
+		}
+		if replay.Error != "" {
+			return nil, errors.New(replay.Error)
+		}
+		// the Tendermint RPC client rejects the responses of which the id differs from the request
+		respBody := replaceJSONRPCID(replay.ResponseBody, rpcReq.ID)
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", replay.StatusCode, http.StatusText(replay.StatusCode)),
+			StatusCode:    replay.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": []string{"application/json"}},
+			Body:          io.NopCloser(bytes.NewReader(respBody)),
+			ContentLength: int64(len(respBody)),
+			Request:       req,
+		}, nil
+	}
+
+	sent := req.Clone(req.Context())
+	sent.Body, sent.ContentLength = io.NopCloser(bytes.NewReader(body)), int64(len(body))
+	resp, err := t.next.RoundTrip(sent)
+	if err == nil {
+		var respBody []byte
+		respBody, err = io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+		exchange.StatusCode, exchange.ResponseBody = resp.StatusCode, respBody
+	}
+	if err != nil {
+		exchange.Error = err.Error()
+	}
+
+	if recordErr := c.record(cassetteEntry{Tendermint: exchange}); recordErr != nil {
+		return nil, recordErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// next returns the index of the recorded exchange to replay for the key, the caller should hold the lock.
+func (c *cassette) next(key string, recorded int) int {
+	i := c.replayed[key]
+	if i >= recorded {
+		return recorded - 1
+	}
+	c.replayed[key] = i + 1
+	return i
+}
+
+// record appends the entry of an exchange to the cassette file as a line, so the exchanges recorded before a crash are
+// kept without rewriting the file.
+func (c *cassette) record(entry cassetteEntry) error {
+	data, err := json.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	f, err := os.OpenFile(c.path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(data, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// load indexes the recorded exchange of the entry for the replay.
+func (c *cassette) load(entry cassetteEntry) {
+	if exchange := entry.SP; exchange != nil {
+		key := spExchangeKey(exchange.Method, exchange.URL, c.matchedHeader(exchange.Header), exchange.BodySHA256)
+		c.spIndex[key] = append(c.spIndex[key], exchange)
+	}
+	if exchange := entry.Chain; exchange != nil {
+		key := chainExchangeKey(exchange.Method, exchange.Request)
+		c.chainIndex[key] = append(c.chainIndex[key], exchange)
+	}
+	if exchange := entry.Tendermint; exchange != nil {
+		key := tendermintExchangeKey(exchange.Method, exchange.Params)
+		c.tendermintIndex[key] = append(c.tendermintIndex[key], exchange)
+	}
+}
+
+// matchedHeader returns the request headers used by the matching.
+func (c *cassette) matchedHeader(header http.Header) http.Header {
+	matched := make(http.Header)
+	for key, values := range header {
+		if canonical := http.CanonicalHeaderKey(key); !c.ignoreHeaders[canonical] {
+			matched[canonical] = append([]string(nil), values...)
+		}
+	}
+	return matched
+}
+
+func spExchangeKey(method, url string, header http.Header, bodySHA256 string) string {
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	b.WriteString(method + " " + url + "\n")
+	for _, key := range keys {
+		b.WriteString(key + ": " + strings.Join(header[key], ",") + "\n")
+	}
+	b.WriteString(bodySHA256)
+	return b.String()
+}
+
+func chainExchangeKey(method string, request []byte) string {
+	return method + " " + hex.EncodeToString(request)
+}
+
+func tendermintExchangeKey(method string, params []byte) string {
+	return "tendermint " + method + " " + string(params)
+}
+
+// replaceJSONRPCID replaces the id of the JSON-RPC response, the response is returned as it is if it is not an object.
+func replaceJSONRPCID(response []byte, id json.RawMessage) []byte {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(response, &fields); err != nil || fields == nil {
+		return response
+	}
+	fields["id"] = id
+	replaced, err := json.Marshal(fields)
+	if err != nil {
+		return response
+	}
+	return replaced
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// recordCassette records an SP exchange and two chain exchanges, one of them failing, to the cassette. It returns the
+// URL of the recorded SP request.
+func recordCassette(t *testing.T, path string) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(append([]byte("echo "), body...))
+	}))
+	defer server.Close()
+	c, err := newCassette(&CassetteOption{Mode: CassetteRecord, Path: path}, nil)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPut, server.URL+"/bucket/object", strings.NewReader("payload"))
+	require.NoError(t, err)
+	req.Header.Set(types.HTTPHeaderDate, "volatile")
+	resp, err := c.RoundTrip(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, "echo payload", string(body))
+
+	invoker := func(_ context.Context, _ string, req, reply interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		if req.(*storageTypes.QueryHeadBucketRequest).BucketName == "missing" {
+			return status.Error(codes.NotFound, "no such bucket")
+		}
+		reply.(*storageTypes.QueryHeadBucketResponse).BucketInfo = &storageTypes.BucketInfo{BucketName: "bucket"}
+		return nil
+	}
+	err = c.interceptor(context.Background(), "/HeadBucket", &storageTypes.QueryHeadBucketRequest{BucketName: "bucket"},
+		&storageTypes.QueryHeadBucketResponse{}, nil, invoker)
+	require.NoError(t, err)
+	err = c.interceptor(context.Background(), "/HeadBucket", &storageTypes.QueryHeadBucketRequest{BucketName: "missing"},
+		&storageTypes.QueryHeadBucketResponse{}, nil, invoker)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	return req.URL.String()
+}
+
+// replayCassette replays the exchanges recorded by recordCassette.
+func replayCassette(t *testing.T, path, url string) {
+	c, err := newCassette(&CassetteOption{Mode: CassetteReplay, Path: path}, nil)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPut, url, strings.NewReader("payload"))
+	require.NoError(t, err)
+	req.Header.Set(types.HTTPHeaderDate, "changed")
+	resp, err := c.RoundTrip(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, "echo payload", string(body))
+	req, err = http.NewRequest(http.MethodPut, url, strings.NewReader("another payload"))
+	require.NoError(t, err)
+	_, err = c.RoundTrip(req)
+	require.Error(t, err)
+
+	invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+		return errors.New("the replayed calls are not sent")
+	}
+	reply := &storageTypes.QueryHeadBucketResponse{}
+	err = c.interceptor(context.Background(), "/HeadBucket", &storageTypes.QueryHeadBucketRequest{BucketName: "bucket"}, reply, nil, invoker)
+	require.NoError(t, err)
+	require.Equal(t, "bucket", reply.BucketInfo.BucketName)
+	err = c.interceptor(context.Background(), "/HeadBucket", &storageTypes.QueryHeadBucketRequest{BucketName: "missing"},
+		&storageTypes.QueryHeadBucketResponse{}, nil, invoker)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	err = c.interceptor(context.Background(), "/HeadBucket", &storageTypes.QueryHeadBucketRequest{BucketName: "other"},
+		&storageTypes.QueryHeadBucketResponse{}, nil, invoker)
+	require.Error(t, err)
+}
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte("stale"), 0o644))
+	url := recordCassette(t, path)
+
+	// every exchange is appended as a line, and the stale content is truncated
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 3)
+	for _, line := range lines {
+		var entry cassetteEntry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+	}
+	replayCassette(t, path, url)
+}
+
+func TestCassetteReplayDocument(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cassette.jsonl")
+	url := recordCassette(t, path)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// convert the lines to the document of the former format
+	var document cassetteFile
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	for decoder.More() {
+		var entry cassetteEntry
+		require.NoError(t, decoder.Decode(&entry))
+		if entry.SP != nil {
+			document.SPExchanges = append(document.SPExchanges, entry.SP)
+		}
+		if entry.Chain != nil {
+			document.ChainExchanges = append(document.ChainExchanges, entry.Chain)
+		}
+	}
+	data, err = json.MarshalIndent(&document, "", "  ")
+	require.NoError(t, err)
+	documentPath := filepath.Join(dir, "cassette.json")
+	require.NoError(t, os.WriteFile(documentPath, data, 0o644))
+	replayCassette(t, documentPath, url)
+}
@@ -0,0 +1,237 @@
+package clienttest
+
+import (
+	"context"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/types/s3util"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// CreateBucket - Create a new bucket owned by the signer.
+//
+// The primary SP address is ignored as the Fake has no storage provider. The payment address defaults to the owner.
+func (f *Fake) CreateBucket(ctx context.Context, bucketName string, primaryAddr string, opts types.CreateBucketOptions) (string, error) {
+	if err := f.call(ctx, "CreateBucket"); err != nil {
+		return "", err
+	}
+	if err := s3util.CheckValidBucketName(bucketName); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opts.Account)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := f.buckets[bucketName]; ok {
+		return "", storageTypes.ErrBucketAlreadyExists.Wrapf("bucket: %s", bucketName)
+	}
+	paymentAddr := signer.String()
+	if opts.PaymentAddress != "" {
+		addr, err := sdk.AccAddressFromHexUnsafe(opts.PaymentAddress)
+		if err != nil {
+			return "", err
+		}
+		paymentAddr = addr.String()
+	}
+	visibility := opts.Visibility
+	if visibility == storageTypes.VISIBILITY_TYPE_UNSPECIFIED {
+		visibility = storageTypes.VISIBILITY_TYPE_PRIVATE
+	}
+	f.buckets[bucketName] = &fakeBucket{
+		info: &storageTypes.BucketInfo{
+			Owner:            signer.String(),
+			BucketName:       bucketName,
+			Visibility:       visibility,
+			Id:               f.nextID(),
+			SourceType:       storageTypes.SOURCE_TYPE_ORIGIN,
+			CreateAt:         f.now().Unix(),
+			PaymentAddress:   paymentAddr,
+			ChargedReadQuota: opts.ChargedQuota,
+			BucketStatus:     storageTypes.BUCKET_STATUS_CREATED,
+			Tags:             opts.Tags,
+		},
+		objects: make(map[string]*fakeObject),
+	}
+	return f.commitTx(), nil
+}
+
+// DeleteBucket - Delete an empty bucket.
+func (f *Fake) DeleteBucket(ctx context.Context, bucketName string, opt types.DeleteBucketOption) (string, error) {
+	if err := f.call(ctx, "DeleteBucket"); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	bucket, err := f.getBucket(bucketName)
+	if err != nil {
+		return "", err
+	}
+	if f.verifyBucketPermission(bucket, signer, permTypes.ACTION_DELETE_BUCKET, nil) != permTypes.EFFECT_ALLOW {
+		return "", storageTypes.ErrAccessDenied.Wrapf("the operator %s has no DeleteBucket permission of the bucket %s", signer, bucketName)
+	}
+	if len(bucket.objects) > 0 {
+		return "", storageTypes.ErrBucketNotEmpty.Wrapf("bucket: %s", bucketName)
+	}
+	delete(f.buckets, bucketName)
+	f.deletePolicies(gnfdTypes.NewBucketGRN(bucketName))
+	return f.commitTx(), nil
+}
+
+// UpdateBucketVisibility - Update the visibility of the bucket.
+func (f *Fake) UpdateBucketVisibility(ctx context.Context, bucketName string, visibility storageTypes.VisibilityType, opt types.UpdateVisibilityOption) (string, error) {
+	if err := f.call(ctx, "UpdateBucketVisibility"); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	bucket, err := f.updatableBucket(bucketName, opt.Account)
+	if err != nil {
+		return "", err
+	}
+	bucket.info.Visibility = visibility
+	return f.commitTx(), nil
+}
+
+// UpdateBucketInfo - Update the visibility, the payment address and the charged read quota of the bucket.
+func (f *Fake) UpdateBucketInfo(ctx context.Context, bucketName string, opts types.UpdateBucketOptions) (string, error) {
+	if err := f.call(ctx, "UpdateBucketInfo"); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	bucket, err := f.updatableBucket(bucketName, opts.Account)
+	if err != nil {
+		return "", err
+	}
+	if opts.PaymentAddress != "" {
+		addr, err := sdk.AccAddressFromHexUnsafe(opts.PaymentAddress)
+		if err != nil {
+			return "", err
+		}
+		bucket.info.PaymentAddress = addr.String()
+	}
+	if opts.Visibility != storageTypes.VISIBILITY_TYPE_UNSPECIFIED {
+		bucket.info.Visibility = opts.Visibility
+	}
+	if opts.ChargedQuota != nil {
+		bucket.info.ChargedReadQuota = *opts.ChargedQuota
+	}
+	return f.commitTx(), nil
+}
+
+// UpdateBucketPaymentAddr - Update the payment address of the bucket.
+func (f *Fake) UpdateBucketPaymentAddr(ctx context.Context, bucketName string, paymentAddr sdk.AccAddress, opt types.UpdatePaymentOption) (string, error) {
+	if err := f.call(ctx, "UpdateBucketPaymentAddr"); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	bucket, err := f.updatableBucket(bucketName, opt.Account)
+	if err != nil {
+		return "", err
+	}
+	bucket.info.PaymentAddress = paymentAddr.String()
+	return f.commitTx(), nil
+}
+
+// updatableBucket returns the bucket if the signer is allowed to update it. The caller must hold the lock.
+func (f *Fake) updatableBucket(bucketName string, account *types.Account) (*fakeBucket, error) {
+	signer, err := f.signer(account)
+	if err != nil {
+		return nil, err
+	}
+	bucket, err := f.getBucket(bucketName)
+	if err != nil {
+		return nil, err
+	}
+	if f.verifyBucketPermission(bucket, signer, permTypes.ACTION_UPDATE_BUCKET_INFO, nil) != permTypes.EFFECT_ALLOW {
+		return nil, storageTypes.ErrAccessDenied.Wrapf("the operator %s has no UpdateBucketInfo permission of the bucket %s", signer, bucketName)
+	}
+	return bucket, nil
+}
+
+// HeadBucket - Query the bucket info.
+func (f *Fake) HeadBucket(ctx context.Context, bucketName string) (*storageTypes.BucketInfo, error) {
+	if err := f.call(ctx, "HeadBucket"); err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	bucket, err := f.getBucket(bucketName)
+	if err != nil {
+		return nil, err
+	}
+	info := *bucket.info
+	return &info, nil
+}
+
+// HeadBucketByID - Query the bucket info by the bucket id.
+func (f *Fake) HeadBucketByID(ctx context.Context, bucketID string) (*storageTypes.BucketInfo, error) {
+	if err := f.call(ctx, "HeadBucketByID"); err != nil {
+		return nil, err
+	}
+	id, err := sdkmath.ParseUint(bucketID)
+	if err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	for _, bucket := range f.buckets {
+		if bucket.info.Id.Equal(id) {
+			info := *bucket.info
+			return &info, nil
+		}
+	}
+	return nil, storageTypes.ErrNoSuchBucket.Wrapf("bucket id: %s", bucketID)
+}
+
+// ListBuckets - List the buckets owned by the account in the order of their ids.
+func (f *Fake) ListBuckets(ctx context.Context, opts types.ListBucketsOptions) (types.ListBucketsResult, error) {
+	if err := f.call(ctx, "ListBuckets"); err != nil {
+		return types.ListBucketsResult{}, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	owner, err := f.listAccount(opts.Account)
+	if err != nil {
+		return types.ListBucketsResult{}, err
+	}
+	buckets := make([]*types.BucketMetaWithVGF, 0)
+	for _, bucket := range f.buckets {
+		if bucket.info.Owner != owner {
+			continue
+		}
+		info := *bucket.info
+		buckets = append(buckets, &types.BucketMetaWithVGF{BucketInfo: &info})
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].BucketInfo.Id.LT(buckets[j].BucketInfo.Id) })
+	return types.ListBucketsResult{Buckets: buckets}, nil
+}
+
+// listAccount returns the account of the list options, or the default account. The caller must hold the lock.
+func (f *Fake) listAccount(account string) (string, error) {
+	if account == "" {
+		addr, err := f.signer(nil)
+		if err != nil {
+			return "", err
+		}
+		return addr.String(), nil
+	}
+	addr, err := sdk.AccAddressFromHexUnsafe(account)
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
+}
@@ -0,0 +1,22 @@
+/*
+Package clienttest provides a stateful in-memory fake of client.IClient for unit tests.
+
+The Fake keeps the buckets, objects, groups, policies and payment accounts in memory and follows the rules of the
+chain and the storage providers for them, e.g. an object can only be downloaded after it is sealed, and the
+permission of an account is evaluated from the visibility, the owner and the policies in the same way as the chain.
+The transactions take effect immediately and return a fake transaction hash.
+
+	fake := clienttest.New(account)
+	_, err := fake.CreateBucket(ctx, "bucket", "", types.CreateBucketOptions{})
+	_, err = fake.CreateObject(ctx, "bucket", "object", strings.NewReader("hello"), types.CreateObjectOptions{})
+	err = fake.PutObject(ctx, "bucket", "object", 5, strings.NewReader("hello"), types.PutObjectOptions{})
+
+The errors can be injected into the calls of a method to test the error handling:
+
+	fake.InjectError("GetObject", errors.New("connection reset"), 1)
+
+Only the APIs of the buckets, objects, groups, policies and payment accounts are implemented, calling the other APIs
+of client.IClient fails with the error "clienttest: <Method> not implemented", or panics with it if the API has no
+error to return.
+*/
+package clienttest
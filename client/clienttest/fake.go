@@ -0,0 +1,302 @@
+package clienttest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/client"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	paymentTypes "github.com/bnb-chain/greenfield/x/payment/types"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// FaultHook - The hook called before each call of the Fake, the call fails with the returned error if it is not nil.
+//
+// - method: The name of the called method of client.IClient, e.g. "CreateBucket".
+type FaultHook func(ctx context.Context, method string) error
+
+// fault is an error injected into the calls of a method.
+type fault struct {
+	err   error
+	times int // the number of the calls left to fail, a negative number means failing all the calls
+}
+
+type fakeBucket struct {
+	info    *storageTypes.BucketInfo
+	objects map[string]*fakeObject
+}
+
+type fakeObject struct {
+	info         *storageTypes.ObjectInfo
+	content      []byte // nil if the object has not been uploaded
+	createTxHash string
+	sealTxHash   string
+}
+
+type fakeGroup struct {
+	info    *storageTypes.GroupInfo
+	members map[string]*time.Time // the expiration time of the members keyed by their addresses, storageTypes.MaxTimeStamp means never
+}
+
+// Fake - The in-memory fake of client.IClient.
+//
+// The Fake is safe for concurrent use. The methods not implemented by the Fake return an error, or panic if they
+// have no error to return, with the message "clienttest: <Method> not implemented".
+type Fake struct {
+	// IClient is nil, it only provides the unexported methods of client.IClient, which are never called on the Fake.
+	client.IClient
+
+	mtx             sync.Mutex
+	defaultAccount  *types.Account
+	now             func() time.Time
+	autoSeal        bool
+	lastID          uint64
+	txCount         uint64
+	buckets         map[string]*fakeBucket
+	groups          map[string]*fakeGroup // keyed by the owner and the name of the group
+	groupsByID      map[uint64]*fakeGroup
+	policies        map[policyKey]*permTypes.Policy
+	paymentAccounts map[string]*paymentTypes.PaymentAccount
+	paymentCounts   map[string]uint64
+	faults          map[string]*fault
+	faultHook       FaultHook
+	calls           map[string]int
+}
+
+var _ client.IClient = (*Fake)(nil)
+
+// New - Create an empty Fake.
+//
+// - defaultAccount: The default account of the Fake, which signs the calls without an account in their options. It can be nil.
+//
+// - ret: The Fake sealing the objects once they are uploaded.
+func New(defaultAccount *types.Account) *Fake {
+	return &Fake{
+		defaultAccount:  defaultAccount,
+		now:             time.Now,
+		autoSeal:        true,
+		buckets:         make(map[string]*fakeBucket),
+		groups:          make(map[string]*fakeGroup),
+		groupsByID:      make(map[uint64]*fakeGroup),
+		policies:        make(map[policyKey]*permTypes.Policy),
+		paymentAccounts: make(map[string]*paymentTypes.PaymentAccount),
+		paymentCounts:   make(map[string]uint64),
+		faults:          make(map[string]*fault),
+		calls:           make(map[string]int),
+	}
+}
+
+// SetClock - Set the clock of the Fake, which is used as the block time to check the expiration of the policies and
+// the group members.
+//
+// - now: The function returning the current time.
+func (f *Fake) SetClock(now func() time.Time) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.now = now
+}
+
+// SetAutoSeal - Set whether the objects are sealed once they are uploaded. If it is disabled, the uploaded objects
+// stay in the created status until SealObject is called, e.g. to test waiting for the objects to be sealed.
+//
+// - autoSeal: Whether to seal the objects once they are uploaded, the default value is true.
+func (f *Fake) SetAutoSeal(autoSeal bool) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.autoSeal = autoSeal
+}
+
+// InjectError - Fail the calls of a method with the error.
+//
+// - method: The name of the method of client.IClient, e.g. "PutObject".
+//
+// - err: The error returned by the calls.
+//
+// - times: The number of the calls to fail, the calls after them succeed. A value <= 0 means failing all the calls
+// until ClearFaults is called.
+func (f *Fake) InjectError(method string, err error, times int) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if times <= 0 {
+		times = -1
+	}
+	f.faults[method] = &fault{err: err, times: times}
+}
+
+// SetFaultHook - Set the hook called before each call, e.g. to fail the calls randomly or to delay them.
+//
+// - hook: The hook deciding whether a call fails, nil removes the hook.
+func (f *Fake) SetFaultHook(hook FaultHook) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.faultHook = hook
+}
+
+// ClearFaults - Remove the injected errors and the fault hook.
+func (f *Fake) ClearFaults() {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.faults = make(map[string]*fault)
+	f.faultHook = nil
+}
+
+// Calls - Get the number of the calls of a method, including the failed ones.
+//
+// - method: The name of the method of client.IClient, e.g. "PutObject".
+//
+// - ret: The number of the calls.
+func (f *Fake) Calls(method string) int {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	return f.calls[method]
+}
+
+// call counts the call of the method and returns the injected error of it. The caller must not hold the lock, as the
+// fault hook may call the Fake.
+func (f *Fake) call(ctx context.Context, method string) error {
+	f.mtx.Lock()
+	f.calls[method]++
+	hook := f.faultHook
+	var err error
+	if flt, ok := f.faults[method]; ok {
+		err = flt.err
+		if flt.times > 0 {
+			flt.times--
+			if flt.times == 0 {
+				delete(f.faults, method)
+			}
+		}
+	}
+	f.mtx.Unlock()
+
+	if err != nil {
+		return err
+	}
+	if hook != nil {
+		if err = hook(ctx, method); err != nil {
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
+// SetDefaultAccount - Set the default account of the Fake.
+//
+// - account: The account signing the calls without an account in their options.
+func (f *Fake) SetDefaultAccount(account *types.Account) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.defaultAccount = account
+}
+
+// Close - Do nothing, the Fake has no background goroutines.
+func (f *Fake) Close() error {
+	return nil
+}
+
+// GetDefaultAccount - Get the default account of the Fake.
+//
+// - ret1: The default account of the Fake.
+//
+// - ret2: Return error when the default account is not set, otherwise return nil.
+func (f *Fake) GetDefaultAccount() (*types.Account, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if f.defaultAccount == nil {
+		return nil, types.ErrorDefaultAccountNotExist
+	}
+	return f.defaultAccount, nil
+}
+
+// MustGetDefaultAccount - Get the default account of the Fake, it panics if the default account is not set.
+//
+// - ret: The default account of the Fake.
+func (f *Fake) MustGetDefaultAccount() *types.Account {
+	account, err := f.GetDefaultAccount()
+	if err != nil {
+		panic(err)
+	}
+	return account
+}
+
+// signer returns the address of the account in the options, or the address of the default account. The caller must
+// hold the lock.
+func (f *Fake) signer(account *types.Account) (sdk.AccAddress, error) {
+	if account != nil {
+		return account.GetAddress(), nil
+	}
+	if f.defaultAccount == nil {
+		return nil, types.ErrorDefaultAccountNotExist
+	}
+	return f.defaultAccount.GetAddress(), nil
+}
+
+// nextID returns the id of a new resource. The caller must hold the lock.
+func (f *Fake) nextID() sdkmath.Uint {
+	f.lastID++
+	return sdkmath.NewUint(f.lastID)
+}
+
+// commitTx returns the hash of a new transaction. The caller must hold the lock.
+func (f *Fake) commitTx() string {
+	f.txCount++
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, f.txCount)
+	hash := sha256.Sum256(b)
+	return hex.EncodeToString(hash[:])
+}
+
+func groupKey(owner, groupName string) string {
+	return fmt.Sprintf("%s/%s", owner, groupName)
+}
+
+// getBucket returns the bucket or ErrNoSuchBucket. The caller must hold the lock.
+func (f *Fake) getBucket(bucketName string) (*fakeBucket, error) {
+	bucket, ok := f.buckets[bucketName]
+	if !ok {
+		return nil, storageTypes.ErrNoSuchBucket.Wrapf("bucket: %s", bucketName)
+	}
+	return bucket, nil
+}
+
+// getObject returns the bucket and the object, or ErrNoSuchBucket and ErrNoSuchObject. The caller must hold the lock.
+func (f *Fake) getObject(bucketName, objectName string) (*fakeBucket, *fakeObject, error) {
+	bucket, err := f.getBucket(bucketName)
+	if err != nil {
+		return nil, nil, err
+	}
+	object, ok := bucket.objects[objectName]
+	if !ok {
+		return nil, nil, storageTypes.ErrNoSuchObject.Wrapf("bucket: %s, object: %s", bucketName, objectName)
+	}
+	return bucket, object, nil
+}
+
+// getGroup returns the group or ErrNoSuchGroup. The caller must hold the lock.
+func (f *Fake) getGroup(owner, groupName string) (*fakeGroup, error) {
+	group, ok := f.groups[groupKey(owner, groupName)]
+	if !ok {
+		return nil, storageTypes.ErrNoSuchGroup.Wrapf("owner: %s, group: %s", owner, groupName)
+	}
+	return group, nil
+}
+
+// pageLimit returns the number of the records of a page, the default value is 50 and the maximum value is 1000.
+func pageLimit(limit int64) int {
+	if limit <= 0 {
+		return 50
+	}
+	if limit > 1000 {
+		return 1000
+	}
+	return int(limit)
+}
@@ -0,0 +1,369 @@
+package clienttest_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield-go-sdk/client/clienttest"
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/utils"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+const testBucketName = "test-bucket"
+
+func newAccount(t *testing.T, name string) *types.Account {
+	account, _, err := types.NewAccount(name)
+	require.NoError(t, err)
+	return account
+}
+
+// newFake creates a Fake with a private bucket of the owner holding the objects.
+func newFake(t *testing.T, owner *types.Account, objectNames ...string) *clienttest.Fake {
+	ctx := context.Background()
+	fake := clienttest.New(owner)
+	_, err := fake.CreateBucket(ctx, testBucketName, "", types.CreateBucketOptions{Visibility: storageTypes.VISIBILITY_TYPE_PRIVATE})
+	require.NoError(t, err)
+	for _, objectName := range objectNames {
+		_, err = fake.CreateObject(ctx, testBucketName, objectName, strings.NewReader(objectName), types.CreateObjectOptions{})
+		require.NoError(t, err)
+	}
+	return fake
+}
+
+func TestListObjectsPagination(t *testing.T) {
+	ctx := context.Background()
+	fake := newFake(t, newAccount(t, "owner"), "a", "b/1", "b/2", "c", "d/x/1", "e")
+
+	tests := []struct {
+		name  string
+		opts  types.ListObjectsOptions
+		pages [][]string // the object names and the common prefixes of every page
+	}{
+		{"all objects", types.ListObjectsOptions{}, [][]string{{"a", "b/1", "b/2", "c", "d/x/1", "e"}}},
+		{"pages of objects", types.ListObjectsOptions{MaxKeys: 4}, [][]string{{"a", "b/1", "b/2", "c"}, {"d/x/1", "e"}}},
+		{"pages of objects and common prefixes", types.ListObjectsOptions{Delimiter: "/", MaxKeys: 2}, [][]string{{"a", "b/"}, {"c", "d/"}, {"e"}}},
+		{"common prefix ending a page", types.ListObjectsOptions{Delimiter: "/", MaxKeys: 1}, [][]string{{"a"}, {"b/"}, {"c"}, {"d/"}, {"e"}}},
+		{"prefix", types.ListObjectsOptions{Prefix: "b/", MaxKeys: 1}, [][]string{{"b/1"}, {"b/2"}}},
+		{"prefix and delimiter", types.ListObjectsOptions{Prefix: "d/", Delimiter: "/"}, [][]string{{"d/x/"}}},
+		{"start after", types.ListObjectsOptions{StartAfter: "b/2"}, [][]string{{"c", "d/x/1", "e"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			var pages [][]string
+			for {
+				result, err := fake.ListObjects(ctx, testBucketName, opts)
+				require.NoError(t, err)
+				page := make([]string, 0)
+				for _, object := range result.Objects {
+					page = append(page, object.ObjectInfo.ObjectName)
+				}
+				page = append(page, result.CommonPrefixes...)
+				pages = append(pages, page)
+				if !result.IsTruncated {
+					break
+				}
+				require.Less(t, len(pages), 10, "the pagination does not end")
+				opts.ContinuationToken = result.NextContinuationToken
+			}
+			for _, page := range pages {
+				// the objects and the common prefixes of a page are listed in the lexicographical order together
+				sort.Strings(page)
+			}
+			require.Equal(t, tt.pages, pages)
+		})
+	}
+}
+
+func TestListGroupMembersPagination(t *testing.T) {
+	ctx := context.Background()
+	owner := newAccount(t, "owner")
+	fake := clienttest.New(owner)
+	_, err := fake.CreateGroup(ctx, "readers", types.CreateGroupOptions{})
+	require.NoError(t, err)
+	group, err := fake.HeadGroup(ctx, "readers", owner.GetAddress().String())
+	require.NoError(t, err)
+
+	members := make([]string, 0, 5)
+	for i := 0; i < 5; i++ {
+		members = append(members, newAccount(t, "member").GetAddress().String())
+	}
+	// the members added without an expiration time never expire
+	_, err = fake.UpdateGroupMember(ctx, "readers", owner.GetAddress().String(), members, nil, types.UpdateGroupMemberOption{})
+	require.NoError(t, err)
+
+	var listed []string
+	opts := types.GroupMembersPaginationOptions{Limit: 2}
+	for {
+		result, err := fake.ListGroupMembers(ctx, int64(group.Id.Uint64()), opts)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(result.Groups), 2)
+		if len(result.Groups) == 0 {
+			break
+		}
+		for _, member := range result.Groups {
+			require.Equal(t, "253402300799", member.ExpirationTime)
+			listed = append(listed, member.AccountID)
+		}
+		opts.StartAfter = listed[len(listed)-1]
+	}
+	sort.Strings(members)
+	require.Equal(t, members, listed)
+
+	groups, err := fake.ListGroupsByAccount(ctx, types.GroupsPaginationOptions{Account: members[0]})
+	require.NoError(t, err)
+	require.Len(t, groups.Groups, 1)
+	require.Equal(t, "readers", groups.Groups[0].Group.GroupName)
+}
+
+// permissionCase is a permission scenario, the setup runs with the owner as the default account of the Fake, and the
+// permission of the user is checked unless asOwner is set.
+type permissionCase struct {
+	name    string
+	asOwner bool
+	setup   func(t *testing.T, fake *clienttest.Fake, user *types.Account)
+	object  string
+	action  permTypes.ActionType
+	effect  permTypes.Effect
+}
+
+func putBucketPolicy(t *testing.T, fake *clienttest.Fake, user *types.Account, effect permTypes.Effect, expiration *time.Time, resources ...string) {
+	principal, err := utils.NewPrincipalWithAccount(user.GetAddress())
+	require.NoError(t, err)
+	_, err = fake.PutBucketPolicy(context.Background(), testBucketName, principal, []*permTypes.Statement{{
+		Effect:    effect,
+		Actions:   []permTypes.ActionType{permTypes.ACTION_GET_OBJECT},
+		Resources: resources,
+	}}, types.PutPolicyOption{PolicyExpireTime: expiration})
+	require.NoError(t, err)
+}
+
+func TestPermissionMatchesEvaluatePermission(t *testing.T) {
+	ctx := context.Background()
+	past := time.Now().Add(-time.Hour)
+	tests := []permissionCase{
+		{
+			name: "owner", asOwner: true,
+			object: "docs/a.txt", action: permTypes.ACTION_GET_OBJECT, effect: permTypes.EFFECT_ALLOW,
+		},
+		{
+			name:   "no policy",
+			object: "docs/a.txt", action: permTypes.ACTION_GET_OBJECT, effect: permTypes.EFFECT_DENY,
+		},
+		{
+			name: "public-read object",
+			setup: func(t *testing.T, fake *clienttest.Fake, _ *types.Account) {
+				_, err := fake.UpdateObjectVisibility(ctx, testBucketName, "docs/a.txt", storageTypes.VISIBILITY_TYPE_PUBLIC_READ, types.UpdateObjectOption{})
+				require.NoError(t, err)
+			},
+			object: "docs/a.txt", action: permTypes.ACTION_GET_OBJECT, effect: permTypes.EFFECT_ALLOW,
+		},
+		{
+			name: "bucket policy matching the object",
+			setup: func(t *testing.T, fake *clienttest.Fake, user *types.Account) {
+				putBucketPolicy(t, fake, user, permTypes.EFFECT_ALLOW, nil, types.NewObjectResourcePattern(testBucketName, "docs/"))
+			},
+			object: "docs/a.txt", action: permTypes.ACTION_GET_OBJECT, effect: permTypes.EFFECT_ALLOW,
+		},
+		{
+			name: "bucket policy not matching the object",
+			setup: func(t *testing.T, fake *clienttest.Fake, user *types.Account) {
+				putBucketPolicy(t, fake, user, permTypes.EFFECT_ALLOW, nil, types.NewObjectResourcePattern(testBucketName, "docs/"))
+			},
+			object: "private/b.txt", action: permTypes.ACTION_GET_OBJECT, effect: permTypes.EFFECT_DENY,
+		},
+		{
+			name: "bucket policy without resources",
+			setup: func(t *testing.T, fake *clienttest.Fake, user *types.Account) {
+				putBucketPolicy(t, fake, user, permTypes.EFFECT_ALLOW, nil)
+			},
+			object: "docs/a.txt", action: permTypes.ACTION_GET_OBJECT, effect: permTypes.EFFECT_DENY,
+		},
+		{
+			name: "expired bucket policy",
+			setup: func(t *testing.T, fake *clienttest.Fake, user *types.Account) {
+				putBucketPolicy(t, fake, user, permTypes.EFFECT_ALLOW, &past, types.NewObjectResourcePattern(testBucketName, ""))
+			},
+			object: "docs/a.txt", action: permTypes.ACTION_GET_OBJECT, effect: permTypes.EFFECT_DENY,
+		},
+		{
+			name: "object policy denying over the bucket policy",
+			setup: func(t *testing.T, fake *clienttest.Fake, user *types.Account) {
+				putBucketPolicy(t, fake, user, permTypes.EFFECT_ALLOW, nil, types.NewObjectResourcePattern(testBucketName, ""))
+				principal, err := utils.NewPrincipalWithAccount(user.GetAddress())
+				require.NoError(t, err)
+				_, err = fake.PutObjectPolicy(ctx, testBucketName, "docs/a.txt", principal, []*permTypes.Statement{{
+					Effect:  permTypes.EFFECT_DENY,
+					Actions: []permTypes.ActionType{permTypes.ACTION_GET_OBJECT},
+				}}, types.PutPolicyOption{})
+				require.NoError(t, err)
+			},
+			object: "docs/a.txt", action: permTypes.ACTION_GET_OBJECT, effect: permTypes.EFFECT_DENY,
+		},
+		{
+			name: "bucket policy of the group",
+			setup: func(t *testing.T, fake *clienttest.Fake, user *types.Account) {
+				groupPolicy(t, fake, user, nil)
+			},
+			object: "docs/a.txt", action: permTypes.ACTION_GET_OBJECT, effect: permTypes.EFFECT_ALLOW,
+		},
+		{
+			name: "bucket policy of the group with an expired membership",
+			setup: func(t *testing.T, fake *clienttest.Fake, user *types.Account) {
+				groupPolicy(t, fake, user, &past)
+			},
+			object: "docs/a.txt", action: permTypes.ACTION_GET_OBJECT, effect: permTypes.EFFECT_DENY,
+		},
+		{
+			name: "bucket policy of the group for another action",
+			setup: func(t *testing.T, fake *clienttest.Fake, user *types.Account) {
+				groupPolicy(t, fake, user, nil)
+			},
+			object: "docs/a.txt", action: permTypes.ACTION_DELETE_OBJECT, effect: permTypes.EFFECT_DENY,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, user := newAccount(t, "owner"), newAccount(t, "user")
+			fake := newFake(t, owner, "docs/a.txt", "private/b.txt")
+			if tt.setup != nil {
+				tt.setup(t, fake, user)
+			}
+			operator := user
+			if tt.asOwner {
+				operator = owner
+			}
+
+			effect, err := fake.IsObjectPermissionAllowed(ctx, operator.GetAddress().String(), testBucketName, tt.object, tt.action)
+			require.NoError(t, err)
+			require.Equal(t, tt.effect, effect)
+
+			decision, err := types.EvaluatePermission(snapshot(t, fake, operator, tt.object), tt.action, types.EvaluatePermissionOptions{})
+			require.NoError(t, err)
+			require.Equal(t, decision.Effect, effect, "the Fake disagrees with EvaluatePermission:\n%s", decision)
+		})
+	}
+}
+
+// groupPolicy adds the user into a group with the expiration, and allows the group to get the objects of the bucket.
+func groupPolicy(t *testing.T, fake *clienttest.Fake, user *types.Account, expiration *time.Time) {
+	ctx := context.Background()
+	owner, err := fake.GetDefaultAccount()
+	require.NoError(t, err)
+	_, err = fake.CreateGroup(ctx, "readers", types.CreateGroupOptions{})
+	require.NoError(t, err)
+	_, err = fake.UpdateGroupMember(ctx, "readers", owner.GetAddress().String(), []string{user.GetAddress().String()}, nil,
+		types.UpdateGroupMemberOption{ExpirationTime: []*time.Time{expiration}})
+	require.NoError(t, err)
+	group, err := fake.HeadGroup(ctx, "readers", owner.GetAddress().String())
+	require.NoError(t, err)
+	principal, err := utils.NewPrincipalWithGroupId(group.Id.Uint64())
+	require.NoError(t, err)
+	_, err = fake.PutBucketPolicy(ctx, testBucketName, principal, []*permTypes.Statement{{
+		Effect:    permTypes.EFFECT_ALLOW,
+		Actions:   []permTypes.ActionType{permTypes.ACTION_GET_OBJECT},
+		Resources: []string{types.NewObjectResourcePattern(testBucketName, "")},
+	}}, types.PutPolicyOption{})
+	require.NoError(t, err)
+}
+
+// snapshot collects the permission snapshot of the operator on the object through the query APIs of the Fake.
+func snapshot(t *testing.T, fake *clienttest.Fake, operator *types.Account, objectName string) *types.PermissionSnapshot {
+	ctx := context.Background()
+	addr := operator.GetAddress().String()
+	bucket, err := fake.HeadBucket(ctx, testBucketName)
+	require.NoError(t, err)
+	object, err := fake.HeadObject(ctx, testBucketName, objectName)
+	require.NoError(t, err)
+	s := &types.PermissionSnapshot{Operator: addr, Bucket: bucket, Object: object.ObjectInfo}
+	if policy, err := fake.GetBucketPolicy(ctx, testBucketName, addr); err == nil {
+		s.BucketPolicy = policy
+	}
+	if policy, err := fake.GetObjectPolicy(ctx, testBucketName, objectName, addr); err == nil {
+		s.ObjectPolicy = policy
+	}
+
+	groups, err := fake.ListGroupsByAccount(ctx, types.GroupsPaginationOptions{Account: addr})
+	require.NoError(t, err)
+	for _, group := range groups.Groups {
+		member, err := fake.GetGroupMember(ctx, group.Group.GroupName, group.Group.Owner, addr)
+		require.NoError(t, err)
+		groupSnapshot := types.GroupPermissionSnapshot{
+			GroupID:          group.Group.Id.Uint64(),
+			GroupName:        group.Group.GroupName,
+			MemberExpiration: member.ExpirationTime,
+		}
+		if policy, err := fake.GetBucketPolicyOfGroup(ctx, testBucketName, groupSnapshot.GroupID); err == nil {
+			groupSnapshot.BucketPolicy = policy
+		}
+		if policy, err := fake.GetObjectPolicyOfGroup(ctx, testBucketName, objectName, groupSnapshot.GroupID); err == nil {
+			groupSnapshot.ObjectPolicy = policy
+		}
+		s.Groups = append(s.Groups, groupSnapshot)
+	}
+	return s
+}
+
+func TestFaultInjection(t *testing.T) {
+	ctx := context.Background()
+	fake := newFake(t, newAccount(t, "owner"))
+	injected := errors.New("connection reset")
+
+	// the calls fail the given times, then succeed
+	fake.InjectError("HeadBucket", injected, 2)
+	for i := 0; i < 2; i++ {
+		_, err := fake.HeadBucket(ctx, testBucketName)
+		require.ErrorIs(t, err, injected)
+	}
+	_, err := fake.HeadBucket(ctx, testBucketName)
+	require.NoError(t, err)
+	require.Equal(t, 3, fake.Calls("HeadBucket"))
+
+	// the calls fail until the faults are cleared, the other methods are not affected
+	fake.InjectError("ListObjects", injected, 0)
+	for i := 0; i < 3; i++ {
+		_, err = fake.ListObjects(ctx, testBucketName, types.ListObjectsOptions{})
+		require.ErrorIs(t, err, injected)
+	}
+	_, err = fake.HeadBucket(ctx, testBucketName)
+	require.NoError(t, err)
+	fake.ClearFaults()
+	_, err = fake.ListObjects(ctx, testBucketName, types.ListObjectsOptions{})
+	require.NoError(t, err)
+
+	// the hook decides the failure of every call, the failed calls do not change the state
+	fake.SetFaultHook(func(ctx context.Context, method string) error {
+		if method == "CreateObject" {
+			return injected
+		}
+		return nil
+	})
+	_, err = fake.CreateObject(ctx, testBucketName, "object", strings.NewReader("content"), types.CreateObjectOptions{})
+	require.ErrorIs(t, err, injected)
+	_, err = fake.HeadObject(ctx, testBucketName, "object")
+	require.ErrorIs(t, err, storageTypes.ErrNoSuchObject)
+	fake.SetFaultHook(nil)
+
+	// the canceled context fails the calls
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = fake.HeadBucket(canceled, testBucketName)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestNotImplemented(t *testing.T) {
+	fake := clienttest.New(nil)
+	_, err := fake.GetStatus(context.Background())
+	require.EqualError(t, err, "clienttest: GetStatus not implemented")
+	require.PanicsWithError(t, "clienttest: GetStorageProvidersHealth not implemented", func() {
+		fake.GetStorageProvidersHealth()
+	})
+}
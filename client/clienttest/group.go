@@ -0,0 +1,407 @@
+package clienttest
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/types/s3util"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// CreateGroup - Create a new group owned by the signer.
+func (f *Fake) CreateGroup(ctx context.Context, groupName string, opt types.CreateGroupOptions) (string, error) {
+	if err := f.call(ctx, "CreateGroup"); err != nil {
+		return "", err
+	}
+	if err := s3util.CheckValidGroupName(groupName); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	key := groupKey(signer.String(), groupName)
+	if _, ok := f.groups[key]; ok {
+		return "", storageTypes.ErrGroupAlreadyExists.Wrapf("owner: %s, group: %s", signer, groupName)
+	}
+	group := &fakeGroup{
+		info: &storageTypes.GroupInfo{
+			Owner:      signer.String(),
+			GroupName:  groupName,
+			SourceType: storageTypes.SOURCE_TYPE_ORIGIN,
+			Id:         f.nextID(),
+			Extra:      opt.Extra,
+			Tags:       opt.Tags,
+		},
+		members: make(map[string]*time.Time),
+	}
+	f.groups[key] = group
+	f.groupsByID[group.info.Id.Uint64()] = group
+	return f.commitTx(), nil
+}
+
+// DeleteGroup - Delete the group owned by the signer, the policies of the group are also deleted.
+func (f *Fake) DeleteGroup(ctx context.Context, groupName string, opt types.DeleteGroupOption) (string, error) {
+	if err := f.call(ctx, "DeleteGroup"); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	group, err := f.getGroup(signer.String(), groupName)
+	if err != nil {
+		return "", err
+	}
+	if f.verifyGroupPermission(group, signer, permTypes.ACTION_DELETE_GROUP) != permTypes.EFFECT_ALLOW {
+		return "", storageTypes.ErrAccessDenied.Wrapf("the operator %s has no DeleteGroup permission of the group %s", signer, groupName)
+	}
+	delete(f.groups, groupKey(group.info.Owner, groupName))
+	delete(f.groupsByID, group.info.Id.Uint64())
+	f.deletePolicies(gnfdTypes.NewGroupGRN(signer, groupName))
+	for key := range f.policies {
+		if key.principalType == permTypes.PRINCIPAL_TYPE_GNFD_GROUP && key.principal == group.info.Id.String() {
+			delete(f.policies, key)
+		}
+	}
+	return f.commitTx(), nil
+}
+
+// UpdateGroupMember - Add members into the group and remove members from the group, the signer needs to be the owner
+// of the group or be allowed to update the members by the group policy.
+//
+// The members are added with the expiration time in opts.ExpirationTime, or never expire if it is not set.
+func (f *Fake) UpdateGroupMember(ctx context.Context, groupName string, groupOwnerAddr string,
+	addAddresses, removeAddresses []string, opts types.UpdateGroupMemberOption,
+) (string, error) {
+	if err := f.call(ctx, "UpdateGroupMember"); err != nil {
+		return "", err
+	}
+	if len(addAddresses) == 0 && len(removeAddresses) == 0 {
+		return "", errors.New("no update member")
+	}
+	if opts.ExpirationTime != nil && len(addAddresses) != len(opts.ExpirationTime) {
+		return "", errors.New("please provide expirationTime for every new add member")
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	group, err := f.updatableGroup(groupName, groupOwnerAddr, opts.Account)
+	if err != nil {
+		return "", err
+	}
+	addMembers, err := groupMembers(addAddresses, opts.ExpirationTime)
+	if err != nil {
+		return "", err
+	}
+	removeMembers, err := groupMembers(removeAddresses, nil)
+	if err != nil {
+		return "", err
+	}
+	for member, expiration := range addMembers {
+		group.members[member] = expiration
+	}
+	for member := range removeMembers {
+		if _, ok := group.members[member]; !ok {
+			return "", storageTypes.ErrNoSuchGroupMember.Wrapf("member: %s", member)
+		}
+		delete(group.members, member)
+	}
+	return f.commitTx(), nil
+}
+
+// RenewGroupMember - Renew the expiration time of the members of the group, the members which are not in the group
+// are added.
+func (f *Fake) RenewGroupMember(ctx context.Context, groupOwnerAddr, groupName string, memberAddresses []string, opts types.RenewGroupMemberOption) (string, error) {
+	if err := f.call(ctx, "RenewGroupMember"); err != nil {
+		return "", err
+	}
+	if len(memberAddresses) == 0 {
+		return "", errors.New("no renew member")
+	}
+	if opts.ExpirationTime != nil && len(memberAddresses) != len(opts.ExpirationTime) {
+		return "", errors.New("please provide expirationTime for every renew member")
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	group, err := f.updatableGroup(groupName, groupOwnerAddr, opts.Account)
+	if err != nil {
+		return "", err
+	}
+	members, err := groupMembers(memberAddresses, opts.ExpirationTime)
+	if err != nil {
+		return "", err
+	}
+	for member, expiration := range members {
+		group.members[member] = expiration
+	}
+	return f.commitTx(), nil
+}
+
+// updatableGroup returns the group if the signer is allowed to update its members. The caller must hold the lock.
+func (f *Fake) updatableGroup(groupName, groupOwnerAddr string, account *types.Account) (*fakeGroup, error) {
+	signer, err := f.signer(account)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := sdk.AccAddressFromHexUnsafe(groupOwnerAddr)
+	if err != nil {
+		return nil, err
+	}
+	group, err := f.getGroup(owner.String(), groupName)
+	if err != nil {
+		return nil, err
+	}
+	if f.verifyGroupPermission(group, signer, permTypes.ACTION_UPDATE_GROUP_MEMBER) != permTypes.EFFECT_ALLOW {
+		return nil, storageTypes.ErrAccessDenied.Wrapf("the operator %s has no UpdateGroupMember permission of the group %s", signer, groupName)
+	}
+	return group, nil
+}
+
+// groupMembers returns the expiration time of the members keyed by their addresses, the members without the
+// expiration time never expire as the chain does.
+func groupMembers(addresses []string, expirationTime []*time.Time) (map[string]*time.Time, error) {
+	members := make(map[string]*time.Time, len(addresses))
+	for i, address := range addresses {
+		addr, err := sdk.AccAddressFromHexUnsafe(address)
+		if err != nil {
+			return nil, err
+		}
+		expiration := storageTypes.MaxTimeStamp
+		if expirationTime != nil && expirationTime[i] != nil {
+			expiration = *expirationTime[i]
+		}
+		members[addr.String()] = &expiration
+	}
+	return members, nil
+}
+
+// LeaveGroup - Leave the group as a member.
+func (f *Fake) LeaveGroup(ctx context.Context, groupName string, groupOwnerAddr string, opt types.LeaveGroupOption) (string, error) {
+	if err := f.call(ctx, "LeaveGroup"); err != nil {
+		return "", err
+	}
+	owner, err := sdk.AccAddressFromHexUnsafe(groupOwnerAddr)
+	if err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	group, err := f.getGroup(owner.String(), groupName)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := group.members[signer.String()]; !ok {
+		return "", storageTypes.ErrNoSuchGroupMember.Wrapf("member: %s", signer)
+	}
+	delete(group.members, signer.String())
+	return f.commitTx(), nil
+}
+
+// HeadGroup - Query the group info.
+func (f *Fake) HeadGroup(ctx context.Context, groupName string, groupOwnerAddr string) (*storageTypes.GroupInfo, error) {
+	if err := f.call(ctx, "HeadGroup"); err != nil {
+		return nil, err
+	}
+	owner, err := sdk.AccAddressFromHexUnsafe(groupOwnerAddr)
+	if err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	group, err := f.getGroup(owner.String(), groupName)
+	if err != nil {
+		return nil, err
+	}
+	info := *group.info
+	return &info, nil
+}
+
+// HeadGroupMember - Check whether the account is a member of the group, the expired members are still members until
+// they are removed as the chain does.
+func (f *Fake) HeadGroupMember(ctx context.Context, groupName string, groupOwnerAddr, headMemberAddr string) bool {
+	if err := f.call(ctx, "HeadGroupMember"); err != nil {
+		return false
+	}
+	owner, err := sdk.AccAddressFromHexUnsafe(groupOwnerAddr)
+	if err != nil {
+		return false
+	}
+	member, err := sdk.AccAddressFromHexUnsafe(headMemberAddr)
+	if err != nil {
+		return false
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	group, err := f.getGroup(owner.String(), groupName)
+	if err != nil {
+		return false
+	}
+	_, ok := group.members[member.String()]
+	return ok
+}
+
+// GetGroupMember - Return the member info of the account, the members added without an expiration time expire at
+// storageTypes.MaxTimeStamp as they do on chain.
+func (f *Fake) GetGroupMember(ctx context.Context, groupName string, groupOwnerAddr, memberAddr string) (*permTypes.GroupMember, error) {
+	if err := f.call(ctx, "GetGroupMember"); err != nil {
+		return nil, err
+	}
+	owner, err := sdk.AccAddressFromHexUnsafe(groupOwnerAddr)
+	if err != nil {
+		return nil, err
+	}
+	member, err := sdk.AccAddressFromHexUnsafe(memberAddr)
+	if err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	group, err := f.getGroup(owner.String(), groupName)
+	if err != nil {
+		return nil, err
+	}
+	expiration, ok := group.members[member.String()]
+	if !ok {
+		return nil, storageTypes.ErrNoSuchGroupMember.Wrapf("group: %s, member: %s", groupName, member)
+	}
+	expirationTime := storageTypes.MaxTimeStamp
+	if expiration != nil {
+		expirationTime = *expiration
+	}
+	return &permTypes.GroupMember{GroupId: group.info.Id, Member: member.String(), ExpirationTime: &expirationTime}, nil
+}
+
+// ListGroupMembers - List the members of the group in the order of their addresses.
+//
+// At most opts.Limit members after opts.StartAfter are returned, the default limit is 50 and the maximum limit is 1000.
+func (f *Fake) ListGroupMembers(ctx context.Context, groupID int64, opts types.GroupMembersPaginationOptions) (*types.GroupMembersResult, error) {
+	if err := f.call(ctx, "ListGroupMembers"); err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	group, ok := f.groupsByID[uint64(groupID)]
+	if !ok {
+		return nil, storageTypes.ErrNoSuchGroup.Wrapf("group id: %d", groupID)
+	}
+	members := make([]string, 0, len(group.members))
+	for member := range group.members {
+		if member > opts.StartAfter {
+			members = append(members, member)
+		}
+	}
+	sort.Strings(members)
+	if limit := pageLimit(opts.Limit); len(members) > limit {
+		members = members[:limit]
+	}
+
+	result := &types.GroupMembersResult{Groups: make([]*types.GroupMembers, 0, len(members))}
+	for _, member := range members {
+		info := *group.info
+		result.Groups = append(result.Groups, &types.GroupMembers{
+			Group:          &info,
+			Operator:       group.info.Owner,
+			AccountID:      member,
+			ExpirationTime: strconv.FormatInt(group.members[member].Unix(), 10),
+		})
+	}
+	return result, nil
+}
+
+// ListGroupsByAccount - List the groups which the account is a member of in the order of their ids.
+//
+// At most opts.Limit groups after the group id opts.StartAfter are returned, the default limit is 50 and the maximum
+// limit is 1000.
+func (f *Fake) ListGroupsByAccount(ctx context.Context, opts types.GroupsPaginationOptions) (*types.GroupsResult, error) {
+	if err := f.call(ctx, "ListGroupsByAccount"); err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	account, err := f.listAccount(opts.Account)
+	if err != nil {
+		return nil, err
+	}
+	return f.listGroups(opts.StartAfter, opts.Limit, func(group *fakeGroup) (string, bool) {
+		expiration, ok := group.members[account]
+		if !ok {
+			return "", false
+		}
+		return strconv.FormatInt(expiration.Unix(), 10), true
+	})
+}
+
+// ListGroupsByOwner - List the groups owned by the account in the order of their ids.
+//
+// At most opts.Limit groups after the group id opts.StartAfter are returned, the default limit is 50 and the maximum
+// limit is 1000.
+func (f *Fake) ListGroupsByOwner(ctx context.Context, opts types.GroupsOwnerPaginationOptions) (*types.GroupsResult, error) {
+	if err := f.call(ctx, "ListGroupsByOwner"); err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	owner, err := f.listAccount(opts.Owner)
+	if err != nil {
+		return nil, err
+	}
+	return f.listGroups(opts.StartAfter, opts.Limit, func(group *fakeGroup) (string, bool) {
+		return "", group.info.Owner == owner
+	})
+}
+
+// listGroups returns a page of the groups matched by the filter, which also returns the expiration time of the
+// account in the group. The caller must hold the lock.
+func (f *Fake) listGroups(startAfter string, limit int64, filter func(group *fakeGroup) (string, bool)) (*types.GroupsResult, error) {
+	var startAfterID uint64
+	if startAfter != "" {
+		id, err := strconv.ParseUint(startAfter, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		startAfterID = id
+	}
+	ids := make([]uint64, 0)
+	for id := range f.groupsByID {
+		if id > startAfterID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	result := &types.GroupsResult{Groups: make([]*types.GroupMembers, 0)}
+	for _, id := range ids {
+		if len(result.Groups) == pageLimit(limit) {
+			break
+		}
+		group := f.groupsByID[id]
+		expiration, ok := filter(group)
+		if !ok {
+			continue
+		}
+		info := *group.info
+		result.Groups = append(result.Groups, &types.GroupMembers{
+			Group:          &info,
+			Operator:       group.info.Owner,
+			AccountID:      group.info.Owner,
+			ExpirationTime: expiration,
+		})
+	}
+	return result, nil
+}
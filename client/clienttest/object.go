@@ -0,0 +1,559 @@
+package clienttest
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/types/s3util"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// listObjectsMaxKeys is the default and the maximum number of the keys returned by ListObjects.
+const listObjectsMaxKeys = 1000
+
+// checksum returns the checksum of the object content, the Fake keeps a single checksum of the whole content instead
+// of the integrity hashes of the segments.
+func checksum(content []byte) [][]byte {
+	sum := sha256.Sum256(content)
+	return [][]byte{sum[:]}
+}
+
+// CreateObject - Create the object meta on the chain, the object stays in the created status until it is uploaded.
+//
+// The reader is read to compute the size and the checksum of the object, the content uploaded by PutObject must match them.
+func (f *Fake) CreateObject(ctx context.Context, bucketName, objectName string, reader io.Reader, opts types.CreateObjectOptions) (string, error) {
+	if err := f.call(ctx, "CreateObject"); err != nil {
+		return "", err
+	}
+	if reader == nil {
+		return "", errors.New("fail to compute hash, reader is nil")
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	redundancyType := storageTypes.REDUNDANCY_EC_TYPE
+	if opts.IsReplicaType {
+		redundancyType = storageTypes.REDUNDANCY_REPLICA_TYPE
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opts.Account)
+	if err != nil {
+		return "", err
+	}
+	object, err := f.createObject(bucketName, objectName, signer, content, opts.ContentType, opts.Visibility, redundancyType)
+	if err != nil {
+		return "", err
+	}
+	object.info.Tags = opts.Tags
+	return object.createTxHash, nil
+}
+
+// CreateFolder - Create a folder, which is an empty object whose name ends with "/".
+func (f *Fake) CreateFolder(ctx context.Context, bucketName, objectName string, opts types.CreateObjectOptions) (string, error) {
+	if !strings.HasSuffix(objectName, "/") {
+		return "", errors.New("failed to create folder. Folder names must end with a forward slash (/) character")
+	}
+	return f.CreateObject(ctx, bucketName, objectName, bytes.NewReader(nil), opts)
+}
+
+// createObject creates the object meta if the signer is allowed to create it. The empty object is sealed immediately
+// as the chain does. The caller must hold the lock.
+func (f *Fake) createObject(bucketName, objectName string, signer sdk.AccAddress, content []byte, contentType string,
+	visibility storageTypes.VisibilityType, redundancyType storageTypes.RedundancyType,
+) (*fakeObject, error) {
+	if err := s3util.CheckValidObjectName(objectName); err != nil {
+		return nil, err
+	}
+	bucket, err := f.getBucket(bucketName)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := bucket.objects[objectName]; ok {
+		return nil, storageTypes.ErrObjectAlreadyExists.Wrapf("bucket: %s, object: %s", bucketName, objectName)
+	}
+	size := uint64(len(content))
+	opts := &permTypes.VerifyOptions{Resource: gnfdTypes.NewObjectGRN(bucketName, objectName).String(), WantedSize: &size}
+	if f.verifyBucketPermission(bucket, signer, permTypes.ACTION_CREATE_OBJECT, opts) != permTypes.EFFECT_ALLOW {
+		return nil, storageTypes.ErrAccessDenied.Wrapf("the operator %s has no CreateObject permission of the bucket %s", signer, bucketName)
+	}
+	if visibility == storageTypes.VISIBILITY_TYPE_UNSPECIFIED {
+		visibility = storageTypes.VISIBILITY_TYPE_INHERIT
+	}
+	if contentType == "" {
+		contentType = types.ContentDefault
+	}
+	object := &fakeObject{
+		info: &storageTypes.ObjectInfo{
+			Owner:          bucket.info.Owner,
+			Creator:        signer.String(),
+			BucketName:     bucketName,
+			ObjectName:     objectName,
+			Id:             f.nextID(),
+			PayloadSize:    size,
+			Visibility:     visibility,
+			ContentType:    contentType,
+			CreateAt:       f.now().Unix(),
+			ObjectStatus:   storageTypes.OBJECT_STATUS_CREATED,
+			RedundancyType: redundancyType,
+			SourceType:     storageTypes.SOURCE_TYPE_ORIGIN,
+			Checksums:      checksum(content),
+		},
+		createTxHash: f.commitTx(),
+	}
+	if size == 0 {
+		object.info.ObjectStatus = storageTypes.OBJECT_STATUS_SEALED
+		object.sealTxHash = object.createTxHash
+	}
+	bucket.objects[objectName] = object
+	return object, nil
+}
+
+// PutObject - Upload the content of the created object, the object is sealed once it is uploaded unless the auto
+// sealing is disabled by SetAutoSeal.
+//
+// The object is created by the signer if it does not exist and opts.Delegated is set, and the content of a sealed
+// object is replaced if opts.IsUpdate is also set.
+func (f *Fake) PutObject(ctx context.Context, bucketName, objectName string, objectSize int64, reader io.Reader, opts types.PutObjectOptions) error {
+	if err := f.call(ctx, "PutObject"); err != nil {
+		return err
+	}
+	return f.putObject(bucketName, objectName, objectSize, reader, opts)
+}
+
+// DelegatePutObject - Upload the object and let the SP create the object meta on behalf of the signer.
+func (f *Fake) DelegatePutObject(ctx context.Context, bucketName, objectName string, objectSize int64, reader io.Reader, opts types.PutObjectOptions) error {
+	if err := f.call(ctx, "DelegatePutObject"); err != nil {
+		return err
+	}
+	opts.Delegated = true
+	return f.putObject(bucketName, objectName, objectSize, reader, opts)
+}
+
+// FPutObject - Upload the content of the created object from the file.
+func (f *Fake) FPutObject(ctx context.Context, bucketName, objectName, filePath string, opts types.PutObjectOptions) error {
+	if err := f.call(ctx, "FPutObject"); err != nil {
+		return err
+	}
+	fd, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+	stat, err := fd.Stat()
+	if err != nil {
+		return err
+	}
+	return f.putObject(bucketName, objectName, stat.Size(), fd, opts)
+}
+
+func (f *Fake) putObject(bucketName, objectName string, objectSize int64, reader io.Reader, opts types.PutObjectOptions) error {
+	if objectSize < 0 {
+		return errors.New("object size should not be less than 0")
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	if int64(len(content)) != objectSize {
+		return fmt.Errorf("the size of the content %d does not match the object size %d", len(content), objectSize)
+	}
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opts.Account)
+	if err != nil {
+		return err
+	}
+	bucket, err := f.getBucket(bucketName)
+	if err != nil {
+		return err
+	}
+	object, ok := bucket.objects[objectName]
+	switch {
+	case !ok && opts.Delegated:
+		object, err = f.createObject(bucketName, objectName, signer, content, opts.ContentType, opts.Visibility, storageTypes.REDUNDANCY_EC_TYPE)
+		if err != nil {
+			return err
+		}
+	case !ok:
+		return storageTypes.ErrNoSuchObject.Wrapf("bucket: %s, object: %s", bucketName, objectName)
+	case opts.Delegated && opts.IsUpdate:
+		if f.verifyObjectPermission(bucket, object, signer, permTypes.ACTION_UPDATE_OBJECT_CONTENT) != permTypes.EFFECT_ALLOW {
+			return storageTypes.ErrAccessDenied.Wrapf("the operator %s has no UpdateObjectContent permission of the object %s", signer, objectName)
+		}
+		object.info.PayloadSize = uint64(len(content))
+		object.info.Checksums = checksum(content)
+		object.info.UpdatedAt = f.now().Unix()
+		object.info.UpdatedBy = signer.String()
+		object.info.ObjectStatus = storageTypes.OBJECT_STATUS_CREATED
+		object.info.Version++
+		object.content = nil
+	}
+
+	// the empty object has been sealed when it is created
+	if len(content) == 0 && object.info.PayloadSize == 0 && object.info.ObjectStatus == storageTypes.OBJECT_STATUS_SEALED {
+		return nil
+	}
+	if object.info.ObjectStatus != storageTypes.OBJECT_STATUS_CREATED {
+		return storageTypes.ErrObjectNotCreated.Wrapf("Object status: %s", object.info.ObjectStatus.String())
+	}
+	if !bytes.Equal(checksum(content)[0], object.info.Checksums[0]) {
+		return types.ErrResponse{StatusCode: 400, Code: "InvalidChecksum", Message: "the checksum of the content does not match the object"}
+	}
+	object.content = content
+	if f.autoSeal {
+		object.info.ObjectStatus = storageTypes.OBJECT_STATUS_SEALED
+		object.sealTxHash = f.commitTx()
+	}
+	return nil
+}
+
+// SealObject - Seal the uploaded object, it is used with SetAutoSeal(false) to control when the object is sealed.
+//
+// - bucketName: The name of the bucket.
+//
+// - objectName: The name of the object.
+//
+// - ret: Return error when the object does not exist or has not been uploaded, otherwise return nil.
+func (f *Fake) SealObject(bucketName, objectName string) error {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	_, object, err := f.getObject(bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	if object.info.ObjectStatus != storageTypes.OBJECT_STATUS_CREATED {
+		return storageTypes.ErrObjectNotCreated.Wrapf("Object status: %s", object.info.ObjectStatus.String())
+	}
+	if object.content == nil && object.info.PayloadSize > 0 {
+		return fmt.Errorf("the object %s has not been uploaded", objectName)
+	}
+	object.info.ObjectStatus = storageTypes.OBJECT_STATUS_SEALED
+	object.sealTxHash = f.commitTx()
+	return nil
+}
+
+// GetObjectUploadProgress - Get the upload status of the object, which is "sealed" or "object meta created".
+func (f *Fake) GetObjectUploadProgress(ctx context.Context, bucketName, objectName string) (string, error) {
+	if err := f.call(ctx, "GetObjectUploadProgress"); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	_, object, err := f.getObject(bucketName, objectName)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case object.info.ObjectStatus == storageTypes.OBJECT_STATUS_SEALED:
+		return "sealed", nil
+	case object.content != nil:
+		return "sealing", nil
+	default:
+		return "object meta created", nil
+	}
+}
+
+// GetObject - Download the sealed object, the signer needs the permission to get the object.
+//
+// The range "bytes=start-end" and "bytes=start-" in opts.Range are supported.
+func (f *Fake) GetObject(ctx context.Context, bucketName, objectName string, opts types.GetObjectOptions) (io.ReadCloser, types.ObjectStat, error) {
+	if err := f.call(ctx, "GetObject"); err != nil {
+		return nil, types.ObjectStat{}, err
+	}
+	content, stat, err := f.getObjectContent(bucketName, objectName, opts)
+	if err != nil {
+		return nil, types.ObjectStat{}, err
+	}
+	return io.NopCloser(bytes.NewReader(content)), stat, nil
+}
+
+// FGetObject - Download the sealed object into the file.
+func (f *Fake) FGetObject(ctx context.Context, bucketName, objectName, filePath string, opts types.GetObjectOptions) error {
+	if err := f.call(ctx, "FGetObject"); err != nil {
+		return err
+	}
+	content, _, err := f.getObjectContent(bucketName, objectName, opts)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, content, 0o660)
+}
+
+func (f *Fake) getObjectContent(bucketName, objectName string, opts types.GetObjectOptions) ([]byte, types.ObjectStat, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opts.Account)
+	if err != nil {
+		return nil, types.ObjectStat{}, err
+	}
+	bucket, object, err := f.getObject(bucketName, objectName)
+	if err != nil {
+		return nil, types.ObjectStat{}, err
+	}
+	if f.verifyObjectPermission(bucket, object, signer, permTypes.ACTION_GET_OBJECT) != permTypes.EFFECT_ALLOW {
+		return nil, types.ObjectStat{}, types.ErrResponse{StatusCode: 403, Code: "AccessDenied", Message: "access denied"}
+	}
+	if object.info.ObjectStatus != storageTypes.OBJECT_STATUS_SEALED {
+		return nil, types.ObjectStat{}, storageTypes.ErrObjectNotSealed.Wrapf("Object status: %s", object.info.ObjectStatus.String())
+	}
+	content := object.content
+	if opts.Range != "" {
+		start, end, err := parseRange(opts.Range, int64(len(content)))
+		if err != nil {
+			return nil, types.ObjectStat{}, err
+		}
+		content = content[start : end+1]
+	}
+	return append([]byte(nil), content...), types.ObjectStat{
+		ObjectName:  objectName,
+		ContentType: object.info.ContentType,
+		Size:        int64(len(content)),
+	}, nil
+}
+
+// parseRange parses the range "bytes=start-end" or "bytes=start-" of the content.
+func parseRange(rangeInfo string, size int64) (int64, int64, error) {
+	invalidRange := types.ErrResponse{StatusCode: 416, Code: "InvalidRange", Message: "invalid range " + rangeInfo}
+	spec, ok := strings.CutPrefix(rangeInfo, "bytes=")
+	if !ok {
+		return 0, 0, invalidRange
+	}
+	startStr, endStr, ok := strings.Cut(spec, "-")
+	if !ok {
+		return 0, 0, invalidRange
+	}
+	start, err := strconv.ParseInt(startStr, 10, 64)
+	if err != nil || start >= size {
+		return 0, 0, invalidRange
+	}
+	end := size - 1
+	if endStr != "" {
+		if end, err = strconv.ParseInt(endStr, 10, 64); err != nil || end < start {
+			return 0, 0, invalidRange
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+	return start, end, nil
+}
+
+// HeadObject - Query the object info.
+func (f *Fake) HeadObject(ctx context.Context, bucketName, objectName string) (*types.ObjectDetail, error) {
+	if err := f.call(ctx, "HeadObject"); err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	_, object, err := f.getObject(bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	info := *object.info
+	return &types.ObjectDetail{ObjectInfo: &info}, nil
+}
+
+// HeadObjectByID - Query the object info by the object id.
+func (f *Fake) HeadObjectByID(ctx context.Context, objID string) (*types.ObjectDetail, error) {
+	if err := f.call(ctx, "HeadObjectByID"); err != nil {
+		return nil, err
+	}
+	id, err := sdkmath.ParseUint(objID)
+	if err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	for _, bucket := range f.buckets {
+		for _, object := range bucket.objects {
+			if object.info.Id.Equal(id) {
+				info := *object.info
+				return &types.ObjectDetail{ObjectInfo: &info}, nil
+			}
+		}
+	}
+	return nil, storageTypes.ErrNoSuchObject.Wrapf("object id: %s", objID)
+}
+
+// DeleteObject - Delete the sealed object, the signer needs the permission to delete the object.
+func (f *Fake) DeleteObject(ctx context.Context, bucketName, objectName string, opt types.DeleteObjectOption) (string, error) {
+	if err := f.call(ctx, "DeleteObject"); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	bucket, object, err := f.getObject(bucketName, objectName)
+	if err != nil {
+		return "", err
+	}
+	if object.info.ObjectStatus == storageTypes.OBJECT_STATUS_CREATED {
+		return "", storageTypes.ErrObjectNotSealed.Wrapf("the object %s is not sealed, cancel creating it instead", objectName)
+	}
+	if f.verifyObjectPermission(bucket, object, signer, permTypes.ACTION_DELETE_OBJECT) != permTypes.EFFECT_ALLOW {
+		return "", storageTypes.ErrAccessDenied.Wrapf("the operator %s has no DeleteObject permission of the object %s", signer, objectName)
+	}
+	delete(bucket.objects, objectName)
+	f.deletePolicies(gnfdTypes.NewObjectGRN(bucketName, objectName))
+	return f.commitTx(), nil
+}
+
+// CancelCreateObject - Cancel creating the object which has not been sealed, the signer needs to be the owner or the
+// creator of the object.
+func (f *Fake) CancelCreateObject(ctx context.Context, bucketName, objectName string, opt types.CancelCreateOption) (string, error) {
+	if err := f.call(ctx, "CancelCreateObject"); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	bucket, object, err := f.getObject(bucketName, objectName)
+	if err != nil {
+		return "", err
+	}
+	if object.info.ObjectStatus != storageTypes.OBJECT_STATUS_CREATED {
+		return "", storageTypes.ErrObjectNotCreated.Wrapf("Object status: %s", object.info.ObjectStatus.String())
+	}
+	if signer.String() != object.info.Owner && signer.String() != object.info.Creator {
+		return "", storageTypes.ErrAccessDenied.Wrapf("only the owner or the creator can cancel creating the object %s", objectName)
+	}
+	delete(bucket.objects, objectName)
+	f.deletePolicies(gnfdTypes.NewObjectGRN(bucketName, objectName))
+	return f.commitTx(), nil
+}
+
+// UpdateObjectVisibility - Update the visibility of the object.
+func (f *Fake) UpdateObjectVisibility(ctx context.Context, bucketName, objectName string, visibility storageTypes.VisibilityType, opt types.UpdateObjectOption) (string, error) {
+	if err := f.call(ctx, "UpdateObjectVisibility"); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	bucket, object, err := f.getObject(bucketName, objectName)
+	if err != nil {
+		return "", err
+	}
+	if f.verifyObjectPermission(bucket, object, signer, permTypes.ACTION_UPDATE_OBJECT_INFO) != permTypes.EFFECT_ALLOW {
+		return "", storageTypes.ErrAccessDenied.Wrapf("the operator %s has no UpdateObjectInfo permission of the object %s", signer, objectName)
+	}
+	object.info.Visibility = visibility
+	return f.commitTx(), nil
+}
+
+// ListObjects - List the objects of the bucket in the lexicographical order of their names.
+//
+// The pagination follows the SP: the keys after StartAfter or the continuation token are listed, the keys sharing the
+// same prefix up to the delimiter are grouped into a common prefix, and at most MaxKeys objects and common prefixes
+// are returned, 1000 by default.
+func (f *Fake) ListObjects(ctx context.Context, bucketName string, opts types.ListObjectsOptions) (types.ListObjectsResult, error) {
+	if err := f.call(ctx, "ListObjects"); err != nil {
+		return types.ListObjectsResult{}, err
+	}
+	maxKeys := opts.MaxKeys
+	if maxKeys == 0 || maxKeys > listObjectsMaxKeys {
+		maxKeys = listObjectsMaxKeys
+	}
+	if opts.Delimiter != "" && opts.Delimiter != "/" {
+		return types.ListObjectsResult{}, errors.New("the delimiter of ListObjects should be '/'")
+	}
+	startAfter := opts.StartAfter
+	if opts.ContinuationToken != "" {
+		decoded, err := base64.StdEncoding.DecodeString(opts.ContinuationToken)
+		if err != nil {
+			return types.ListObjectsResult{}, err
+		}
+		if !strings.HasPrefix(string(decoded), opts.Prefix) {
+			return types.ListObjectsResult{}, fmt.Errorf("continuation-token does not match the input prefix")
+		}
+		startAfter = string(decoded)
+	}
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	bucket, err := f.getBucket(bucketName)
+	if err != nil {
+		return types.ListObjectsResult{}, err
+	}
+	names := make([]string, 0, len(bucket.objects))
+	for name := range bucket.objects {
+		if strings.HasPrefix(name, opts.Prefix) && name > startAfter {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	result := types.ListObjectsResult{
+		Objects:           make([]*types.ObjectMeta, 0),
+		CommonPrefixes:    make([]string, 0),
+		MaxKeys:           strconv.FormatUint(maxKeys, 10),
+		Name:              bucketName,
+		Prefix:            opts.Prefix,
+		Delimiter:         opts.Delimiter,
+		ContinuationToken: opts.ContinuationToken,
+	}
+	var count uint64
+	var lastKey string
+	for _, name := range names {
+		commonPrefix := ""
+		if opts.Delimiter != "" {
+			if i := strings.Index(name[len(opts.Prefix):], opts.Delimiter); i >= 0 {
+				commonPrefix = name[:len(opts.Prefix)+i+len(opts.Delimiter)]
+			}
+		}
+		// the common prefix is returned once, including the one ending the previous page
+		if commonPrefix != "" && (commonPrefix == lastKey || commonPrefix == startAfter) {
+			continue
+		}
+		if count == maxKeys {
+			result.IsTruncated = true
+			break
+		}
+		count++
+		if commonPrefix != "" {
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix)
+			lastKey = commonPrefix
+			continue
+		}
+		object := bucket.objects[name]
+		info := *object.info
+		result.Objects = append(result.Objects, &types.ObjectMeta{
+			ObjectInfo:   &info,
+			CreateTxHash: object.createTxHash,
+			SealTxHash:   object.sealTxHash,
+		})
+		lastKey = name
+	}
+	result.KeyCount = strconv.FormatUint(count, 10)
+	if result.IsTruncated {
+		result.NextContinuationToken = base64.StdEncoding.EncodeToString([]byte(lastKey))
+	}
+	return result, nil
+}
@@ -0,0 +1,148 @@
+package clienttest
+
+import (
+	"context"
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	gnfdSdkTypes "github.com/bnb-chain/greenfield/sdk/types"
+	paymentTypes "github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+// CreatePaymentAccount - Create a refundable payment account owned by the address, the address of the payment account
+// is derived from the owner and the number of the payment accounts of the owner as the chain does.
+func (f *Fake) CreatePaymentAccount(ctx context.Context, addr string, txOption gnfdSdkTypes.TxOption) (string, error) {
+	if err := f.call(ctx, "CreatePaymentAccount"); err != nil {
+		return "", err
+	}
+	owner, err := sdk.AccAddressFromHexUnsafe(addr)
+	if err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	count := f.paymentCounts[owner.String()]
+	paymentAddr := derivePaymentAccountAddress(owner, count)
+	f.paymentCounts[owner.String()] = count + 1
+	f.paymentAccounts[paymentAddr.String()] = &paymentTypes.PaymentAccount{
+		Addr:       paymentAddr.String(),
+		Owner:      owner.String(),
+		Refundable: true,
+	}
+	return f.commitTx(), nil
+}
+
+// GetPaymentAccount - Get the payment account by its address.
+func (f *Fake) GetPaymentAccount(ctx context.Context, addr string) (*paymentTypes.PaymentAccount, error) {
+	if err := f.call(ctx, "GetPaymentAccount"); err != nil {
+		return nil, err
+	}
+	paymentAddr, err := sdk.AccAddressFromHexUnsafe(addr)
+	if err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	account, ok := f.paymentAccounts[paymentAddr.String()]
+	if !ok {
+		return nil, paymentTypes.ErrPaymentAccountNotFound.Wrapf("address: %s", addr)
+	}
+	pa := *account
+	return &pa, nil
+}
+
+// GetPaymentAccountsByOwner - Get the payment accounts owned by the address in the order of their creation.
+func (f *Fake) GetPaymentAccountsByOwner(ctx context.Context, owner string) ([]*paymentTypes.PaymentAccount, error) {
+	if err := f.call(ctx, "GetPaymentAccountsByOwner"); err != nil {
+		return nil, err
+	}
+	ownerAddr, err := sdk.AccAddressFromHexUnsafe(owner)
+	if err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	return f.paymentAccountsOf(ownerAddr), nil
+}
+
+// ListUserPaymentAccounts - List the payment accounts owned by the account in the order of their creation.
+func (f *Fake) ListUserPaymentAccounts(ctx context.Context, opts types.ListUserPaymentAccountsOptions) (types.ListUserPaymentAccountsResult, error) {
+	if err := f.call(ctx, "ListUserPaymentAccounts"); err != nil {
+		return types.ListUserPaymentAccountsResult{}, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	account, err := f.listAccount(opts.Account)
+	if err != nil {
+		return types.ListUserPaymentAccountsResult{}, err
+	}
+	result := types.ListUserPaymentAccountsResult{PaymentAccounts: make([]*types.PaymentAccounts, 0)}
+	for _, pa := range f.paymentAccountsOf(sdk.MustAccAddressFromHex(account)) {
+		result.PaymentAccounts = append(result.PaymentAccounts, &types.PaymentAccounts{
+			PaymentAccount: &types.PaymentAccount{
+				Address:    pa.Addr,
+				Owner:      pa.Owner,
+				Refundable: pa.Refundable,
+			},
+			StreamRecord: &types.StreamRecord{Account: pa.Addr},
+		})
+	}
+	return result, nil
+}
+
+// DisableRefund - Make the payment account owned by the signer non-refundable.
+func (f *Fake) DisableRefund(ctx context.Context, paymentAddress string, txOption gnfdSdkTypes.TxOption) (string, error) {
+	if err := f.call(ctx, "DisableRefund"); err != nil {
+		return "", err
+	}
+	paymentAddr, err := sdk.AccAddressFromHexUnsafe(paymentAddress)
+	if err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.txSigner(txOption)
+	if err != nil {
+		return "", err
+	}
+	account, ok := f.paymentAccounts[paymentAddr.String()]
+	if !ok {
+		return "", paymentTypes.ErrPaymentAccountNotFound.Wrapf("address: %s", paymentAddress)
+	}
+	if account.Owner != signer.String() {
+		return "", paymentTypes.ErrNotPaymentAccountOwner
+	}
+	account.Refundable = false
+	return f.commitTx(), nil
+}
+
+// paymentAccountsOf returns the copies of the payment accounts of the owner in the order of their creation. The
+// caller must hold the lock.
+func (f *Fake) paymentAccountsOf(owner sdk.AccAddress) []*paymentTypes.PaymentAccount {
+	count := f.paymentCounts[owner.String()]
+	accounts := make([]*paymentTypes.PaymentAccount, 0, count)
+	for i := uint64(0); i < count; i++ {
+		pa := *f.paymentAccounts[derivePaymentAccountAddress(owner, i).String()]
+		accounts = append(accounts, &pa)
+	}
+	return accounts
+}
+
+// derivePaymentAccountAddress derives the address of the index-th payment account of the owner as the chain does.
+func derivePaymentAccountAddress(owner sdk.AccAddress, index uint64) sdk.AccAddress {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, index)
+	return address.Derive(owner.Bytes(), b)[:sdk.EthAddressLength]
+}
+
+// txSigner returns the address of the OverrideKeyManager of the tx option, or the address of the default account.
+// The caller must hold the lock.
+func (f *Fake) txSigner(txOption gnfdSdkTypes.TxOption) (sdk.AccAddress, error) {
+	if txOption.OverrideKeyManager != nil {
+		return (*txOption.OverrideKeyManager).GetAddr(), nil
+	}
+	return f.signer(nil)
+}
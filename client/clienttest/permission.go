@@ -0,0 +1,422 @@
+package clienttest
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/types/common"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// policyKey identifies the policy of a principal on a resource.
+type policyKey struct {
+	resource      string // the GRN of the resource
+	principalType permTypes.PrincipalType
+	principal     string // the address of the account or the id of the group
+}
+
+// putPolicy puts the policy of the principal on the resource owned by the operator. The caller must hold the lock.
+func (f *Fake) putPolicy(operator sdk.AccAddress, grn *gnfdTypes.GRN, resourceOwner string, resourceID sdkmath.Uint,
+	principalStr types.Principal, statements []*permTypes.Statement, expireTime *time.Time,
+) (string, error) {
+	principal := &permTypes.Principal{}
+	if err := principal.Unmarshal([]byte(principalStr)); err != nil {
+		return "", err
+	}
+	if operator.String() != resourceOwner {
+		return "", storageTypes.ErrAccessDenied.Wrapf("only the owner %s can put the policy of %s", resourceOwner, grn.String())
+	}
+	if principal.Type == permTypes.PRINCIPAL_TYPE_GNFD_GROUP {
+		if _, ok := f.groupsByID[principal.MustGetGroupID().Uint64()]; !ok {
+			return "", storageTypes.ErrNoSuchGroup.Wrapf("group id: %s", principal.Value)
+		}
+	}
+	f.policies[policyKey{resource: grn.String(), principalType: principal.Type, principal: principal.Value}] = &permTypes.Policy{
+		Id:             f.nextID(),
+		Principal:      principal,
+		ResourceType:   grn.ResourceType(),
+		ResourceId:     resourceID,
+		Statements:     statements,
+		ExpirationTime: expireTime,
+	}
+	return f.commitTx(), nil
+}
+
+// deletePolicy deletes the policy of the principal on the resource owned by the operator. The caller must hold the lock.
+func (f *Fake) deletePolicy(operator sdk.AccAddress, grn *gnfdTypes.GRN, resourceOwner string, principalStr types.Principal) (string, error) {
+	principal := &permTypes.Principal{}
+	if err := principal.Unmarshal([]byte(principalStr)); err != nil {
+		return "", err
+	}
+	if operator.String() != resourceOwner {
+		return "", storageTypes.ErrAccessDenied.Wrapf("only the owner %s can delete the policy of %s", resourceOwner, grn.String())
+	}
+	key := policyKey{resource: grn.String(), principalType: principal.Type, principal: principal.Value}
+	if _, ok := f.policies[key]; !ok {
+		return "", storageTypes.ErrNoSuchPolicy.Wrapf("GRN: %s, principal: %s", grn.String(), principal.String())
+	}
+	delete(f.policies, key)
+	return f.commitTx(), nil
+}
+
+// getPolicy returns the policy of the principal on the resource. The caller must hold the lock.
+func (f *Fake) getPolicy(grn *gnfdTypes.GRN, principalType permTypes.PrincipalType, principal string) (*permTypes.Policy, error) {
+	policy, ok := f.policies[policyKey{resource: grn.String(), principalType: principalType, principal: principal}]
+	if !ok {
+		return nil, storageTypes.ErrNoSuchPolicy.Wrapf("GRN: %s, principal: %s", grn.String(), principal)
+	}
+	return policy, nil
+}
+
+// deletePolicies deletes the policies on the deleted resource. The caller must hold the lock.
+func (f *Fake) deletePolicies(grn *gnfdTypes.GRN) {
+	for key := range f.policies {
+		if key.resource == grn.String() {
+			delete(f.policies, key)
+		}
+	}
+}
+
+// permissionSnapshot collects the policies of the operator and the groups of the operator on the bucket and the object
+// to be evaluated by types.EvaluatePermission, the object is nil for the permission on the bucket. The caller must hold
+// the lock.
+func (f *Fake) permissionSnapshot(bucket *fakeBucket, object *fakeObject, operator sdk.AccAddress) *types.PermissionSnapshot {
+	bucketGRN := gnfdTypes.NewBucketGRN(bucket.info.BucketName).String()
+	snapshot := &types.PermissionSnapshot{
+		Operator:     operator.String(),
+		Bucket:       bucket.info,
+		BucketPolicy: f.policies[policyKey{resource: bucketGRN, principalType: permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT, principal: operator.String()}],
+	}
+	objectGRN := ""
+	if object != nil {
+		objectGRN = gnfdTypes.NewObjectGRN(object.info.BucketName, object.info.ObjectName).String()
+		snapshot.Object = object.info
+		snapshot.ObjectPolicy = f.policies[policyKey{resource: objectGRN, principalType: permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT, principal: operator.String()}]
+	}
+
+	groupIDs := make([]uint64, 0)
+	for id, group := range f.groupsByID {
+		if _, isMember := group.members[operator.String()]; isMember {
+			groupIDs = append(groupIDs, id)
+		}
+	}
+	sort.Slice(groupIDs, func(i, j int) bool { return groupIDs[i] < groupIDs[j] })
+	for _, id := range groupIDs {
+		group := f.groupsByID[id]
+		groupPrincipal := strconv.FormatUint(id, 10)
+		groupSnapshot := types.GroupPermissionSnapshot{
+			GroupID:          id,
+			GroupName:        group.info.GroupName,
+			MemberExpiration: group.members[operator.String()],
+			BucketPolicy:     f.policies[policyKey{resource: bucketGRN, principalType: permTypes.PRINCIPAL_TYPE_GNFD_GROUP, principal: groupPrincipal}],
+		}
+		if object != nil {
+			groupSnapshot.ObjectPolicy = f.policies[policyKey{resource: objectGRN, principalType: permTypes.PRINCIPAL_TYPE_GNFD_GROUP, principal: groupPrincipal}]
+		}
+		if groupSnapshot.BucketPolicy != nil || groupSnapshot.ObjectPolicy != nil {
+			snapshot.Groups = append(snapshot.Groups, groupSnapshot)
+		}
+	}
+	return snapshot
+}
+
+// evaluatePermission evaluates the permission of the operator on the bucket or the object with types.EvaluatePermission.
+// The allowing statements of ACTION_CREATE_OBJECT consume the wanted size from their limit size as the chain does. The
+// caller must hold the lock.
+func (f *Fake) evaluatePermission(bucket *fakeBucket, object *fakeObject, operator sdk.AccAddress, action permTypes.ActionType,
+	opts *permTypes.VerifyOptions,
+) permTypes.Effect {
+	evalOpts := types.EvaluatePermissionOptions{Time: f.now()}
+	if opts != nil {
+		evalOpts.WantedSize = opts.WantedSize
+	}
+	decision, err := types.EvaluatePermission(f.permissionSnapshot(bucket, object, operator), action, evalOpts)
+	if err != nil {
+		return permTypes.EFFECT_DENY
+	}
+	if decision.Effect == permTypes.EFFECT_ALLOW && action == permTypes.ACTION_CREATE_OBJECT && evalOpts.WantedSize != nil {
+		for _, matched := range decision.Statements {
+			if limitSize := matched.Statement.LimitSize; limitSize != nil {
+				matched.Statement.LimitSize = &common.UInt64Value{Value: limitSize.GetValue() - *evalOpts.WantedSize}
+			}
+		}
+	}
+	return decision.Effect
+}
+
+// verifyBucketPermission checks the permission of the operator on the bucket as the chain does. The caller must hold
+// the lock.
+func (f *Fake) verifyBucketPermission(bucket *fakeBucket, operator sdk.AccAddress, action permTypes.ActionType, opts *permTypes.VerifyOptions) permTypes.Effect {
+	return f.evaluatePermission(bucket, nil, operator, action, opts)
+}
+
+// verifyObjectPermission checks the permission of the operator on the object as the chain does, the policies on the
+// bucket which cover the object are also evaluated. The caller must hold the lock.
+func (f *Fake) verifyObjectPermission(bucket *fakeBucket, object *fakeObject, operator sdk.AccAddress, action permTypes.ActionType) permTypes.Effect {
+	return f.evaluatePermission(bucket, object, operator, action, nil)
+}
+
+// verifyGroupPermission checks the permission of the operator on the group as the chain does, only the policies of the
+// accounts can be put on the groups. The caller must hold the lock.
+func (f *Fake) verifyGroupPermission(group *fakeGroup, operator sdk.AccAddress, action permTypes.ActionType) permTypes.Effect {
+	if group.info.Owner == operator.String() {
+		return permTypes.EFFECT_ALLOW
+	}
+	owner := sdk.MustAccAddressFromHex(group.info.Owner)
+	policy, ok := f.policies[policyKey{resource: gnfdTypes.NewGroupGRN(owner, group.info.GroupName).String(),
+		principalType: permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT, principal: operator.String()}]
+	if !ok {
+		return permTypes.EFFECT_DENY
+	}
+	if effect, _ := policy.Eval(action, f.now(), nil); effect == permTypes.EFFECT_ALLOW {
+		return permTypes.EFFECT_ALLOW
+	}
+	return permTypes.EFFECT_DENY
+}
+
+// IsBucketPermissionAllowed - Check if the permission of bucket is allowed to the user.
+//
+// The permission is evaluated from the visibility, the owner and the policies of the bucket as the chain does.
+func (f *Fake) IsBucketPermissionAllowed(ctx context.Context, userAddr string, bucketName string, action permTypes.ActionType) (permTypes.Effect, error) {
+	if err := f.call(ctx, "IsBucketPermissionAllowed"); err != nil {
+		return permTypes.EFFECT_DENY, err
+	}
+	operator, err := sdk.AccAddressFromHexUnsafe(userAddr)
+	if err != nil {
+		return permTypes.EFFECT_DENY, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	bucket, err := f.getBucket(bucketName)
+	if err != nil {
+		return permTypes.EFFECT_DENY, err
+	}
+	return f.verifyBucketPermission(bucket, operator, action, nil), nil
+}
+
+// IsObjectPermissionAllowed - Check if the permission of the object is allowed to the user.
+//
+// The permission is evaluated from the visibility and the owner of the object, and the policies of the bucket and the
+// object as the chain does.
+func (f *Fake) IsObjectPermissionAllowed(ctx context.Context, userAddr string, bucketName, objectName string, action permTypes.ActionType) (permTypes.Effect, error) {
+	if err := f.call(ctx, "IsObjectPermissionAllowed"); err != nil {
+		return permTypes.EFFECT_DENY, err
+	}
+	operator, err := sdk.AccAddressFromHexUnsafe(userAddr)
+	if err != nil {
+		return permTypes.EFFECT_DENY, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	bucket, object, err := f.getObject(bucketName, objectName)
+	if err != nil {
+		return permTypes.EFFECT_DENY, err
+	}
+	return f.verifyObjectPermission(bucket, object, operator, action), nil
+}
+
+// PutBucketPolicy - Apply the bucket policy to the principal, the signer needs to be the owner of the bucket.
+func (f *Fake) PutBucketPolicy(ctx context.Context, bucketName string, principal types.Principal,
+	statements []*permTypes.Statement, opt types.PutPolicyOption,
+) (string, error) {
+	if err := f.call(ctx, "PutBucketPolicy"); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	bucket, err := f.getBucket(bucketName)
+	if err != nil {
+		return "", err
+	}
+	return f.putPolicy(signer, gnfdTypes.NewBucketGRN(bucketName), bucket.info.Owner, bucket.info.Id, principal, statements, opt.PolicyExpireTime)
+}
+
+// DeleteBucketPolicy - Delete the bucket policy of the principal, the signer needs to be the owner of the bucket.
+func (f *Fake) DeleteBucketPolicy(ctx context.Context, bucketName string, principal types.Principal, opt types.DeletePolicyOption) (string, error) {
+	if err := f.call(ctx, "DeleteBucketPolicy"); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	bucket, err := f.getBucket(bucketName)
+	if err != nil {
+		return "", err
+	}
+	return f.deletePolicy(signer, gnfdTypes.NewBucketGRN(bucketName), bucket.info.Owner, principal)
+}
+
+// GetBucketPolicy - Get the bucket policy of the account.
+func (f *Fake) GetBucketPolicy(ctx context.Context, bucketName string, principalAddr string) (*permTypes.Policy, error) {
+	if err := f.call(ctx, "GetBucketPolicy"); err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if _, err := f.getBucket(bucketName); err != nil {
+		return nil, err
+	}
+	return f.getPolicy(gnfdTypes.NewBucketGRN(bucketName), permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT, principalAddr)
+}
+
+// GetBucketPolicyOfGroup - Get the bucket policy of the group.
+func (f *Fake) GetBucketPolicyOfGroup(ctx context.Context, bucketName string, groupId uint64) (*permTypes.Policy, error) {
+	if err := f.call(ctx, "GetBucketPolicyOfGroup"); err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if _, err := f.getBucket(bucketName); err != nil {
+		return nil, err
+	}
+	return f.getPolicy(gnfdTypes.NewBucketGRN(bucketName), permTypes.PRINCIPAL_TYPE_GNFD_GROUP, sdkmath.NewUint(groupId).String())
+}
+
+// PutObjectPolicy - Apply the object policy to the principal, the signer needs to be the owner of the object.
+func (f *Fake) PutObjectPolicy(ctx context.Context, bucketName, objectName string, principal types.Principal,
+	statements []*permTypes.Statement, opt types.PutPolicyOption,
+) (string, error) {
+	if err := f.call(ctx, "PutObjectPolicy"); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	_, object, err := f.getObject(bucketName, objectName)
+	if err != nil {
+		return "", err
+	}
+	return f.putPolicy(signer, gnfdTypes.NewObjectGRN(bucketName, objectName), object.info.Owner, object.info.Id, principal, statements, opt.PolicyExpireTime)
+}
+
+// DeleteObjectPolicy - Delete the object policy of the principal, the signer needs to be the owner of the object.
+func (f *Fake) DeleteObjectPolicy(ctx context.Context, bucketName, objectName string, principal types.Principal, opt types.DeletePolicyOption) (string, error) {
+	if err := f.call(ctx, "DeleteObjectPolicy"); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	_, object, err := f.getObject(bucketName, objectName)
+	if err != nil {
+		return "", err
+	}
+	return f.deletePolicy(signer, gnfdTypes.NewObjectGRN(bucketName, objectName), object.info.Owner, principal)
+}
+
+// GetObjectPolicy - Get the object policy of the account.
+func (f *Fake) GetObjectPolicy(ctx context.Context, bucketName, objectName string, principalAddr string) (*permTypes.Policy, error) {
+	if err := f.call(ctx, "GetObjectPolicy"); err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if _, _, err := f.getObject(bucketName, objectName); err != nil {
+		return nil, err
+	}
+	return f.getPolicy(gnfdTypes.NewObjectGRN(bucketName, objectName), permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT, principalAddr)
+}
+
+// GetObjectPolicyOfGroup - Get the object policy of the group.
+func (f *Fake) GetObjectPolicyOfGroup(ctx context.Context, bucketName, objectName string, groupId uint64) (*permTypes.Policy, error) {
+	if err := f.call(ctx, "GetObjectPolicyOfGroup"); err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if _, _, err := f.getObject(bucketName, objectName); err != nil {
+		return nil, err
+	}
+	return f.getPolicy(gnfdTypes.NewObjectGRN(bucketName, objectName), permTypes.PRINCIPAL_TYPE_GNFD_GROUP, sdkmath.NewUint(groupId).String())
+}
+
+// PutGroupPolicy - Apply the group policy to the account, the signer needs to be the owner of the group.
+func (f *Fake) PutGroupPolicy(ctx context.Context, groupName string, principalAddr string, statements []*permTypes.Statement, opt types.PutPolicyOption) (string, error) {
+	if err := f.call(ctx, "PutGroupPolicy"); err != nil {
+		return "", err
+	}
+	addr, err := sdk.AccAddressFromHexUnsafe(principalAddr)
+	if err != nil {
+		return "", err
+	}
+	principal, err := permTypes.NewPrincipalWithAccount(addr).Marshal()
+	if err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	group, err := f.getGroup(signer.String(), groupName)
+	if err != nil {
+		return "", err
+	}
+	return f.putPolicy(signer, gnfdTypes.NewGroupGRN(signer, groupName), group.info.Owner, group.info.Id,
+		types.Principal(principal), statements, opt.PolicyExpireTime)
+}
+
+// DeleteGroupPolicy - Delete the group policy of the account, the signer needs to be the owner of the group.
+func (f *Fake) DeleteGroupPolicy(ctx context.Context, groupName string, principalAddr string, opt types.DeletePolicyOption) (string, error) {
+	if err := f.call(ctx, "DeleteGroupPolicy"); err != nil {
+		return "", err
+	}
+	addr, err := sdk.AccAddressFromHexUnsafe(principalAddr)
+	if err != nil {
+		return "", err
+	}
+	principal, err := permTypes.NewPrincipalWithAccount(addr).Marshal()
+	if err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	group, err := f.getGroup(signer.String(), groupName)
+	if err != nil {
+		return "", err
+	}
+	return f.deletePolicy(signer, gnfdTypes.NewGroupGRN(signer, groupName), group.info.Owner, types.Principal(principal))
+}
+
+// GetGroupPolicy - Get the policy of the account on the group owned by the default account.
+func (f *Fake) GetGroupPolicy(ctx context.Context, groupName string, principalAddr string) (*permTypes.Policy, error) {
+	if err := f.call(ctx, "GetGroupPolicy"); err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	owner, err := f.signer(nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = f.getGroup(owner.String(), groupName); err != nil {
+		return nil, err
+	}
+	return f.getPolicy(gnfdTypes.NewGroupGRN(owner, groupName), permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT, principalAddr)
+}
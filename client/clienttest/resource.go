@@ -0,0 +1,126 @@
+package clienttest
+
+import (
+	"context"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	"github.com/bnb-chain/greenfield/types/resource"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// fakeResource is the owner, the id and the tags of the bucket, the object or the group.
+type fakeResource struct {
+	owner string
+	id    sdkmath.Uint
+	tags  *storageTypes.ResourceTags
+}
+
+// getResource returns the bucket, the object or the group of the resource. The caller must hold the lock.
+func (f *Fake) getResource(res types.Resource) (*fakeResource, error) {
+	if err := res.Validate(false); err != nil {
+		return nil, err
+	}
+	switch res.Type {
+	case resource.RESOURCE_TYPE_BUCKET:
+		bucket, err := f.getBucket(res.BucketName)
+		if err != nil {
+			return nil, err
+		}
+		return &fakeResource{owner: bucket.info.Owner, id: bucket.info.Id, tags: bucket.info.Tags}, nil
+	case resource.RESOURCE_TYPE_OBJECT:
+		_, object, err := f.getObject(res.BucketName, res.ObjectName)
+		if err != nil {
+			return nil, err
+		}
+		return &fakeResource{owner: object.info.Owner, id: object.info.Id, tags: object.info.Tags}, nil
+	case resource.RESOURCE_TYPE_GROUP:
+		owner, err := sdk.AccAddressFromHexUnsafe(res.GroupOwner)
+		if err != nil {
+			return nil, err
+		}
+		group, err := f.getGroup(owner.String(), res.GroupName)
+		if err != nil {
+			return nil, err
+		}
+		return &fakeResource{owner: group.info.Owner, id: group.info.Id, tags: group.info.Tags}, nil
+	default:
+		return nil, fmt.Errorf("unknown resource type %s", res.Type)
+	}
+}
+
+// PutPolicy - Apply the policy to the principal on the resource, the signer needs to be the owner of the resource.
+func (f *Fake) PutPolicy(ctx context.Context, res types.Resource, principal types.Principal,
+	statements []*permTypes.Statement, opt types.PutPolicyOption,
+) (string, error) {
+	if err := f.call(ctx, "PutPolicy"); err != nil {
+		return "", err
+	}
+	if err := types.ValidateStatements(res.Type, statements); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	r, err := f.getResource(res)
+	if err != nil {
+		return "", err
+	}
+	return f.putPolicy(signer, res.GRN(), r.owner, r.id, principal, statements, opt.PolicyExpireTime)
+}
+
+// DeletePolicy - Delete the policy of the principal on the resource, the signer needs to be the owner of the resource.
+func (f *Fake) DeletePolicy(ctx context.Context, res types.Resource, principal types.Principal, opt types.DeletePolicyOption) (string, error) {
+	if err := f.call(ctx, "DeletePolicy"); err != nil {
+		return "", err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	signer, err := f.signer(opt.Account)
+	if err != nil {
+		return "", err
+	}
+	r, err := f.getResource(res)
+	if err != nil {
+		return "", err
+	}
+	return f.deletePolicy(signer, res.GRN(), r.owner, principal)
+}
+
+// GetPolicy - Get the policy of the account or the group on the resource.
+func (f *Fake) GetPolicy(ctx context.Context, res types.Resource, principalStr types.Principal) (*permTypes.Policy, error) {
+	if err := f.call(ctx, "GetPolicy"); err != nil {
+		return nil, err
+	}
+	principal := &permTypes.Principal{}
+	if err := principal.Unmarshal([]byte(principalStr)); err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if _, err := f.getResource(res); err != nil {
+		return nil, err
+	}
+	return f.getPolicy(res.GRN(), principal.Type, principal.Value)
+}
+
+// GetTags - Get the tags of the resource.
+func (f *Fake) GetTags(ctx context.Context, res types.Resource) (*storageTypes.ResourceTags, error) {
+	if err := f.call(ctx, "GetTags"); err != nil {
+		return nil, err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	r, err := f.getResource(res)
+	if err != nil {
+		return nil, err
+	}
+	return r.tags, nil
+}
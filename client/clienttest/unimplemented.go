@@ -0,0 +1,540 @@
+package clienttest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/proto/tendermint/p2p"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/votepool"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	govTypesV1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	oracletypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"google.golang.org/grpc"
+
+	"github.com/bnb-chain/greenfield-go-sdk/client"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	gnfdSdkTypes "github.com/bnb-chain/greenfield/sdk/types"
+	challengeTypes "github.com/bnb-chain/greenfield/x/challenge/types"
+	paymentTypes "github.com/bnb-chain/greenfield/x/payment/types"
+	spTypes "github.com/bnb-chain/greenfield/x/sp/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	virtualGroupTypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+// The methods below are not implemented by the Fake. The ones returning an error fail with the error of
+// notImplemented, and the others panic with it, so that a test calling them gets an explicit message.
+
+// notImplemented returns the error of calling the method which is not implemented by the Fake.
+func notImplemented(method string) error {
+	return fmt.Errorf("clienttest: %s not implemented", method)
+}
+
+func (f *Fake) ApplyPolicyTemplate(context.Context, string, types.Principal, types.PolicyTemplate, types.PolicyTemplateOptions, types.PutPolicyOption) (string, error) {
+	return "", notImplemented("ApplyPolicyTemplate")
+}
+
+func (f *Fake) AttestChallenge(context.Context, string, string, string, uint64, sdkmath.Uint, challengeTypes.VoteResult, []uint64, []byte, gnfdSdkTypes.TxOption) (*sdk.TxResponse, error) {
+	return nil, notImplemented("AttestChallenge")
+}
+
+func (f *Fake) AuditBucketPermissions(context.Context, string, types.AuditBucketPermissionsOptions) (*types.PermissionAuditReport, error) {
+	return nil, notImplemented("AuditBucketPermissions")
+}
+
+func (f *Fake) BeginRedelegate(context.Context, string, string, sdkmath.Int, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("BeginRedelegate")
+}
+
+func (f *Fake) BroadcastRawTx(context.Context, []byte, bool) (*sdk.TxResponse, error) {
+	return nil, notImplemented("BroadcastRawTx")
+}
+
+func (f *Fake) BroadcastTx(context.Context, []sdk.Msg, *gnfdSdkTypes.TxOption, ...grpc.CallOption) (*tx.BroadcastTxResponse, error) {
+	return nil, notImplemented("BroadcastTx")
+}
+
+func (f *Fake) BroadcastVote(context.Context, votepool.Vote) error {
+	return notImplemented("BroadcastVote")
+}
+
+func (f *Fake) BulkUpdateGroupMember(context.Context, string, string, []types.DesiredMember, []string, types.BulkUpdateGroupMemberOptions) (*types.BulkGroupMemberUpdateResult, error) {
+	return nil, notImplemented("BulkUpdateGroupMember")
+}
+
+func (f *Fake) BuyQuotaForBucket(context.Context, string, uint64, types.BuyQuotaOption) (string, error) {
+	return "", notImplemented("BuyQuotaForBucket")
+}
+
+func (f *Fake) CancelMigrateBucket(context.Context, string, types.CancelMigrateBucketOptions) (string, error) {
+	return "", notImplemented("CancelMigrateBucket")
+}
+
+func (f *Fake) CancelUnbondingDelegation(context.Context, string, int64, sdkmath.Int, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("CancelUnbondingDelegation")
+}
+
+func (f *Fake) CancelUpdateObjectContent(context.Context, string, string, types.CancelUpdateObjectOption) (string, error) {
+	return "", notImplemented("CancelUpdateObjectContent")
+}
+
+func (f *Fake) ChallengeParams(context.Context, *challengeTypes.QueryParamsRequest) (*challengeTypes.QueryParamsResponse, error) {
+	return nil, notImplemented("ChallengeParams")
+}
+
+func (f *Fake) Claims(context.Context, uint32, uint32, uint64, uint64, []byte, []uint64, []byte, gnfdSdkTypes.TxOption) (*sdk.TxResponse, error) {
+	return nil, notImplemented("Claims")
+}
+
+func (f *Fake) ComputeHashRoots(io.Reader, bool) ([][]byte, int64, storageTypes.RedundancyType, error) {
+	return nil, 0, 0, notImplemented("ComputeHashRoots")
+}
+
+func (f *Fake) CreateStorageProvider(context.Context, string, string, string, string, string, string, string, string, sdkmath.Int, spTypes.Description, types.CreateStorageProviderOptions) (uint64, string, error) {
+	return 0, "", notImplemented("CreateStorageProvider")
+}
+
+func (f *Fake) CreateValidator(context.Context, stakingtypes.Description, stakingtypes.CommissionRates, sdkmath.Int, string, string, string, string, string, string, string, sdkmath.Int, string, string, string, gnfdSdkTypes.TxOption) (uint64, string, error) {
+	return 0, "", notImplemented("CreateValidator")
+}
+
+func (f *Fake) DelegateCreateFolder(context.Context, string, string, types.PutObjectOptions) error {
+	return notImplemented("DelegateCreateFolder")
+}
+
+func (f *Fake) DelegateUpdateObjectContent(context.Context, string, string, int64, io.Reader, types.PutObjectOptions) error {
+	return notImplemented("DelegateUpdateObjectContent")
+}
+
+func (f *Fake) DelegateValidator(context.Context, string, sdkmath.Int, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("DelegateValidator")
+}
+
+func (f *Fake) DeleteUserPublicKeyV2(string, string, []string) (bool, error) {
+	return false, notImplemented("DeleteUserPublicKeyV2")
+}
+
+func (f *Fake) Deposit(context.Context, string, sdkmath.Int, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("Deposit")
+}
+
+func (f *Fake) EditValidator(context.Context, stakingtypes.Description, *sdkmath.LegacyDec, *sdkmath.Int, string, string, string, string, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("EditValidator")
+}
+
+func (f *Fake) EnableTrace(io.Writer, bool) {
+	panic(notImplemented("EnableTrace"))
+}
+
+func (f *Fake) FGetObjectResumable(context.Context, string, string, string, types.GetObjectOptions) error {
+	return notImplemented("FGetObjectResumable")
+}
+
+func (f *Fake) FundCommunityPool(context.Context, sdkmath.Int, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("FundCommunityPool")
+}
+
+func (f *Fake) GetAccount(context.Context, string) (authTypes.AccountI, error) {
+	return nil, notImplemented("GetAccount")
+}
+
+func (f *Fake) GetAccountBalance(context.Context, string) (*sdk.Coin, error) {
+	return nil, notImplemented("GetAccountBalance")
+}
+
+func (f *Fake) GetBlockByHeight(context.Context, int64) (*bfttypes.Block, error) {
+	return nil, notImplemented("GetBlockByHeight")
+}
+
+func (f *Fake) GetBlockResultByHeight(context.Context, int64) (*ctypes.ResultBlockResults, error) {
+	return nil, notImplemented("GetBlockResultByHeight")
+}
+
+func (f *Fake) GetBucketMigrationProgress(context.Context, string, uint32) (types.MigrationProgress, error) {
+	return types.MigrationProgress{}, notImplemented("GetBucketMigrationProgress")
+}
+
+func (f *Fake) GetBucketReadQuota(context.Context, string) (types.QuotaInfo, error) {
+	return types.QuotaInfo{}, notImplemented("GetBucketReadQuota")
+}
+
+func (f *Fake) GetCacheStats() (types.CacheStats, error) {
+	return types.CacheStats{}, notImplemented("GetCacheStats")
+}
+
+func (f *Fake) GetChallengeInfo(context.Context, string, int, int, types.GetChallengeInfoOptions) (types.ChallengeResult, error) {
+	return types.ChallengeResult{}, notImplemented("GetChallengeInfo")
+}
+
+func (f *Fake) GetChannelReceiveSequence(context.Context, sdk.ChainID, uint32) (uint64, error) {
+	return 0, notImplemented("GetChannelReceiveSequence")
+}
+
+func (f *Fake) GetChannelSendSequence(context.Context, sdk.ChainID, uint32) (uint64, error) {
+	return 0, notImplemented("GetChannelSendSequence")
+}
+
+func (f *Fake) GetCommit(context.Context, int64) (*ctypes.ResultCommit, error) {
+	return nil, notImplemented("GetCommit")
+}
+
+func (f *Fake) GetCreateBucketApproval(context.Context, *storageTypes.MsgCreateBucket) (*storageTypes.MsgCreateBucket, error) {
+	return nil, notImplemented("GetCreateBucketApproval")
+}
+
+func (f *Fake) GetCreateObjectApproval(context.Context, *storageTypes.MsgCreateObject) (*storageTypes.MsgCreateObject, error) {
+	return nil, notImplemented("GetCreateObjectApproval")
+}
+
+func (f *Fake) GetCrossChainPackage(context.Context, sdk.ChainID, uint32, uint64) ([]byte, error) {
+	return nil, notImplemented("GetCrossChainPackage")
+}
+
+func (f *Fake) GetGlobalSpStorePrice(context.Context) (*spTypes.GlobalSpStorePrice, error) {
+	return nil, notImplemented("GetGlobalSpStorePrice")
+}
+
+func (f *Fake) GetInturnRelayer(context.Context, *oracletypes.QueryInturnRelayerRequest) (*oracletypes.QueryInturnRelayerResponse, error) {
+	return nil, notImplemented("GetInturnRelayer")
+}
+
+func (f *Fake) GetLatestBlock(context.Context) (*bfttypes.Block, error) {
+	return nil, notImplemented("GetLatestBlock")
+}
+
+func (f *Fake) GetLatestBlockHeight(context.Context) (int64, error) {
+	return 0, notImplemented("GetLatestBlockHeight")
+}
+
+func (f *Fake) GetMigrateBucketApproval(context.Context, *storageTypes.MsgMigrateBucket) (*storageTypes.MsgMigrateBucket, error) {
+	return nil, notImplemented("GetMigrateBucketApproval")
+}
+
+func (f *Fake) GetModuleAccountByName(context.Context, string) (authTypes.ModuleAccountI, error) {
+	return nil, notImplemented("GetModuleAccountByName")
+}
+
+func (f *Fake) GetModuleAccounts(context.Context) ([]authTypes.ModuleAccountI, error) {
+	return nil, notImplemented("GetModuleAccounts")
+}
+
+func (f *Fake) GetNextNonce(string) (string, error) {
+	return "", notImplemented("GetNextNonce")
+}
+
+func (f *Fake) GetNodeInfo(context.Context) (*p2p.DefaultNodeInfo, *tmservice.VersionInfo, error) {
+	return nil, nil, notImplemented("GetNodeInfo")
+}
+
+func (f *Fake) GetPaymentAccountFlowRateLimit(context.Context, sdk.AccAddress, sdk.AccAddress, string) (*storageTypes.QueryPaymentAccountBucketFlowRateLimitResponse, error) {
+	return nil, notImplemented("GetPaymentAccountFlowRateLimit")
+}
+
+func (f *Fake) GetProposal(context.Context, uint64) (*govTypesV1.Proposal, error) {
+	return nil, notImplemented("GetProposal")
+}
+
+func (f *Fake) GetQuotaUpdateTime(context.Context, string) (int64, error) {
+	return 0, notImplemented("GetQuotaUpdateTime")
+}
+
+func (f *Fake) GetRPCEndpointsStatus() ([]client.RPCEndpointStatus, error) {
+	return nil, notImplemented("GetRPCEndpointsStatus")
+}
+
+func (f *Fake) GetRecommendedVirtualGroupFamilyIDBySPID(context.Context, uint32) (uint32, error) {
+	return 0, notImplemented("GetRecommendedVirtualGroupFamilyIDBySPID")
+}
+
+func (f *Fake) GetStatus(context.Context) (*ctypes.ResultStatus, error) {
+	return nil, notImplemented("GetStatus")
+}
+
+func (f *Fake) GetStoragePrice(context.Context, string) (*spTypes.SpStoragePrice, error) {
+	return nil, notImplemented("GetStoragePrice")
+}
+
+func (f *Fake) GetStorageProviderInfo(context.Context, sdk.AccAddress) (*spTypes.StorageProvider, error) {
+	return nil, notImplemented("GetStorageProviderInfo")
+}
+
+func (f *Fake) GetStorageProvidersHealth() []types.SPHealthScore {
+	panic(notImplemented("GetStorageProvidersHealth"))
+}
+
+func (f *Fake) GetStreamRecord(context.Context, string) (*paymentTypes.StreamRecord, error) {
+	return nil, notImplemented("GetStreamRecord")
+}
+
+func (f *Fake) GetSyncing(context.Context) (bool, error) {
+	return false, notImplemented("GetSyncing")
+}
+
+func (f *Fake) GetValidatorSet(context.Context) (int64, []*bfttypes.Validator, error) {
+	return 0, nil, notImplemented("GetValidatorSet")
+}
+
+func (f *Fake) GetValidatorsByHeight(context.Context, int64) ([]*bfttypes.Validator, error) {
+	return nil, notImplemented("GetValidatorsByHeight")
+}
+
+func (f *Fake) GrantAllowance(context.Context, string, feegranttypes.FeeAllowanceI, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("GrantAllowance")
+}
+
+func (f *Fake) GrantBasicAllowance(context.Context, string, sdkmath.Int, *time.Time, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("GrantBasicAllowance")
+}
+
+func (f *Fake) GrantDelegationForValidator(context.Context, sdkmath.Int, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("GrantDelegationForValidator")
+}
+
+func (f *Fake) GrantDepositForStorageProvider(context.Context, string, sdkmath.Int, types.GrantDepositForStorageProviderOptions) (string, error) {
+	return "", notImplemented("GrantDepositForStorageProvider")
+}
+
+func (f *Fake) ImpeachValidator(context.Context, string, sdkmath.Int, string, string, string, gnfdSdkTypes.TxOption) (uint64, string, error) {
+	return 0, "", notImplemented("ImpeachValidator")
+}
+
+func (f *Fake) InturnAttestationSubmitter(context.Context, *challengeTypes.QueryInturnAttestationSubmitterRequest) (*challengeTypes.QueryInturnAttestationSubmitterResponse, error) {
+	return nil, notImplemented("InturnAttestationSubmitter")
+}
+
+func (f *Fake) InvalidateBucketCache(string) {
+	panic(notImplemented("InvalidateBucketCache"))
+}
+
+func (f *Fake) LatestAttestedChallenges(context.Context, *challengeTypes.QueryLatestAttestedChallengesRequest) (*challengeTypes.QueryLatestAttestedChallengesResponse, error) {
+	return nil, notImplemented("LatestAttestedChallenges")
+}
+
+func (f *Fake) ListBucketReadRecord(context.Context, string, types.ListReadRecordOptions) (types.QuotaRecordInfo, error) {
+	return types.QuotaRecordInfo{}, notImplemented("ListBucketReadRecord")
+}
+
+func (f *Fake) ListBucketsByBucketID(context.Context, []uint64, types.EndPointOptions) (types.ListBucketsByBucketIDResponse, error) {
+	return types.ListBucketsByBucketIDResponse{}, notImplemented("ListBucketsByBucketID")
+}
+
+func (f *Fake) ListBucketsByPaymentAccount(context.Context, string, types.ListBucketsByPaymentAccountOptions) (types.ListBucketsByPaymentAccountResult, error) {
+	return types.ListBucketsByPaymentAccountResult{}, notImplemented("ListBucketsByPaymentAccount")
+}
+
+func (f *Fake) ListGroup(context.Context, string, string, types.ListGroupsOptions) (types.ListGroupsResult, error) {
+	return types.ListGroupsResult{}, notImplemented("ListGroup")
+}
+
+func (f *Fake) ListGroupsByGroupID(context.Context, []uint64, types.EndPointOptions) (types.ListGroupsByGroupIDResponse, error) {
+	return types.ListGroupsByGroupIDResponse{}, notImplemented("ListGroupsByGroupID")
+}
+
+func (f *Fake) ListObjectPolicies(context.Context, string, string, uint32, types.ListObjectPoliciesOptions) (types.ListObjectPoliciesResponse, error) {
+	return types.ListObjectPoliciesResponse{}, notImplemented("ListObjectPolicies")
+}
+
+func (f *Fake) ListObjectsByObjectID(context.Context, []uint64, types.EndPointOptions) (types.ListObjectsByObjectIDResponse, error) {
+	return types.ListObjectsByObjectIDResponse{}, notImplemented("ListObjectsByObjectID")
+}
+
+func (f *Fake) ListStorageProviders(context.Context, bool) ([]spTypes.StorageProvider, error) {
+	return nil, notImplemented("ListStorageProviders")
+}
+
+func (f *Fake) ListUserPublicKeyV2(string, string) ([]string, error) {
+	return nil, notImplemented("ListUserPublicKeyV2")
+}
+
+func (f *Fake) ListValidators(context.Context, string) (*stakingtypes.QueryValidatorsResponse, error) {
+	return nil, notImplemented("ListValidators")
+}
+
+func (f *Fake) MigrateBucket(context.Context, string, uint32, types.MigrateBucketOptions) (string, error) {
+	return "", notImplemented("MigrateBucket")
+}
+
+func (f *Fake) MirrorBucket(context.Context, sdk.ChainID, sdkmath.Uint, string, gnfdSdkTypes.TxOption) (*sdk.TxResponse, error) {
+	return nil, notImplemented("MirrorBucket")
+}
+
+func (f *Fake) MirrorGroup(context.Context, sdk.ChainID, sdkmath.Uint, string, gnfdSdkTypes.TxOption) (*sdk.TxResponse, error) {
+	return nil, notImplemented("MirrorGroup")
+}
+
+func (f *Fake) MirrorObject(context.Context, sdk.ChainID, sdkmath.Uint, string, string, gnfdSdkTypes.TxOption) (*sdk.TxResponse, error) {
+	return nil, notImplemented("MirrorObject")
+}
+
+func (f *Fake) MultiTransfer(context.Context, []types.TransferDetail, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("MultiTransfer")
+}
+
+func (f *Fake) OffChainAuthSign([]byte) string {
+	panic(notImplemented("OffChainAuthSign"))
+}
+
+func (f *Fake) OffChainAuthSignV2([]byte) string {
+	panic(notImplemented("OffChainAuthSignV2"))
+}
+
+func (f *Fake) ProbeStorageProviders(context.Context) error {
+	return notImplemented("ProbeStorageProviders")
+}
+
+func (f *Fake) QueryAllowance(context.Context, string, string) (*feegranttypes.Grant, error) {
+	return nil, notImplemented("QueryAllowance")
+}
+
+func (f *Fake) QueryAllowances(context.Context, string) ([]*feegranttypes.Grant, error) {
+	return nil, notImplemented("QueryAllowances")
+}
+
+func (f *Fake) QueryBasicAllowance(context.Context, string, string) (*feegranttypes.BasicAllowance, error) {
+	return nil, notImplemented("QueryBasicAllowance")
+}
+
+func (f *Fake) QuerySpAvailableGlobalVirtualGroupFamilies(context.Context, uint32) ([]uint32, error) {
+	return nil, notImplemented("QuerySpAvailableGlobalVirtualGroupFamilies")
+}
+
+func (f *Fake) QuerySpOptimalGlobalVirtualGroupFamily(context.Context, uint32, virtualGroupTypes.PickVGFStrategy) (uint32, error) {
+	return 0, notImplemented("QuerySpOptimalGlobalVirtualGroupFamily")
+}
+
+func (f *Fake) QueryVirtualGroupFamily(context.Context, uint32) (*virtualGroupTypes.GlobalVirtualGroupFamily, error) {
+	return nil, notImplemented("QueryVirtualGroupFamily")
+}
+
+func (f *Fake) QueryVirtualGroupParams(context.Context) (*virtualGroupTypes.Params, error) {
+	return nil, notImplemented("QueryVirtualGroupParams")
+}
+
+func (f *Fake) QueryVote(context.Context, int, []byte) (*ctypes.ResultQueryVote, error) {
+	return nil, notImplemented("QueryVote")
+}
+
+func (f *Fake) RegisterEDDSAPublicKey(string, string) (string, error) {
+	return "", notImplemented("RegisterEDDSAPublicKey")
+}
+
+func (f *Fake) RegisterEDDSAPublicKeyV2(string) (string, error) {
+	return "", notImplemented("RegisterEDDSAPublicKeyV2")
+}
+
+func (f *Fake) RevokeAllowance(context.Context, string, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("RevokeAllowance")
+}
+
+func (f *Fake) ScanHDWalletAccounts(context.Context, *types.HDWallet, types.ScanHDWalletOptions) ([]*types.Account, error) {
+	return nil, notImplemented("ScanHDWalletAccounts")
+}
+
+func (f *Fake) SetBucketFlowRateLimit(context.Context, string, sdk.AccAddress, sdk.AccAddress, sdkmath.Int, types.SetBucketFlowRateLimitOption) (string, error) {
+	return "", notImplemented("SetBucketFlowRateLimit")
+}
+
+func (f *Fake) SetTag(context.Context, string, storageTypes.ResourceTags, types.SetTagsOptions) (string, error) {
+	return "", notImplemented("SetTag")
+}
+
+func (f *Fake) SetWithdrawAddress(context.Context, string, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("SetWithdrawAddress")
+}
+
+func (f *Fake) SimulateRawTx(context.Context, []byte, ...grpc.CallOption) (*tx.SimulateResponse, error) {
+	return nil, notImplemented("SimulateRawTx")
+}
+
+func (f *Fake) SimulateTx(context.Context, []sdk.Msg, gnfdSdkTypes.TxOption, ...grpc.CallOption) (*tx.SimulateResponse, error) {
+	return nil, notImplemented("SimulateTx")
+}
+
+func (f *Fake) SubmitChallenge(context.Context, string, string, string, string, bool, uint32, gnfdSdkTypes.TxOption) (*sdk.TxResponse, error) {
+	return nil, notImplemented("SubmitChallenge")
+}
+
+func (f *Fake) SubmitProposal(context.Context, []sdk.Msg, sdkmath.Int, string, string, types.SubmitProposalOptions) (uint64, string, error) {
+	return 0, "", notImplemented("SubmitProposal")
+}
+
+func (f *Fake) SyncGroupMembers(context.Context, string, string, []types.DesiredMember, types.SyncGroupMembersOptions) (*types.GroupMemberSyncReport, error) {
+	return nil, notImplemented("SyncGroupMembers")
+}
+
+func (f *Fake) ToggleSPAsDelegatedAgent(context.Context, string, types.UpdateBucketOptions) (string, error) {
+	return "", notImplemented("ToggleSPAsDelegatedAgent")
+}
+
+func (f *Fake) Transfer(context.Context, string, sdkmath.Int, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("Transfer")
+}
+
+func (f *Fake) TransferOut(context.Context, string, sdkmath.Int, gnfdSdkTypes.TxOption) (*sdk.TxResponse, error) {
+	return nil, notImplemented("TransferOut")
+}
+
+func (f *Fake) UnJailValidator(context.Context, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("UnJailValidator")
+}
+
+func (f *Fake) Undelegate(context.Context, string, sdkmath.Int, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("Undelegate")
+}
+
+func (f *Fake) UpdateObjectContent(context.Context, string, string, io.Reader, types.UpdateObjectOptions) (string, error) {
+	return "", notImplemented("UpdateObjectContent")
+}
+
+func (f *Fake) UpdateSpStatus(context.Context, string, spTypes.Status, int64, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("UpdateSpStatus")
+}
+
+func (f *Fake) UpdateSpStoragePrice(context.Context, string, sdkmath.LegacyDec, sdkmath.LegacyDec, uint64, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("UpdateSpStoragePrice")
+}
+
+func (f *Fake) VoteProposal(context.Context, uint64, govTypesV1.VoteOption, types.VoteProposalOptions) (string, error) {
+	return "", notImplemented("VoteProposal")
+}
+
+func (f *Fake) WaitForBlockHeight(context.Context, int64) error {
+	return notImplemented("WaitForBlockHeight")
+}
+
+func (f *Fake) WaitForNBlocks(context.Context, int64) error {
+	return notImplemented("WaitForNBlocks")
+}
+
+func (f *Fake) WaitForNextBlock(context.Context) error {
+	return notImplemented("WaitForNextBlock")
+}
+
+func (f *Fake) WaitForTx(context.Context, string) (*ctypes.ResultTx, error) {
+	return nil, notImplemented("WaitForTx")
+}
+
+func (f *Fake) Withdraw(context.Context, string, sdkmath.Int, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("Withdraw")
+}
+
+func (f *Fake) WithdrawDelegatorReward(context.Context, string, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("WithdrawDelegatorReward")
+}
+
+func (f *Fake) WithdrawValidatorCommission(context.Context, gnfdSdkTypes.TxOption) (string, error) {
+	return "", notImplemented("WithdrawValidatorCommission")
+}
+
+func (f *Fake) putObjectResumable(context.Context, string, string, int64, io.Reader, types.PutObjectOptions) error {
+	return notImplemented("putObjectResumable")
+}
@@ -0,0 +1,307 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/utils"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// GrantManager - Share objects for a limited time and clean up the leftovers on chain after the grants expire.
+//
+// A grant is either an object policy expiring at the end of the ttl, or a group membership expiring at the end of the
+// ttl. The chain stops honoring them after they expire, but the expired policies and members are kept on chain until
+// they are deleted, so the grants are recorded in a registry and SweepExpiredGrants deletes them afterwards.
+type GrantManager struct {
+	client   IClient
+	registry types.GrantRegistry
+	opts     types.GrantManagerOptions
+}
+
+// NewGrantManager - Create a grant manager recording the grants in the registry.
+//
+// - client: The client putting and deleting the policies and the group members.
+//
+// - registry: The registry recording the grants, e.g. types.NewFileGrantRegistry to sweep the grants in a cron job.
+//
+// - opts: The options of the grant manager, the objects are shared by opts.Account or the default account of the client.
+//
+// - ret: The new grant manager.
+func NewGrantManager(client IClient, registry types.GrantRegistry, opts types.GrantManagerOptions) *GrantManager {
+	return &GrantManager{client: client, registry: registry, opts: opts}
+}
+
+// ShareObject - Grant the actions on the object to the principal with an object policy expiring after the ttl, and
+// record the grant in the registry. The policy of the principal on the object is replaced.
+//
+// - ctx: Context variables for the current API call.
+//
+// - bucketName: The bucket name identifies the bucket.
+//
+// - objectName: The object name identifies the object.
+//
+// - principalAddr: The HEX-encoded string of the account address to share the object with.
+//
+// - actions: The actions granted, e.g. ACTION_GET_OBJECT.
+//
+// - ttl: The duration of the grant.
+//
+// - ret1: The recorded grant.
+//
+// - ret2: Return error when the policy can not be put or the grant can not be recorded, otherwise return nil.
+func (m *GrantManager) ShareObject(ctx context.Context, bucketName, objectName, principalAddr string, actions []permTypes.ActionType,
+	ttl time.Duration,
+) (*types.Grant, error) {
+	if ttl <= 0 {
+		return nil, fmt.Errorf("the ttl of the grant should be positive, got %s", ttl)
+	}
+	if len(actions) == 0 {
+		return nil, errors.New("no actions are granted")
+	}
+	principal, err := accountPrincipal(principalAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+	statement := utils.NewStatement(actions, permTypes.EFFECT_ALLOW, nil, types.NewStatementOptions{})
+	txHash, err := m.client.PutObjectPolicy(ctx, bucketName, objectName, principal, []*permTypes.Statement{&statement},
+		types.PutPolicyOption{TxOpts: m.opts.TxOpts, Account: m.opts.Account, PolicyExpireTime: &expiresAt})
+	if err != nil {
+		return nil, err
+	}
+
+	actionNames := make([]string, 0, len(actions))
+	for _, action := range actions {
+		actionNames = append(actionNames, action.String())
+	}
+	return m.record(&types.Grant{
+		ID:        txHash,
+		Kind:      types.GrantKindPolicy,
+		Bucket:    bucketName,
+		Object:    objectName,
+		Principal: principalAddr,
+		Actions:   actionNames,
+		TxHash:    txHash,
+		CreatedAt: now,
+		ExpiresAt: expiresAt,
+	})
+}
+
+// ShareObjectByGroup - Grant the object to the principal with a membership of the group expiring after the ttl, and
+// record the grant in the registry. The permissions on the object are decided by the policies of the group, which
+// should have been put before. The group is owned by the sharing account.
+//
+// If the principal is a member of the group already, the membership is renewed to expire after the ttl unless it
+// expires later. Such a membership is not removed by the sweep, unless it was added by another grant of the registry.
+//
+// - ctx: Context variables for the current API call.
+//
+// - bucketName: The bucket name identifies the bucket.
+//
+// - objectName: The object name identifies the object.
+//
+// - groupName: The name of the group granted the permissions on the object.
+//
+// - principalAddr: The HEX-encoded string of the account address to be added to the group.
+//
+// - ttl: The duration of the grant.
+//
+// - ret1: The recorded grant.
+//
+// - ret2: Return error when the member can not be added or the grant can not be recorded, otherwise return nil.
+func (m *GrantManager) ShareObjectByGroup(ctx context.Context, bucketName, objectName, groupName, principalAddr string,
+	ttl time.Duration,
+) (*types.Grant, error) {
+	if ttl <= 0 {
+		return nil, fmt.Errorf("the ttl of the grant should be positive, got %s", ttl)
+	}
+	if _, err := sdk.AccAddressFromHexUnsafe(principalAddr); err != nil {
+		return nil, err
+	}
+	owner, err := m.owner()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+	member, err := m.client.GetGroupMember(ctx, groupName, owner, principalAddr)
+	if err != nil && !types.IsNotFound(err) {
+		return nil, err
+	}
+	var (
+		txHash         string
+		existingMember bool
+	)
+	if err == nil {
+		// the membership is not shortened by the grant
+		expiration := expiresAt
+		if member.ExpirationTime == nil {
+			expiration = storageTypes.MaxTimeStamp
+		} else if member.ExpirationTime.After(expiresAt) {
+			expiration = *member.ExpirationTime
+		}
+		if existingMember, err = m.isExistingMember(groupName, owner, principalAddr); err != nil {
+			return nil, err
+		}
+		txHash, err = m.client.RenewGroupMember(ctx, owner, groupName, []string{principalAddr},
+			types.RenewGroupMemberOption{TxOpts: m.opts.TxOpts, Account: m.opts.Account, ExpirationTime: []*time.Time{&expiration}})
+	} else {
+		txHash, err = m.client.UpdateGroupMember(ctx, groupName, owner, []string{principalAddr}, nil,
+			types.UpdateGroupMemberOption{TxOpts: m.opts.TxOpts, Account: m.opts.Account, ExpirationTime: []*time.Time{&expiresAt}})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m.record(&types.Grant{
+		ID:             txHash,
+		Kind:           types.GrantKindGroupMember,
+		Bucket:         bucketName,
+		Object:         objectName,
+		Principal:      principalAddr,
+		Group:          groupName,
+		GroupOwner:     owner,
+		ExistingMember: existingMember,
+		TxHash:         txHash,
+		CreatedAt:      now,
+		ExpiresAt:      expiresAt,
+	})
+}
+
+// isExistingMember returns whether the member of the group was added outside the grant manager, i.e. no grant in the
+// registry added it.
+func (m *GrantManager) isExistingMember(groupName, groupOwner, principalAddr string) (bool, error) {
+	grants, err := m.registry.List()
+	if err != nil {
+		return false, err
+	}
+	for _, grant := range grants {
+		if grant.Kind == types.GrantKindGroupMember && !grant.ExistingMember && grant.Group == groupName &&
+			grant.GroupOwner == groupOwner && grant.Principal == principalAddr {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// SweepExpiredGrants - Delete the expired policies and group members of the recorded grants on chain, and remove the
+// grants from the registry. A policy or a member is kept if it has been renewed by another unexpired grant, or its
+// expiration time on chain has been extended, and a member is kept if it was a member before the grant.
+//
+// - ctx: Context variables for the current API call.
+//
+// - ret1: The swept grants.
+//
+// - ret2: Return the errors of the grants failed to be swept, they are kept in the registry to be swept next time.
+func (m *GrantManager) SweepExpiredGrants(ctx context.Context) ([]*types.Grant, error) {
+	grants, err := m.registry.List()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var (
+		swept []*types.Grant
+		errs  []error
+	)
+	for _, grant := range grants {
+		if !grant.Expired(now) {
+			continue
+		}
+		if err = m.sweep(ctx, grant, grants, now); err != nil {
+			errs = append(errs, fmt.Errorf("fail to sweep the grant %s: %w", grant.ID, err))
+			continue
+		}
+		if err = m.registry.Delete(grant.ID); err != nil {
+			errs = append(errs, fmt.Errorf("fail to remove the grant %s from the registry: %w", grant.ID, err))
+			continue
+		}
+		swept = append(swept, grant)
+	}
+	return swept, errors.Join(errs...)
+}
+
+func (m *GrantManager) sweep(ctx context.Context, grant *types.Grant, grants []*types.Grant, now time.Time) error {
+	for _, other := range grants {
+		if !other.Expired(now) && other.Kind == grant.Kind && other.Principal == grant.Principal &&
+			(grant.Kind == types.GrantKindPolicy && other.Bucket == grant.Bucket && other.Object == grant.Object ||
+				grant.Kind == types.GrantKindGroupMember && other.Group == grant.Group && other.GroupOwner == grant.GroupOwner) {
+			return nil
+		}
+	}
+
+	switch grant.Kind {
+	case types.GrantKindPolicy:
+		policy, err := m.client.GetObjectPolicy(ctx, grant.Bucket, grant.Object, grant.Principal)
+		if types.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if policy.ExpirationTime == nil || policy.ExpirationTime.After(now) {
+			return nil
+		}
+		principal, err := accountPrincipal(grant.Principal)
+		if err != nil {
+			return err
+		}
+		_, err = m.client.DeleteObjectPolicy(ctx, grant.Bucket, grant.Object, principal,
+			types.DeletePolicyOption{TxOpts: m.opts.TxOpts, Account: m.opts.Account})
+		if types.IsNotFound(err) {
+			return nil
+		}
+		return err
+	case types.GrantKindGroupMember:
+		if grant.ExistingMember {
+			return nil
+		}
+		member, err := m.client.GetGroupMember(ctx, grant.Group, grant.GroupOwner, grant.Principal)
+		if types.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if member.ExpirationTime == nil || member.ExpirationTime.After(now) {
+			return nil
+		}
+		_, err = m.client.UpdateGroupMember(ctx, grant.Group, grant.GroupOwner, nil, []string{grant.Principal},
+			types.UpdateGroupMemberOption{TxOpts: m.opts.TxOpts, Account: m.opts.Account})
+		return err
+	default:
+		return fmt.Errorf("unknown kind %s of the grant", grant.Kind)
+	}
+}
+
+func (m *GrantManager) record(grant *types.Grant) (*types.Grant, error) {
+	if err := m.registry.Put(grant); err != nil {
+		return nil, fmt.Errorf("the grant has been made in the tx %s but can not be recorded: %w", grant.TxHash, err)
+	}
+	return grant, nil
+}
+
+func (m *GrantManager) owner() (string, error) {
+	if m.opts.Account != nil {
+		return m.opts.Account.GetAddress().String(), nil
+	}
+	account, err := m.client.GetDefaultAccount()
+	if err != nil {
+		return "", err
+	}
+	return account.GetAddress().String(), nil
+}
+
+func accountPrincipal(principalAddr string) (types.Principal, error) {
+	addr, err := sdk.AccAddressFromHexUnsafe(principalAddr)
+	if err != nil {
+		return "", err
+	}
+	return utils.NewPrincipalWithAccount(addr)
+}
@@ -0,0 +1,188 @@
+package client_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield-go-sdk/client"
+	"github.com/bnb-chain/greenfield-go-sdk/client/clienttest"
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/utils"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+const (
+	testBucketName = "test-bucket"
+	testObjectName = "shared.txt"
+	testGroupName  = "sharers"
+)
+
+func newAccount(t *testing.T, name string) *types.Account {
+	account, _, err := types.NewAccount(name)
+	require.NoError(t, err)
+	return account
+}
+
+// newSharingFake creates a Fake with the object and the group of the owner.
+func newSharingFake(t *testing.T, owner *types.Account) *clienttest.Fake {
+	ctx := context.Background()
+	fake := clienttest.New(owner)
+	_, err := fake.CreateBucket(ctx, testBucketName, "", types.CreateBucketOptions{Visibility: storageTypes.VISIBILITY_TYPE_PRIVATE})
+	require.NoError(t, err)
+	_, err = fake.CreateObject(ctx, testBucketName, testObjectName, strings.NewReader("shared"), types.CreateObjectOptions{})
+	require.NoError(t, err)
+	_, err = fake.CreateGroup(ctx, testGroupName, types.CreateGroupOptions{})
+	require.NoError(t, err)
+	return fake
+}
+
+func TestGrantManagerShareObjectByGroup(t *testing.T) {
+	ctx := context.Background()
+	owner, principal := newAccount(t, "owner"), newAccount(t, "principal")
+	principalAddr := principal.GetAddress().String()
+	now := time.Now()
+	soon, later := now.Add(time.Minute), now.Add(48*time.Hour)
+
+	tests := []struct {
+		name string
+		// setup makes the principal a member before the grant, the registry records the grants of the manager
+		setup          func(t *testing.T, fake *clienttest.Fake, registry types.GrantRegistry)
+		existingMember bool
+		// expiration is the expiration time of the membership after the grant, nil means the end of the grant
+		expiration *time.Time
+	}{
+		{"not a member", func(*testing.T, *clienttest.Fake, types.GrantRegistry) {}, false, nil},
+		{"member never expiring", func(t *testing.T, fake *clienttest.Fake, _ types.GrantRegistry) {
+			_, err := fake.UpdateGroupMember(ctx, testGroupName, owner.GetAddress().String(), []string{principalAddr}, nil,
+				types.UpdateGroupMemberOption{})
+			require.NoError(t, err)
+		}, true, &storageTypes.MaxTimeStamp},
+		{"member expiring before the grant", func(t *testing.T, fake *clienttest.Fake, _ types.GrantRegistry) {
+			_, err := fake.UpdateGroupMember(ctx, testGroupName, owner.GetAddress().String(), []string{principalAddr}, nil,
+				types.UpdateGroupMemberOption{ExpirationTime: []*time.Time{&soon}})
+			require.NoError(t, err)
+		}, true, nil},
+		{"member expiring after the grant", func(t *testing.T, fake *clienttest.Fake, _ types.GrantRegistry) {
+			_, err := fake.UpdateGroupMember(ctx, testGroupName, owner.GetAddress().String(), []string{principalAddr}, nil,
+				types.UpdateGroupMemberOption{ExpirationTime: []*time.Time{&later}})
+			require.NoError(t, err)
+		}, true, &later},
+		{"member added by another grant", func(t *testing.T, fake *clienttest.Fake, registry types.GrantRegistry) {
+			_, err := client.NewGrantManager(fake, registry, types.GrantManagerOptions{}).
+				ShareObjectByGroup(ctx, testBucketName, testObjectName, testGroupName, principalAddr, time.Minute)
+			require.NoError(t, err)
+		}, false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, registry := newSharingFake(t, owner), types.NewMemoryGrantRegistry()
+			tt.setup(t, fake, registry)
+			manager := client.NewGrantManager(fake, registry, types.GrantManagerOptions{})
+
+			grant, err := manager.ShareObjectByGroup(ctx, testBucketName, testObjectName, testGroupName, principalAddr, 24*time.Hour)
+			require.NoError(t, err)
+			require.Equal(t, tt.existingMember, grant.ExistingMember)
+			require.Equal(t, owner.GetAddress().String(), grant.GroupOwner)
+			member, err := fake.GetGroupMember(ctx, testGroupName, owner.GetAddress().String(), principalAddr)
+			require.NoError(t, err)
+			expiration := grant.ExpiresAt
+			if tt.expiration != nil {
+				expiration = *tt.expiration
+			}
+			require.True(t, expiration.Equal(*member.ExpirationTime), "the member expires at %s", member.ExpirationTime)
+
+			grants, err := registry.List()
+			require.NoError(t, err)
+			require.Contains(t, grants, grant)
+		})
+	}
+}
+
+func TestGrantManagerSweepExpiredGrants(t *testing.T) {
+	ctx := context.Background()
+	owner, principal := newAccount(t, "owner"), newAccount(t, "principal")
+	ownerAddr, principalAddr := owner.GetAddress().String(), principal.GetAddress().String()
+	now := time.Now()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	putPolicy := func(expiration time.Time) func(*testing.T, *clienttest.Fake) {
+		return func(t *testing.T, fake *clienttest.Fake) {
+			principalValue, err := utils.NewPrincipalWithAccount(principal.GetAddress())
+			require.NoError(t, err)
+			statement := utils.NewStatement([]permTypes.ActionType{permTypes.ACTION_GET_OBJECT}, permTypes.EFFECT_ALLOW, nil, types.NewStatementOptions{})
+			_, err = fake.PutObjectPolicy(ctx, testBucketName, testObjectName, principalValue, []*permTypes.Statement{&statement},
+				types.PutPolicyOption{PolicyExpireTime: &expiration})
+			require.NoError(t, err)
+		}
+	}
+	addMember := func(expiration time.Time) func(*testing.T, *clienttest.Fake) {
+		return func(t *testing.T, fake *clienttest.Fake) {
+			_, err := fake.UpdateGroupMember(ctx, testGroupName, ownerAddr, []string{principalAddr}, nil,
+				types.UpdateGroupMemberOption{ExpirationTime: []*time.Time{&expiration}})
+			require.NoError(t, err)
+		}
+	}
+	policyGrant := func(id string, expiresAt time.Time) *types.Grant {
+		return &types.Grant{ID: id, Kind: types.GrantKindPolicy, Bucket: testBucketName, Object: testObjectName,
+			Principal: principalAddr, TxHash: id, ExpiresAt: expiresAt}
+	}
+	memberGrant := func(id string, expiresAt time.Time, existingMember bool) *types.Grant {
+		return &types.Grant{ID: id, Kind: types.GrantKindGroupMember, Bucket: testBucketName, Object: testObjectName,
+			Principal: principalAddr, Group: testGroupName, GroupOwner: ownerAddr, ExistingMember: existingMember, TxHash: id, ExpiresAt: expiresAt}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*testing.T, *clienttest.Fake)
+		grants []*types.Grant
+		swept  []string
+		// kept is whether the policy or the membership is still on chain after the sweep
+		kept bool
+	}{
+		{"expired policy", putPolicy(past), []*types.Grant{policyGrant("a", past)}, []string{"a"}, false},
+		{"unexpired policy", putPolicy(future), []*types.Grant{policyGrant("a", future)}, nil, true},
+		{"policy extended on chain", putPolicy(future), []*types.Grant{policyGrant("a", past)}, []string{"a"}, true},
+		{"policy renewed by another grant", putPolicy(future), []*types.Grant{policyGrant("a", past), policyGrant("b", future)}, []string{"a"}, true},
+		{"policy deleted already", func(*testing.T, *clienttest.Fake) {}, []*types.Grant{policyGrant("a", past)}, []string{"a"}, false},
+		{"expired member", addMember(past), []*types.Grant{memberGrant("a", past, false)}, []string{"a"}, false},
+		{"member extended on chain", addMember(future), []*types.Grant{memberGrant("a", past, false)}, []string{"a"}, true},
+		{"member renewed by another grant", addMember(future), []*types.Grant{memberGrant("a", past, false), memberGrant("b", future, true)}, []string{"a"}, true},
+		{"member before the grant", addMember(past), []*types.Grant{memberGrant("a", past, true)}, []string{"a"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, registry := newSharingFake(t, owner), types.NewMemoryGrantRegistry()
+			tt.setup(t, fake)
+			for _, grant := range tt.grants {
+				require.NoError(t, registry.Put(grant))
+			}
+
+			swept, err := client.NewGrantManager(fake, registry, types.GrantManagerOptions{}).SweepExpiredGrants(ctx)
+			require.NoError(t, err)
+			var sweptIDs []string
+			for _, grant := range swept {
+				sweptIDs = append(sweptIDs, grant.ID)
+			}
+			require.Equal(t, tt.swept, sweptIDs)
+			remained, err := registry.List()
+			require.NoError(t, err)
+			require.Len(t, remained, len(tt.grants)-len(tt.swept))
+
+			if tt.grants[0].Kind == types.GrantKindPolicy {
+				_, err = fake.GetObjectPolicy(ctx, testBucketName, testObjectName, principalAddr)
+			} else {
+				_, err = fake.GetGroupMember(ctx, testGroupName, ownerAddr, principalAddr)
+			}
+			if tt.kept {
+				require.NoError(t, err)
+			} else {
+				require.True(t, types.IsNotFound(err), "unexpected error: %v", err)
+			}
+		})
+	}
+}
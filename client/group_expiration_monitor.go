@@ -0,0 +1,236 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// GroupExpirationMonitor - Surface the group members which are about to expire, and renew them according to the rules.
+//
+// The chain stops honoring a member once its membership expires, without any notice, so the monitor pages through
+// the groups of the owner and their members, emits an event for every member expiring within the window or expired,
+// and renews the members matched by the rules before they lose access.
+type GroupExpirationMonitor struct {
+	client IClient
+	opts   types.GroupExpirationMonitorOptions
+}
+
+// NewGroupExpirationMonitor - Create a monitor of the groups owned by opts.Owner.
+//
+// - client: The client listing the groups and the members, and renewing the members.
+//
+// - opts: The options of the monitor, including the window, the renewal rules and the callback receiving the events.
+//
+// - ret: The new monitor.
+func NewGroupExpirationMonitor(client IClient, opts types.GroupExpirationMonitorOptions) *GroupExpirationMonitor {
+	if opts.Window <= 0 {
+		opts.Window = types.DefaultGroupExpirationWindow
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = types.DefaultGroupExpirationInterval
+	}
+	return &GroupExpirationMonitor{client: client, opts: opts}
+}
+
+// Run - Check the expirations every opts.Interval until the context is done, the first check starts immediately.
+//
+// - ctx: Context variables for the monitor, the monitor stops when it is done.
+//
+// - ret: Return the error of the context when it is done.
+func (m *GroupExpirationMonitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.opts.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := m.Check(ctx); err != nil {
+			log.Error().Msg(fmt.Sprintf("fail to check the group member expirations: %s", err))
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check - Check the expirations once, emit the events of the expiring and expired members, and renew the members
+// matched by the rules. A member is renewed only if the renewal extends its expiration time.
+//
+// - ctx: Context variables for the current API call.
+//
+// - ret1: The members expiring within the window or expired, in the order of the group ids and the member addresses.
+//
+// - ret2: Return error when the groups or the members can not be listed, or the members failed to be renewed.
+func (m *GroupExpirationMonitor) Check(ctx context.Context) ([]types.GroupMemberExpiration, error) {
+	for _, rule := range m.opts.Rules {
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	owner, err := m.owner()
+	if err != nil {
+		return nil, err
+	}
+	groups, err := m.listGroups(ctx, owner)
+	if err != nil {
+		m.emit(types.GroupExpirationEvent{Type: types.GroupExpirationEventCheckFailed, Err: err})
+		return nil, err
+	}
+
+	now := time.Now()
+	deadline := now.Add(m.opts.Window)
+	var (
+		expirations []types.GroupMemberExpiration
+		errs        []error
+	)
+	for _, group := range groups {
+		members, err := listGroupMemberExpirations(ctx, m.client, group.Id)
+		if err != nil {
+			err = fmt.Errorf("fail to list the members of the group %s: %w", group.GroupName, err)
+			m.emit(types.GroupExpirationEvent{Type: types.GroupExpirationEventCheckFailed, Err: err})
+			errs = append(errs, err)
+			continue
+		}
+		addresses := make([]string, 0, len(members))
+		for member := range members {
+			addresses = append(addresses, member)
+		}
+		sort.Strings(addresses)
+
+		var expiring []types.GroupMemberExpiration
+		for _, member := range addresses {
+			expiration := members[member]
+			if expiration.Equal(storageTypes.MaxTimeStamp) || !expiration.Before(deadline) {
+				continue
+			}
+			expiring = append(expiring, types.GroupMemberExpiration{
+				GroupOwner:     owner,
+				GroupName:      group.GroupName,
+				GroupID:        group.Id.String(),
+				Member:         member,
+				ExpirationTime: expiration,
+			})
+		}
+		for _, member := range expiring {
+			eventType := types.GroupExpirationEventExpiring
+			if !member.ExpirationTime.After(now) {
+				eventType = types.GroupExpirationEventExpired
+			}
+			m.emit(types.GroupExpirationEvent{Type: eventType, Member: member})
+		}
+		if err = m.renew(ctx, expiring, now); err != nil {
+			errs = append(errs, err)
+		}
+		expirations = append(expirations, expiring...)
+	}
+	return expirations, errors.Join(errs...)
+}
+
+// listGroups pages through the groups of the owner, the removed groups are skipped.
+func (m *GroupExpirationMonitor) listGroups(ctx context.Context, owner string) ([]*storageTypes.GroupInfo, error) {
+	var groups []*storageTypes.GroupInfo
+	startAfter := ""
+	for {
+		result, err := m.client.ListGroupsByOwner(ctx, types.GroupsOwnerPaginationOptions{
+			Limit:      maxListLimit,
+			StartAfter: startAfter,
+			Owner:      owner,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail to list the groups of %s: %w", owner, err)
+		}
+		for _, group := range result.Groups {
+			if group.Group != nil && !group.Removed {
+				groups = append(groups, group.Group)
+			}
+		}
+		if len(result.Groups) < maxListLimit || result.Groups[len(result.Groups)-1].Group == nil {
+			return groups, nil
+		}
+		startAfter = result.Groups[len(result.Groups)-1].Group.Id.String()
+	}
+}
+
+// renew renews the expiring members of one group matched by the rules, at most storageTypes.MaxGroupMemberLimitOnce
+// members in one tx. The first matching rule decides the new expiration time of a member.
+func (m *GroupExpirationMonitor) renew(ctx context.Context, expiring []types.GroupMemberExpiration, now time.Time) error {
+	var (
+		members     []types.GroupMemberExpiration
+		expirations []*time.Time
+	)
+	for _, member := range expiring {
+		for _, rule := range m.opts.Rules {
+			if !rule.Matches(member.GroupName, member.Member) {
+				continue
+			}
+			if until := now.Add(rule.Duration); until.After(member.ExpirationTime) {
+				members = append(members, member)
+				expirations = append(expirations, &until)
+			}
+			break
+		}
+	}
+
+	var errs []error
+	for start := 0; start < len(members); start += storageTypes.MaxGroupMemberLimitOnce {
+		end := start + storageTypes.MaxGroupMemberLimitOnce
+		if end > len(members) {
+			end = len(members)
+		}
+		addresses := make([]string, 0, end-start)
+		for _, member := range members[start:end] {
+			addresses = append(addresses, member.Member)
+		}
+		txHash, err := m.client.RenewGroupMember(ctx, members[start].GroupOwner, members[start].GroupName, addresses,
+			types.RenewGroupMemberOption{TxOpts: m.opts.TxOpts, Account: m.opts.Account, ExpirationTime: expirations[start:end]})
+		for i, member := range members[start:end] {
+			if err != nil {
+				m.emit(types.GroupExpirationEvent{Type: types.GroupExpirationEventRenewFailed, Member: member, Err: err})
+			} else {
+				m.emit(types.GroupExpirationEvent{Type: types.GroupExpirationEventRenewed, Member: member,
+					RenewedUntil: *expirations[start+i], TxHash: txHash})
+			}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("fail to renew the members of the group %s: %w", members[start].GroupName, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// emit delivers the event to the callback, or logs it if there is no callback.
+func (m *GroupExpirationMonitor) emit(event types.GroupExpirationEvent) {
+	if m.opts.OnEvent != nil {
+		m.opts.OnEvent(event)
+		return
+	}
+	switch event.Type {
+	case types.GroupExpirationEventRenewed:
+		log.Info().Msg(event.String())
+	case types.GroupExpirationEventRenewFailed, types.GroupExpirationEventCheckFailed:
+		log.Error().Msg(event.String())
+	default:
+		log.Warn().Msg(event.String())
+	}
+}
+
+func (m *GroupExpirationMonitor) owner() (string, error) {
+	if m.opts.Owner != "" {
+		return m.opts.Owner, nil
+	}
+	if m.opts.Account != nil {
+		return m.opts.Account.GetAddress().String(), nil
+	}
+	account, err := m.client.GetDefaultAccount()
+	if err != nil {
+		return "", err
+	}
+	return account.GetAddress().String(), nil
+}
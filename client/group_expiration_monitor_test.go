@@ -0,0 +1,206 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield-go-sdk/client"
+	"github.com/bnb-chain/greenfield-go-sdk/client/clienttest"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// memberAddress returns the address of the i-th member of the tests.
+func memberAddress(i int) string {
+	addr, _ := sdk.AccAddressFromHexUnsafe(fmt.Sprintf("0x%040x", i+1))
+	return addr.String()
+}
+
+// addMembers adds the members to the group of the default account of the Fake, each with its expiration time.
+func addMembers(t *testing.T, fake *clienttest.Fake, owner *types.Account, groupName string, members map[string]time.Time) {
+	ctx := context.Background()
+	if _, err := fake.HeadGroup(ctx, groupName, owner.GetAddress().String()); types.IsNotFound(err) {
+		_, err = fake.CreateGroup(ctx, groupName, types.CreateGroupOptions{})
+		require.NoError(t, err)
+	}
+	for member, expiration := range members {
+		expiration := expiration
+		_, err := fake.UpdateGroupMember(ctx, groupName, owner.GetAddress().String(), []string{member}, nil,
+			types.UpdateGroupMemberOption{ExpirationTime: []*time.Time{&expiration}})
+		require.NoError(t, err)
+	}
+}
+
+func TestGroupExpirationMonitorCheck(t *testing.T) {
+	ctx := context.Background()
+	owner := newAccount(t, "owner")
+	now := time.Now().Truncate(time.Second)
+	expiring, expired, later := memberAddress(0), memberAddress(1), memberAddress(2)
+	permanent, guest := memberAddress(3), memberAddress(4)
+
+	tests := []struct {
+		name  string
+		rules []types.GroupRenewalRule
+		// renewed is how long each member is renewed for
+		renewed map[string]time.Duration
+	}{
+		{"no rules", nil, nil},
+		{"all the members of a group", []types.GroupRenewalRule{{GroupName: "team", Duration: 30 * 24 * time.Hour}},
+			map[string]time.Duration{expiring: 30 * 24 * time.Hour, expired: 30 * 24 * time.Hour}},
+		{"listed members", []types.GroupRenewalRule{{GroupName: "team", Members: []string{expired, guest}, Duration: 24 * time.Hour}},
+			map[string]time.Duration{expired: 24 * time.Hour}},
+		{"first matching rule", []types.GroupRenewalRule{
+			{GroupName: "guests", Duration: 2 * 24 * time.Hour},
+			{GroupName: "team", Members: []string{expired}, Duration: 24 * time.Hour},
+			{GroupName: "team", Duration: 30 * 24 * time.Hour},
+		}, map[string]time.Duration{guest: 2 * 24 * time.Hour, expired: 24 * time.Hour, expiring: 30 * 24 * time.Hour}},
+		// the renewal would shorten the membership of the expiring member, and the rule matching it stops the later rules
+		{"renewal not extending the expiration", []types.GroupRenewalRule{
+			{GroupName: "team", Members: []string{expiring}, Duration: time.Minute},
+			{GroupName: "team", Duration: 24 * time.Hour},
+		}, map[string]time.Duration{expired: 24 * time.Hour}},
+		{"rule of another group", []types.GroupRenewalRule{{GroupName: "others", Duration: 24 * time.Hour}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := clienttest.New(owner)
+			addMembers(t, fake, owner, "team", map[string]time.Time{
+				expiring:  now.Add(time.Hour),
+				expired:   now.Add(-time.Hour),
+				later:     now.Add(30 * 24 * time.Hour),
+				permanent: storageTypes.MaxTimeStamp,
+			})
+			addMembers(t, fake, owner, "guests", map[string]time.Time{guest: now.Add(2 * time.Hour)})
+			var events []types.GroupExpirationEvent
+			monitor := client.NewGroupExpirationMonitor(fake, types.GroupExpirationMonitorOptions{
+				Rules:   tt.rules,
+				OnEvent: func(event types.GroupExpirationEvent) { events = append(events, event) },
+			})
+
+			expirations, err := monitor.Check(ctx)
+			require.NoError(t, err)
+			var members []string
+			for _, expiration := range expirations {
+				members = append(members, expiration.GroupName+"/"+expiration.Member)
+			}
+			require.Equal(t, []string{"team/" + expiring, "team/" + expired, "guests/" + guest}, members)
+
+			renewed := make(map[string]bool)
+			for _, event := range events {
+				switch event.Type {
+				case types.GroupExpirationEventExpiring:
+					require.NotEqual(t, expired, event.Member.Member)
+				case types.GroupExpirationEventExpired:
+					require.Equal(t, expired, event.Member.Member)
+				case types.GroupExpirationEventRenewed:
+					renewed[event.Member.Member] = true
+					require.WithinDuration(t, time.Now().Add(tt.renewed[event.Member.Member]), event.RenewedUntil, time.Minute)
+					require.NotEmpty(t, event.TxHash)
+				default:
+					require.Fail(t, "unexpected event", event.String())
+				}
+			}
+			require.Len(t, renewed, len(tt.renewed))
+			for member, duration := range tt.renewed {
+				require.Contains(t, renewed, member)
+				groupName := "team"
+				if member == guest {
+					groupName = "guests"
+				}
+				groupMember, err := fake.GetGroupMember(ctx, groupName, owner.GetAddress().String(), member)
+				require.NoError(t, err)
+				require.WithinDuration(t, time.Now().Add(duration), *groupMember.ExpirationTime, time.Minute)
+			}
+		})
+	}
+}
+
+func TestGroupExpirationMonitorRenewInTxs(t *testing.T) {
+	ctx := context.Background()
+	owner := newAccount(t, "owner")
+	expiration := time.Now().Add(time.Hour)
+	members := make(map[string]time.Time)
+	for i := 0; i < 2*storageTypes.MaxGroupMemberLimitOnce+5; i++ {
+		members[memberAddress(i)] = expiration
+	}
+	renewFailure := errors.New("out of gas")
+
+	tests := []struct {
+		name      string
+		failedTxs int // the number of the renewal txs failing
+	}{
+		{"all the txs succeeding", 0},
+		{"first tx failing", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := clienttest.New(owner)
+			addMembers(t, fake, owner, "team", members)
+			if tt.failedTxs > 0 {
+				fake.InjectError("RenewGroupMember", renewFailure, tt.failedTxs)
+			}
+			counts := make(map[string]int)
+			monitor := client.NewGroupExpirationMonitor(fake, types.GroupExpirationMonitorOptions{
+				Rules:   []types.GroupRenewalRule{{GroupName: "team", Duration: 24 * time.Hour}},
+				OnEvent: func(event types.GroupExpirationEvent) { counts[event.Type]++ },
+			})
+
+			expirations, err := monitor.Check(ctx)
+			require.Len(t, expirations, len(members))
+			require.Equal(t, 3, fake.Calls("RenewGroupMember"))
+			failed := tt.failedTxs * storageTypes.MaxGroupMemberLimitOnce
+			if tt.failedTxs > 0 {
+				require.ErrorIs(t, err, renewFailure)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, len(members), counts[types.GroupExpirationEventExpiring])
+			require.Equal(t, len(members)-failed, counts[types.GroupExpirationEventRenewed])
+			require.Equal(t, failed, counts[types.GroupExpirationEventRenewFailed])
+		})
+	}
+}
+
+func TestGroupExpirationMonitorCheckFailed(t *testing.T) {
+	ctx := context.Background()
+	owner := newAccount(t, "owner")
+	listFailure := errors.New("connection refused")
+
+	tests := []struct {
+		name   string
+		setup  func(fake *clienttest.Fake)
+		rules  []types.GroupRenewalRule
+		events []string
+	}{
+		{"groups failing to be listed", func(fake *clienttest.Fake) {
+			fake.InjectError("ListGroupsByOwner", listFailure, 0)
+		}, nil, []string{types.GroupExpirationEventCheckFailed}},
+		{"members failing to be listed", func(fake *clienttest.Fake) {
+			fake.InjectError("ListGroupMembers", listFailure, 0)
+		}, nil, []string{types.GroupExpirationEventCheckFailed}},
+		{"invalid rule", func(*clienttest.Fake) {}, []types.GroupRenewalRule{{GroupName: "team"}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := clienttest.New(owner)
+			addMembers(t, fake, owner, "team", map[string]time.Time{memberAddress(0): time.Now().Add(time.Hour)})
+			tt.setup(fake)
+			var events []string
+			monitor := client.NewGroupExpirationMonitor(fake, types.GroupExpirationMonitorOptions{
+				Rules:   tt.rules,
+				OnEvent: func(event types.GroupExpirationEvent) { events = append(events, event.Type) },
+			})
+
+			expirations, err := monitor.Check(ctx)
+			require.Error(t, err)
+			require.Empty(t, expirations)
+			require.Equal(t, tt.events, events)
+		})
+	}
+}
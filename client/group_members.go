@@ -0,0 +1,554 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	gnfdSdkTypes "github.com/bnb-chain/greenfield/sdk/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// groupMemberDiff is the changes making the members of a group the desired ones.
+type groupMemberDiff struct {
+	toAdd     []*storageTypes.MsgGroupMember
+	toRenew   []*storageTypes.MsgGroupMember
+	toRemove  []sdk.AccAddress
+	unchanged int
+}
+
+// diffGroupMembers compares the current members and their expiration time with the desired ones, a desired member
+// without an expiration time never expires.
+func diffGroupMembers(current map[string]time.Time, desired []types.DesiredMember) (*groupMemberDiff, error) {
+	diff := &groupMemberDiff{}
+	desiredSet := make(map[string]bool, len(desired))
+	for _, member := range desired {
+		addr, err := sdk.AccAddressFromHexUnsafe(member.Address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid member %s: %w", member.Address, err)
+		}
+		if desiredSet[addr.String()] {
+			return nil, fmt.Errorf("the member %s is desired more than once", addr)
+		}
+		desiredSet[addr.String()] = true
+		expiration := &storageTypes.MaxTimeStamp
+		if member.Expiration != nil {
+			expiration = member.Expiration
+		}
+		msgMember := &storageTypes.MsgGroupMember{Member: addr.String(), ExpirationTime: expiration}
+		currentExpiration, ok := current[addr.String()]
+		switch {
+		case !ok:
+			diff.toAdd = append(diff.toAdd, msgMember)
+		case currentExpiration.Unix() != expiration.Unix():
+			diff.toRenew = append(diff.toRenew, msgMember)
+		default:
+			diff.unchanged++
+		}
+	}
+	members := make([]string, 0, len(current))
+	for member := range current {
+		members = append(members, member)
+	}
+	sort.Strings(members)
+	for _, member := range members {
+		if !desiredSet[member] {
+			diff.toRemove = append(diff.toRemove, sdk.MustAccAddressFromHex(member))
+		}
+	}
+	return diff, nil
+}
+
+func (d *groupMemberDiff) empty() bool {
+	return len(d.toAdd) == 0 && len(d.toRenew) == 0 && len(d.toRemove) == 0
+}
+
+// details describes the changes of the members, e.g. "add 0x... (expires 2025-01-01T00:00:00Z)".
+func (d *groupMemberDiff) details() []string {
+	var details []string
+	for _, member := range d.toAdd {
+		details = append(details, "add "+memberDescription(member))
+	}
+	for _, member := range d.toRenew {
+		details = append(details, "renew "+memberDescription(member))
+	}
+	for _, member := range d.toRemove {
+		details = append(details, "remove "+member.String())
+	}
+	return details
+}
+
+// msgs returns the msgs applying the changes, each of them changes at most storageTypes.MaxGroupMemberLimitOnce members.
+func (d *groupMemberDiff) msgs(operator, groupOwner sdk.AccAddress, groupName string) []sdk.Msg {
+	var msgs []sdk.Msg
+	toAdd, toRemove := d.toAdd, d.toRemove
+	for len(toAdd) > 0 || len(toRemove) > 0 {
+		addCount := len(toAdd)
+		if addCount > storageTypes.MaxGroupMemberLimitOnce {
+			addCount = storageTypes.MaxGroupMemberLimitOnce
+		}
+		removeCount := len(toRemove)
+		if removeCount > storageTypes.MaxGroupMemberLimitOnce-addCount {
+			removeCount = storageTypes.MaxGroupMemberLimitOnce - addCount
+		}
+		msgs = append(msgs, storageTypes.NewMsgUpdateGroupMember(operator, groupOwner, groupName, toAdd[:addCount], toRemove[:removeCount]))
+		toAdd, toRemove = toAdd[addCount:], toRemove[removeCount:]
+	}
+	for start := 0; start < len(d.toRenew); start += storageTypes.MaxGroupMemberLimitOnce {
+		end := start + storageTypes.MaxGroupMemberLimitOnce
+		if end > len(d.toRenew) {
+			end = len(d.toRenew)
+		}
+		msgs = append(msgs, storageTypes.NewMsgRenewGroupMember(operator, groupOwner, groupName, d.toRenew[start:end]))
+	}
+	return msgs
+}
+
+func memberDescription(member *storageTypes.MsgGroupMember) string {
+	return desiredMember(member).String()
+}
+
+func desiredMember(member *storageTypes.MsgGroupMember) types.DesiredMember {
+	desired := types.DesiredMember{Address: member.Member}
+	if !member.ExpirationTime.Equal(storageTypes.MaxTimeStamp) {
+		desired.Expiration = member.ExpirationTime
+	}
+	return desired
+}
+
+// listGroupMemberExpirations returns the expiration time of all the members of the group, the members without an
+// expiration time expire at storageTypes.MaxTimeStamp.
+func listGroupMemberExpirations(ctx context.Context, client IClient, groupID sdkmath.Uint) (map[string]time.Time, error) {
+	members := make(map[string]time.Time)
+	startAfter := ""
+	for {
+		result, err := client.ListGroupMembers(ctx, int64(groupID.Uint64()),
+			types.GroupMembersPaginationOptions{Limit: maxListLimit, StartAfter: startAfter})
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range result.Groups {
+			if member.Removed {
+				continue
+			}
+			expiration := storageTypes.MaxTimeStamp
+			if seconds, err := strconv.ParseInt(member.ExpirationTime, 10, 64); err == nil && seconds > 0 {
+				expiration = time.Unix(seconds, 0)
+			}
+			addr, err := sdk.AccAddressFromHexUnsafe(member.AccountID)
+			if err != nil {
+				return nil, err
+			}
+			members[addr.String()] = expiration
+		}
+		if len(result.Groups) < maxListLimit {
+			return members, nil
+		}
+		startAfter = result.Groups[len(result.Groups)-1].AccountID
+	}
+}
+
+// broadcastInBatches broadcasts the msgs in txs of at most maxMsgsPerTx msgs, the txs are confirmed one by one.
+func broadcastInBatches(ctx context.Context, client IClient, signer *types.Account, txOpts *gnfdSdkTypes.TxOption, msgs []sdk.Msg,
+	maxMsgsPerTx int,
+) ([]string, error) {
+	var txHashes []string
+	for start := 0; start < len(msgs); start += maxMsgsPerTx {
+		end := start + maxMsgsPerTx
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+		resp, err := client.BroadcastTx(ctx, msgs[start:end], signerTxOpts(txOpts, signer))
+		if err != nil {
+			return txHashes, err
+		}
+		txHash := resp.TxResponse.TxHash
+		waitCtx, cancel := context.WithTimeout(ctx, types.ContextTimeout)
+		txResult, err := client.WaitForTx(waitCtx, txHash)
+		cancel()
+		if err != nil {
+			return txHashes, fmt.Errorf("the tx %s has been submitted, please check it later: %w", txHash, err)
+		}
+		if txResult.TxResult.Code != 0 {
+			return txHashes, types.NewTxError(txResult.TxResult.Codespace, txResult.TxResult.Code, txResult.TxResult.Log,
+				"the tx %s has failed with response code: %d, codespace:%s", txHash, txResult.TxResult.Code, txResult.TxResult.Codespace)
+		}
+		txHashes = append(txHashes, txHash)
+	}
+	return txHashes, nil
+}
+
+// SyncGroupMembers - Make the members of the group the desired ones, e.g. the members exported from an HR system.
+//
+// The current members are listed by ListGroupMembers, the missing members are added, the undesired members are removed,
+// and the members whose expiration time differs are renewed. The changes are split into msgs of at most
+// storageTypes.MaxGroupMemberLimitOnce members, which are batched into txs of at most opts.MaxMsgsPerTx msgs.
+//
+// - ctx: Context variables for the current API call.
+//
+// - groupOwnerAddr: The HEX-encoded string of the group owner address.
+//
+// - groupName: The group name identifies the group.
+//
+// - desired: The desired members, e.g. loaded by types.ParseDesiredMembersCSV or types.ParseDesiredMembersJSON.
+//
+// - opts: The options to customize the txs and to only report the changes in the dry run.
+//
+// - ret1: The report of the changes and the hashes of the txs.
+//
+// - ret2: Return error when the changes can not be computed or a tx failed, the report of the changes applied before is returned too.
+func (c *Client) SyncGroupMembers(ctx context.Context, groupOwnerAddr, groupName string, desired []types.DesiredMember,
+	opts types.SyncGroupMembersOptions,
+) (_ *types.GroupMemberSyncReport, err error) {
+	ctx, span := c.startSpan(ctx, "client.SyncGroupMembers")
+	defer func() { endSpan(span, err) }()
+
+	groupOwner, err := sdk.AccAddressFromHexUnsafe(groupOwnerAddr)
+	if err != nil {
+		return nil, err
+	}
+	groupInfo, err := c.HeadGroup(ctx, groupName, groupOwner.String())
+	if err != nil {
+		return nil, err
+	}
+	current, err := listGroupMemberExpirations(ctx, c, groupInfo.Id)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := diffGroupMembers(current, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &types.GroupMemberSyncReport{
+		GroupOwner: groupOwner.String(),
+		GroupName:  groupName,
+		GroupID:    groupInfo.Id.String(),
+		Unchanged:  diff.unchanged,
+		DryRun:     opts.DryRun,
+	}
+	for _, member := range diff.toAdd {
+		report.Added = append(report.Added, desiredMember(member))
+	}
+	for _, member := range diff.toRenew {
+		report.Renewed = append(report.Renewed, desiredMember(member))
+	}
+	for _, member := range diff.toRemove {
+		report.Removed = append(report.Removed, member.String())
+	}
+	if opts.DryRun || diff.empty() {
+		return report, nil
+	}
+
+	signer, err := c.getSigner(opts.Account)
+	if err != nil {
+		return nil, err
+	}
+	maxMsgsPerTx := opts.MaxMsgsPerTx
+	if maxMsgsPerTx <= 0 {
+		maxMsgsPerTx = types.DefaultMaxMsgsPerTx
+	}
+	report.TxHashes, err = broadcastInBatches(ctx, c, signer, opts.TxOpts, diff.msgs(signer.GetAddress(), groupOwner, groupName), maxMsgsPerTx)
+	return report, err
+}
+
+// BulkUpdateGroupMember - Add and remove a large number of group members, e.g. thousands of members which do not fit
+// into one UpdateGroupMember tx.
+//
+// The addresses are validated up front, the members to add which are members already and the members to remove which
+// are not members are skipped by querying HeadGroupMember with opts.Concurrency workers. The changes are split into
+// msgs of at most storageTypes.MaxGroupMemberLimitOnce members, which are batched into txs of at most
+// opts.MaxMsgsPerTx msgs. The txs are broadcast in sync mode with the nonces tracked locally, and at most
+// opts.Concurrency txs are waiting for confirmation at the same time.
+//
+// If opts.CheckpointPath is set, the members of every confirmed tx are recorded in the checkpoint file, calling the
+// API again with the same members after a failure or an interruption resumes the update, and the file is removed once
+// the update completes.
+//
+// - ctx: Context variables for the current API call.
+//
+// - groupName: The group name identifies the group.
+//
+// - groupOwnerAddr: The HEX-encoded string of the group owner address.
+//
+// - addMembers: The members to add with their own expiration time, the member never expires if it is not set.
+//
+// - removeAddresses: The HEX-encoded strings of the member addresses to remove.
+//
+// - opts: The options to customize the txs, the concurrency and the checkpoint.
+//
+// - ret1: The members changed and skipped, and the hashes of the confirmed txs.
+//
+// - ret2: Return error when the members are invalid, the membership of a member can not be queried or a tx failed, the
+// result of the confirmed txs is returned too.
+func (c *Client) BulkUpdateGroupMember(ctx context.Context, groupName, groupOwnerAddr string, addMembers []types.DesiredMember,
+	removeAddresses []string, opts types.BulkUpdateGroupMemberOptions,
+) (_ *types.BulkGroupMemberUpdateResult, err error) {
+	ctx, span := c.startSpan(ctx, "client.BulkUpdateGroupMember")
+	defer func() { endSpan(span, err) }()
+
+	if groupName == "" {
+		return nil, errors.New("group name is empty")
+	}
+	if len(addMembers) == 0 && len(removeAddresses) == 0 {
+		return nil, errors.New("no update member")
+	}
+	groupOwner, err := sdk.AccAddressFromHexUnsafe(groupOwnerAddr)
+	if err != nil {
+		return nil, err
+	}
+	toAdd, toRemove, err := bulkGroupMembers(addMembers, removeAddresses)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := c.getSigner(opts.Account)
+	if err != nil {
+		return nil, err
+	}
+	if opts.MaxMsgsPerTx <= 0 {
+		opts.MaxMsgsPerTx = types.DefaultMaxMsgsPerTx
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = types.DefaultBulkUpdateConcurrency
+	}
+	if _, err = c.HeadGroup(ctx, groupName, groupOwner.String()); err != nil {
+		return nil, err
+	}
+	return bulkUpdateGroupMember(ctx, c, signer, groupOwner, groupName, toAdd, toRemove, opts)
+}
+
+// bulkUpdateGroupMember skips the members recorded in the checkpoint, the members to add which are members already and
+// the members to remove which are not members, and broadcasts the msgs changing the other members.
+func bulkUpdateGroupMember(ctx context.Context, client IClient, signer *types.Account, groupOwner sdk.AccAddress, groupName string,
+	toAdd []*storageTypes.MsgGroupMember, toRemove []sdk.AccAddress, opts types.BulkUpdateGroupMemberOptions,
+) (*types.BulkGroupMemberUpdateResult, error) {
+	checkpoint := &types.GroupMemberCheckpoint{GroupOwner: groupOwner.String(), GroupName: groupName}
+	if opts.CheckpointPath != "" {
+		var err error
+		if checkpoint, err = types.LoadGroupMemberCheckpoint(opts.CheckpointPath, groupOwner.String(), groupName); err != nil {
+			return nil, err
+		}
+	}
+	done := make(map[string]bool, len(checkpoint.Added)+len(checkpoint.Removed))
+	for _, member := range checkpoint.Added {
+		done[member] = true
+	}
+	for _, member := range checkpoint.Removed {
+		done[member] = true
+	}
+	var (
+		pendingAdd    []*storageTypes.MsgGroupMember
+		pendingRemove []sdk.AccAddress
+		addresses     []string
+	)
+	for _, member := range toAdd {
+		if !done[member.Member] {
+			pendingAdd = append(pendingAdd, member)
+			addresses = append(addresses, member.Member)
+		}
+	}
+	for _, member := range toRemove {
+		if !done[member.String()] {
+			pendingRemove = append(pendingRemove, member)
+			addresses = append(addresses, member.String())
+		}
+	}
+	isMember, err := headGroupMembers(ctx, client, groupName, groupOwner.String(), addresses, opts.Concurrency)
+	if err != nil {
+		return nil, err
+	}
+	// the memberships are queried for the members to add followed by the members to remove
+	addIsMember, removeIsMember := isMember[:len(pendingAdd)], isMember[len(pendingAdd):]
+
+	result := &types.BulkGroupMemberUpdateResult{}
+	diff := &groupMemberDiff{}
+	for i, member := range pendingAdd {
+		if addIsMember[i] {
+			result.AlreadyMembers = append(result.AlreadyMembers, member.Member)
+		} else {
+			diff.toAdd = append(diff.toAdd, member)
+		}
+	}
+	for i, member := range pendingRemove {
+		if removeIsMember[i] {
+			diff.toRemove = append(diff.toRemove, member)
+		} else {
+			result.NotMembers = append(result.NotMembers, member.String())
+		}
+	}
+
+	err = broadcastConcurrently(ctx, client, signer, opts, diff.msgs(signer.GetAddress(), groupOwner, groupName), checkpoint)
+	result.Added, result.Removed, result.TxHashes = checkpoint.Added, checkpoint.Removed, checkpoint.TxHashes
+	if err != nil {
+		return result, err
+	}
+	return result, checkpoint.Remove()
+}
+
+// bulkGroupMembers validates the members to add and remove, a member can neither be repeated nor be both added and removed.
+func bulkGroupMembers(addMembers []types.DesiredMember, removeAddresses []string) ([]*storageTypes.MsgGroupMember, []sdk.AccAddress, error) {
+	seen := make(map[string]bool, len(addMembers)+len(removeAddresses))
+	toAdd := make([]*storageTypes.MsgGroupMember, 0, len(addMembers))
+	for _, member := range addMembers {
+		addr, err := sdk.AccAddressFromHexUnsafe(member.Address)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid member %s: %w", member.Address, err)
+		}
+		if seen[addr.String()] {
+			return nil, nil, fmt.Errorf("the member %s is added more than once", addr)
+		}
+		seen[addr.String()] = true
+		expiration := &storageTypes.MaxTimeStamp
+		if member.Expiration != nil {
+			expiration = member.Expiration
+		}
+		toAdd = append(toAdd, &storageTypes.MsgGroupMember{Member: addr.String(), ExpirationTime: expiration})
+	}
+	toRemove := make([]sdk.AccAddress, 0, len(removeAddresses))
+	for _, member := range removeAddresses {
+		addr, err := sdk.AccAddressFromHexUnsafe(member)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid member %s: %w", member, err)
+		}
+		if seen[addr.String()] {
+			return nil, nil, fmt.Errorf("the member %s is added or removed more than once", addr)
+		}
+		seen[addr.String()] = true
+		toRemove = append(toRemove, addr)
+	}
+	return toAdd, toRemove, nil
+}
+
+// headGroupMembers returns whether the accounts are members of the group with the given number of workers, an account
+// is not a member only if the chain reports so, and the other failed queries are returned as the error.
+func headGroupMembers(ctx context.Context, client IClient, groupName, groupOwner string, members []string, concurrency int) ([]bool, error) {
+	isMember := make([]bool, len(members))
+	errs := make([]error, len(members))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency && i < len(members); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indexes {
+				_, err := client.GetGroupMember(ctx, groupName, groupOwner, members[idx])
+				switch {
+				case err == nil:
+					isMember[idx] = true
+				case !types.IsNotFound(err):
+					errs[idx] = fmt.Errorf("failed to query the member %s: %w", members[idx], err)
+				}
+			}
+		}()
+	}
+	for idx := range members {
+		indexes <- idx
+	}
+	close(indexes)
+	wg.Wait()
+	return isMember, errors.Join(errs...)
+}
+
+// broadcastConcurrently broadcasts the msgs in txs of at most opts.MaxMsgsPerTx msgs with the locally tracked nonces,
+// and records the members of every confirmed tx in the checkpoint. No more txs are broadcast once a tx fails.
+func broadcastConcurrently(ctx context.Context, client IClient, signer *types.Account, opts types.BulkUpdateGroupMemberOptions,
+	msgs []sdk.Msg, checkpoint *types.GroupMemberCheckpoint,
+) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	account, err := client.GetAccount(ctx, signer.GetAddress().String())
+	if err != nil {
+		return err
+	}
+	nonce := account.GetSequence()
+
+	var (
+		wg      sync.WaitGroup
+		errMtx  sync.Mutex
+		errs    []error
+		pending = make(chan struct{}, opts.Concurrency)
+	)
+	failed := func() bool {
+		errMtx.Lock()
+		defer errMtx.Unlock()
+		return len(errs) > 0
+	}
+	fail := func(err error) {
+		errMtx.Lock()
+		defer errMtx.Unlock()
+		errs = append(errs, err)
+	}
+
+	for start := 0; start < len(msgs); start += opts.MaxMsgsPerTx {
+		end := start + opts.MaxMsgsPerTx
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+		pending <- struct{}{}
+		if failed() || ctx.Err() != nil {
+			<-pending
+			break
+		}
+		txOpts := signerTxOpts(opts.TxOpts, signer)
+		if txOpts.Mode == nil {
+			broadcastMode := tx.BroadcastMode_BROADCAST_MODE_SYNC
+			txOpts.Mode = &broadcastMode
+		}
+		txOpts.Nonce = nonce
+		resp, err := client.BroadcastTx(ctx, msgs[start:end], txOpts)
+		if err == nil && resp.TxResponse.Code != 0 {
+			err = types.NewTxError(resp.TxResponse.Codespace, resp.TxResponse.Code, resp.TxResponse.RawLog,
+				"the tx has been rejected with response code: %d, codespace:%s", resp.TxResponse.Code, resp.TxResponse.Codespace)
+		}
+		if err != nil {
+			<-pending
+			fail(err)
+			break
+		}
+		nonce++
+
+		wg.Add(1)
+		go func(txHash string, batch []sdk.Msg) {
+			defer wg.Done()
+			defer func() { <-pending }()
+			waitCtx, cancel := context.WithTimeout(ctx, types.ContextTimeout)
+			defer cancel()
+			txResult, err := client.WaitForTx(waitCtx, txHash)
+			if err != nil {
+				fail(fmt.Errorf("the tx %s has been submitted, please check it later: %w", txHash, err))
+				return
+			}
+			if txResult.TxResult.Code != 0 {
+				fail(types.NewTxError(txResult.TxResult.Codespace, txResult.TxResult.Code, txResult.TxResult.Log,
+					"the tx %s has failed with response code: %d, codespace:%s", txHash, txResult.TxResult.Code, txResult.TxResult.Codespace))
+				return
+			}
+			var added, removed []string
+			for _, msg := range batch {
+				updateMsg := msg.(*storageTypes.MsgUpdateGroupMember)
+				for _, member := range updateMsg.MembersToAdd {
+					added = append(added, member.Member)
+				}
+				removed = append(removed, updateMsg.MembersToDelete...)
+			}
+			if err = checkpoint.Record(txHash, added, removed); err != nil {
+				fail(fmt.Errorf("the tx %s has been confirmed but can not be recorded in the checkpoint: %w", txHash, err))
+			}
+		}(resp.TxResponse.TxHash, msgs[start:end])
+	}
+	wg.Wait()
+	if len(errs) == 0 {
+		return ctx.Err()
+	}
+	return errors.Join(errs...)
+}
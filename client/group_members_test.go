@@ -0,0 +1,433 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	gnfdSdkTypes "github.com/bnb-chain/greenfield/sdk/types"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// testMembers returns n member addresses in ascending order.
+func testMembers(n int) []sdk.AccAddress {
+	members := make([]sdk.AccAddress, n)
+	for i := range members {
+		members[i] = sdk.MustAccAddressFromHex(fmt.Sprintf("0x%040x", i+1))
+	}
+	return members
+}
+
+func TestDiffGroupMembers(t *testing.T) {
+	members := testMembers(4)
+	a, b, c, d := members[0].String(), members[1].String(), members[2].String(), members[3].String()
+	expiration := time.Unix(1735689600, 0)
+	renewed := expiration.Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		current   map[string]time.Time
+		desired   []types.DesiredMember
+		toAdd     []*storageTypes.MsgGroupMember
+		toRenew   []*storageTypes.MsgGroupMember
+		toRemove  []sdk.AccAddress
+		unchanged int
+		wantErr   bool
+	}{
+		{
+			name:    "add to an empty group",
+			current: map[string]time.Time{},
+			desired: []types.DesiredMember{{Address: a}, {Address: b, Expiration: &expiration}},
+			toAdd: []*storageTypes.MsgGroupMember{
+				{Member: a, ExpirationTime: &storageTypes.MaxTimeStamp},
+				{Member: b, ExpirationTime: &expiration},
+			},
+		},
+		{
+			name:     "remove the undesired members in order",
+			current:  map[string]time.Time{d: storageTypes.MaxTimeStamp, b: expiration, a: storageTypes.MaxTimeStamp},
+			desired:  []types.DesiredMember{{Address: a}},
+			toRemove: []sdk.AccAddress{members[1], members[3]},
+			// a never expires on both sides
+			unchanged: 1,
+		},
+		{
+			name:    "renew the members whose expiration differs",
+			current: map[string]time.Time{a: expiration, b: expiration, c: storageTypes.MaxTimeStamp},
+			desired: []types.DesiredMember{{Address: a, Expiration: &renewed}, {Address: b, Expiration: &expiration}, {Address: c, Expiration: &expiration}},
+			toRenew: []*storageTypes.MsgGroupMember{
+				{Member: a, ExpirationTime: &renewed},
+				{Member: c, ExpirationTime: &expiration},
+			},
+			unchanged: 1,
+		},
+		{
+			name:    "expiration compared in seconds",
+			current: map[string]time.Time{a: expiration},
+			desired: []types.DesiredMember{{Address: a, Expiration: func() *time.Time { t := expiration.Add(time.Millisecond); return &t }()}},
+			// the chain keeps the expiration in seconds
+			unchanged: 1,
+		},
+		{
+			name:      "address in lower case",
+			current:   map[string]time.Time{a: storageTypes.MaxTimeStamp},
+			desired:   []types.DesiredMember{{Address: fmt.Sprintf("0x%040x", 1)}},
+			unchanged: 1,
+		},
+		{
+			name:    "duplicated member",
+			current: map[string]time.Time{},
+			desired: []types.DesiredMember{{Address: a}, {Address: fmt.Sprintf("0x%040x", 1)}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid member",
+			current: map[string]time.Time{},
+			desired: []types.DesiredMember{{Address: "0x1234"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff, err := diffGroupMembers(tt.current, tt.desired)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.toAdd, diff.toAdd)
+			require.Equal(t, tt.toRenew, diff.toRenew)
+			require.Equal(t, tt.toRemove, diff.toRemove)
+			require.Equal(t, tt.unchanged, diff.unchanged)
+			require.Equal(t, len(tt.toAdd)+len(tt.toRenew)+len(tt.toRemove) == 0, diff.empty())
+		})
+	}
+}
+
+func TestGroupMemberDiffMsgs(t *testing.T) {
+	const limit = storageTypes.MaxGroupMemberLimitOnce
+	operator, owner := testMembers(1)[0], testMembers(2)[1]
+	members := testMembers(3 * limit)
+	msgMembers := func(addrs []sdk.AccAddress) []*storageTypes.MsgGroupMember {
+		msgMembers := make([]*storageTypes.MsgGroupMember, len(addrs))
+		for i, addr := range addrs {
+			msgMembers[i] = &storageTypes.MsgGroupMember{Member: addr.String(), ExpirationTime: &storageTypes.MaxTimeStamp}
+		}
+		return msgMembers
+	}
+
+	// the msgs are described by the numbers of the members added, removed and renewed
+	type msgSize struct{ add, remove, renew int }
+	tests := []struct {
+		name               string
+		add, remove, renew int
+		sizes              []msgSize
+	}{
+		{"nothing", 0, 0, 0, nil},
+		{"adds and removes in one msg", 5, limit - 5, 0, []msgSize{{5, limit - 5, 0}}},
+		{"adds filling the msgs before the removes", limit + 5, 20, 0, []msgSize{{limit, 0, 0}, {5, limit - 5, 0}, {0, 20 - (limit - 5), 0}}},
+		{"removes only", 0, 2*limit + 1, 0, []msgSize{{0, limit, 0}, {0, limit, 0}, {0, 1, 0}}},
+		{"renews in their own msgs", 1, 0, limit + 1, []msgSize{{1, 0, 0}, {0, 0, limit}, {0, 0, 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := &groupMemberDiff{
+				toAdd:    msgMembers(members[:tt.add]),
+				toRemove: members[tt.add : tt.add+tt.remove],
+				toRenew:  msgMembers(members[tt.add+tt.remove : tt.add+tt.remove+tt.renew]),
+			}
+			msgs := diff.msgs(operator, owner, "group")
+
+			var (
+				sizes          []msgSize
+				added, removed []string
+				renewedCount   int
+			)
+			for _, msg := range msgs {
+				require.NoError(t, msg.ValidateBasic())
+				switch msg := msg.(type) {
+				case *storageTypes.MsgUpdateGroupMember:
+					sizes = append(sizes, msgSize{len(msg.MembersToAdd), len(msg.MembersToDelete), 0})
+					for _, member := range msg.MembersToAdd {
+						added = append(added, member.Member)
+					}
+					removed = append(removed, msg.MembersToDelete...)
+				case *storageTypes.MsgRenewGroupMember:
+					sizes = append(sizes, msgSize{0, 0, len(msg.Members)})
+					renewedCount += len(msg.Members)
+				default:
+					t.Fatalf("unexpected msg %T", msg)
+				}
+			}
+			require.Equal(t, tt.sizes, sizes)
+			require.Len(t, added, tt.add)
+			require.Len(t, removed, tt.remove)
+			require.Equal(t, tt.renew, renewedCount)
+		})
+	}
+}
+
+// groupChain serves the membership queries and the txs of BulkUpdateGroupMember for a group, the msgs of a tx are
+// applied when it is confirmed.
+type groupChain struct {
+	IClient
+
+	mtx      sync.Mutex
+	members  map[string]bool
+	sequence uint64
+	txs      map[string][]sdk.Msg
+	txCount  int
+	failedTx int   // the number of the tx failing to be executed, 0 means none
+	queryErr error // the error of the membership queries
+}
+
+func newGroupChain(members ...sdk.AccAddress) *groupChain {
+	chain := &groupChain{members: make(map[string]bool), sequence: 7, txs: make(map[string][]sdk.Msg)}
+	for _, member := range members {
+		chain.members[member.String()] = true
+	}
+	return chain
+}
+
+func (c *groupChain) GetGroupMember(_ context.Context, groupName string, _, memberAddr string) (*permTypes.GroupMember, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.queryErr != nil {
+		return nil, c.queryErr
+	}
+	if !c.members[memberAddr] {
+		return nil, storageTypes.ErrNoSuchGroupMember.Wrapf("group: %s, member: %s", groupName, memberAddr)
+	}
+	return &permTypes.GroupMember{Member: memberAddr}, nil
+}
+
+func (c *groupChain) GetAccount(_ context.Context, address string) (authTypes.AccountI, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return &authTypes.BaseAccount{Address: address, Sequence: c.sequence}, nil
+}
+
+func (c *groupChain) BroadcastTx(_ context.Context, msgs []sdk.Msg, txOpt *gnfdSdkTypes.TxOption, _ ...grpc.CallOption) (*tx.BroadcastTxResponse, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if txOpt.Nonce != c.sequence {
+		return nil, fmt.Errorf("account sequence mismatch, expected %d, got %d", c.sequence, txOpt.Nonce)
+	}
+	c.sequence++
+	c.txCount++
+	txHash := fmt.Sprintf("%064X", c.txCount)
+	c.txs[txHash] = msgs
+	return &tx.BroadcastTxResponse{TxResponse: &sdk.TxResponse{TxHash: txHash}}, nil
+}
+
+func (c *groupChain) WaitForTx(_ context.Context, hash string) (*ctypes.ResultTx, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	msgs, ok := c.txs[hash]
+	if !ok {
+		return nil, fmt.Errorf("tx %s not found", hash)
+	}
+	if hash == fmt.Sprintf("%064X", c.failedTx) {
+		return &ctypes.ResultTx{TxResult: abci.ResponseDeliverTx{Code: 1, Codespace: "storage", Log: "failed"}}, nil
+	}
+	for _, msg := range msgs {
+		updateMsg := msg.(*storageTypes.MsgUpdateGroupMember)
+		for _, member := range updateMsg.MembersToAdd {
+			c.members[member.Member] = true
+		}
+		for _, member := range updateMsg.MembersToDelete {
+			delete(c.members, member)
+		}
+	}
+	return &ctypes.ResultTx{}, nil
+}
+
+func (c *groupChain) memberList() []string {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	members := make([]string, 0, len(c.members))
+	for member := range c.members {
+		members = append(members, member)
+	}
+	return members
+}
+
+func TestBulkUpdateGroupMember(t *testing.T) {
+	const limit = storageTypes.MaxGroupMemberLimitOnce
+	signer, _, err := types.NewAccount("signer")
+	require.NoError(t, err)
+	members := testMembers(100)
+	strs := func(addrs []sdk.AccAddress) []string {
+		strs := make([]string, len(addrs))
+		for i, addr := range addrs {
+			strs[i] = addr.String()
+		}
+		return strs
+	}
+	msgMembers := func(addrs []sdk.AccAddress) []*storageTypes.MsgGroupMember {
+		msgMembers := make([]*storageTypes.MsgGroupMember, len(addrs))
+		for i, addr := range addrs {
+			msgMembers[i] = &storageTypes.MsgGroupMember{Member: addr.String(), ExpirationTime: &storageTypes.MaxTimeStamp}
+		}
+		return msgMembers
+	}
+
+	tests := []struct {
+		name           string
+		current        []sdk.AccAddress
+		toAdd          []sdk.AccAddress
+		toRemove       []sdk.AccAddress
+		opts           types.BulkUpdateGroupMemberOptions
+		alreadyMembers []sdk.AccAddress
+		notMembers     []sdk.AccAddress
+		txCount        int
+		final          []sdk.AccAddress
+	}{
+		{
+			name:    "members in several txs confirmed concurrently",
+			current: members[:5],
+			toAdd:   members[10 : 10+2*limit+5],
+			opts:    types.BulkUpdateGroupMemberOptions{MaxMsgsPerTx: 1, Concurrency: 2},
+			txCount: 3,
+			final:   append(append([]sdk.AccAddress{}, members[:5]...), members[10:10+2*limit+5]...),
+		},
+		{
+			name:     "memberships skipped by the queries",
+			current:  members[:5],
+			toAdd:    []sdk.AccAddress{members[3], members[10], members[4]},
+			toRemove: []sdk.AccAddress{members[0], members[50], members[1], members[51]},
+			opts:     types.BulkUpdateGroupMemberOptions{MaxMsgsPerTx: 1, Concurrency: 3},
+			// the members to add are members already, and the members to remove are not members
+			alreadyMembers: []sdk.AccAddress{members[3], members[4]},
+			notMembers:     []sdk.AccAddress{members[50], members[51]},
+			txCount:        1,
+			final:          []sdk.AccAddress{members[2], members[3], members[4], members[10]},
+		},
+		{
+			name:     "msgs batched into a tx",
+			current:  members[:limit],
+			toAdd:    members[limit : 3*limit],
+			toRemove: members[:limit],
+			opts:     types.BulkUpdateGroupMemberOptions{MaxMsgsPerTx: 2, Concurrency: 1},
+			txCount:  2,
+			final:    members[limit : 3*limit],
+		},
+		{
+			name:           "nothing to change",
+			current:        members[:2],
+			toAdd:          members[:1],
+			toRemove:       members[2:3],
+			opts:           types.BulkUpdateGroupMemberOptions{MaxMsgsPerTx: 1, Concurrency: 1},
+			alreadyMembers: members[:1],
+			notMembers:     members[2:3],
+			final:          members[:2],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := newGroupChain(tt.current...)
+			result, err := bulkUpdateGroupMember(context.Background(), chain, signer, signer.GetAddress(), "group",
+				msgMembers(tt.toAdd), tt.toRemove, tt.opts)
+			require.NoError(t, err)
+			require.ElementsMatch(t, strs(tt.alreadyMembers), result.AlreadyMembers)
+			require.ElementsMatch(t, strs(tt.notMembers), result.NotMembers)
+			require.Len(t, result.TxHashes, tt.txCount)
+			require.Equal(t, uint64(7+tt.txCount), chain.sequence)
+			require.Len(t, result.Added, len(tt.toAdd)-len(tt.alreadyMembers))
+			require.Len(t, result.Removed, len(tt.toRemove)-len(tt.notMembers))
+			require.ElementsMatch(t, strs(tt.final), chain.memberList())
+		})
+	}
+}
+
+func TestBulkUpdateGroupMemberResume(t *testing.T) {
+	const limit = storageTypes.MaxGroupMemberLimitOnce
+	ctx := context.Background()
+	signer, _, err := types.NewAccount("signer")
+	require.NoError(t, err)
+	members := testMembers(3 * limit)
+	toAdd := make([]*storageTypes.MsgGroupMember, len(members))
+	for i, member := range members {
+		toAdd[i] = &storageTypes.MsgGroupMember{Member: member.String(), ExpirationTime: &storageTypes.MaxTimeStamp}
+	}
+	checkpointPath := filepath.Join(t.TempDir(), "checkpoint.json")
+	opts := types.BulkUpdateGroupMemberOptions{MaxMsgsPerTx: 1, Concurrency: 1, CheckpointPath: checkpointPath}
+
+	// the second tx fails, no more txs are broadcast and the first one is recorded in the checkpoint
+	chain := newGroupChain()
+	chain.failedTx = 2
+	result, err := bulkUpdateGroupMember(ctx, chain, signer, signer.GetAddress(), "group", toAdd, nil, opts)
+	require.Error(t, err)
+	require.Equal(t, 2, chain.txCount)
+	require.Equal(t, []string{fmt.Sprintf("%064X", 1)}, result.TxHashes)
+	require.Len(t, result.Added, limit)
+	checkpoint, err := types.LoadGroupMemberCheckpoint(checkpointPath, signer.GetAddress().String(), "group")
+	require.NoError(t, err)
+	require.Equal(t, result.Added, checkpoint.Added)
+
+	// the checkpoint of another group is rejected
+	_, err = bulkUpdateGroupMember(ctx, chain, signer, signer.GetAddress(), "another", toAdd, nil, opts)
+	require.Error(t, err)
+
+	// the update is resumed without the members of the first tx, and the checkpoint is removed after it completes
+	chain.failedTx = 0
+	result, err = bulkUpdateGroupMember(ctx, chain, signer, signer.GetAddress(), "group", toAdd, nil, opts)
+	require.NoError(t, err)
+	require.Equal(t, 4, chain.txCount)
+	require.Len(t, result.TxHashes, 3)
+	require.Len(t, result.Added, len(members))
+	require.Len(t, chain.memberList(), len(members))
+	_, err = os.Stat(checkpointPath)
+	require.True(t, errors.Is(err, os.ErrNotExist))
+
+	// the update stops before broadcasting when a membership can not be queried
+	chain.queryErr = errors.New("connection refused")
+	_, err = bulkUpdateGroupMember(ctx, chain, signer, signer.GetAddress(), "group", toAdd, nil, opts)
+	require.ErrorIs(t, err, chain.queryErr)
+	require.Equal(t, 4, chain.txCount)
+}
+
+func TestBulkGroupMembers(t *testing.T) {
+	members := testMembers(3)
+	a, b := members[0].String(), members[1].String()
+	tests := []struct {
+		name    string
+		add     []types.DesiredMember
+		remove  []string
+		wantErr bool
+	}{
+		{"members to add and remove", []types.DesiredMember{{Address: a}}, []string{b}, false},
+		{"member added twice", []types.DesiredMember{{Address: a}, {Address: fmt.Sprintf("0x%040x", 1)}}, nil, true},
+		{"member removed twice", nil, []string{b, b}, true},
+		{"member added and removed", []types.DesiredMember{{Address: a}}, []string{a}, true},
+		{"invalid member to add", []types.DesiredMember{{Address: "0x12"}}, nil, true},
+		{"invalid member to remove", nil, []string{"0x12"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toAdd, toRemove, err := bulkGroupMembers(tt.add, tt.remove)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, toAdd, len(tt.add))
+			require.Len(t, toRemove, len(tt.remove))
+		})
+	}
+}
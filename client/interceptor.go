@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc"
+)
+
+// SPRequestHandler - The handler sending a request to SP and returning the response.
+//
+// The error is a types.ErrResponse if SP responds with an error, the response is also returned in that case.
+type SPRequestHandler func(req *http.Request) (*http.Response, error)
+
+// SPRequestInterceptor - The interceptor around the requests sent to SP.
+//
+// The interceptor can modify the request before calling next, e.g. adding headers or rewriting the endpoint, inspect
+// the response and the error returned by next, or return without calling next, e.g. injecting faults in tests.
+// The request is signed after all interceptors have run, so the modified headers are covered by the signature.
+// The request is built, intercepted and signed again on each retry.
+type SPRequestInterceptor func(req *http.Request, next SPRequestHandler) (*http.Response, error)
+
+// chainSPRequestInterceptors wraps the handler with the interceptors, the first interceptor is the outermost one.
+func chainSPRequestInterceptors(interceptors []SPRequestInterceptor, handler SPRequestHandler) SPRequestHandler {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], handler
+		handler = func(req *http.Request) (*http.Response, error) {
+			return interceptor(req, next)
+		}
+	}
+	return handler
+}
+
+// chainUnaryInterceptors wraps the invoker with the gRPC unary interceptors, the first interceptor is the outermost
+// one. The queries are sent through the ABCI query of the node instead of a gRPC connection, so the *grpc.ClientConn
+// passed to the interceptors is nil.
+func chainUnaryInterceptors(interceptors []grpc.UnaryClientInterceptor, invoker grpc.UnaryInvoker) grpc.UnaryInvoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+		invoker = func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			return interceptor(ctx, method, req, reply, cc, next, opts...)
+		}
+	}
+	return invoker
+}
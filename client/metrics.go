@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Counter - A metric which only increases, e.g. prometheus.CounterVec.
+type Counter interface {
+	// Add increases the counter of the labels by the value, the label values are in the order of the label names.
+	Add(value float64, labelValues ...string)
+}
+
+// Histogram - A metric which samples observations into buckets, e.g. prometheus.HistogramVec.
+type Histogram interface {
+	// Observe adds an observation of the labels, the label values are in the order of the label names.
+	Observe(value float64, labelValues ...string)
+}
+
+// MetricsRegistry - The registry creating the metrics of the Client.
+//
+// The SDK does not depend on any metrics backend, the registry adapts the metrics to the backend. For example, a
+// prometheus registry creates a prometheus.CounterVec with the name, help and label names, registers it, and returns a
+// Counter calling WithLabelValues(labelValues...).Add(value).
+type MetricsRegistry interface {
+	// Counter creates a counter with the name, help and label names.
+	Counter(name, help string, labelNames ...string) Counter
+	// Histogram creates a histogram with the name, help, bucket upper bounds and label names.
+	Histogram(name, help string, buckets []float64, labelNames ...string) Histogram
+}
+
+// DefaultLatencyBuckets are the buckets in seconds of the latency histograms.
+var DefaultLatencyBuckets = []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// DefaultGasBuckets are the buckets of the gas used histogram.
+var DefaultGasBuckets = []float64{1e3, 5e3, 1e4, 2.5e4, 5e4, 1e5, 2.5e5, 5e5, 1e6, 5e6}
+
+type noopMetric struct{}
+
+func (noopMetric) Add(float64, ...string) {}
+
+func (noopMetric) Observe(float64, ...string) {}
+
+type noopRegistry struct{}
+
+func (noopRegistry) Counter(string, string, ...string) Counter { return noopMetric{} }
+
+func (noopRegistry) Histogram(string, string, []float64, ...string) Histogram { return noopMetric{} }
+
+// clientMetrics are the metrics recorded by the Client.
+type clientMetrics struct {
+	spRequestDuration  Histogram // labels: api, endpoint, method, status
+	spRetries          Counter   // labels: api, endpoint
+	spUploadedBytes    Counter   // labels: api, endpoint
+	spDownloadedBytes  Counter   // labels: api, endpoint
+	txBroadcasts       Counter   // labels: result
+	txGasUsed          Histogram // labels: result
+	chainQueries       Counter   // labels: method, result
+	chainQueryDuration Histogram // labels: method
+}
+
+func newClientMetrics(registry MetricsRegistry) *clientMetrics {
+	if registry == nil {
+		registry = noopRegistry{}
+	}
+	return &clientMetrics{
+		spRequestDuration: registry.Histogram("gnfd_sp_request_duration_seconds",
+			"The latency of the requests sent to SP.", DefaultLatencyBuckets, "api", "endpoint", "method", "status"),
+		spRetries: registry.Counter("gnfd_sp_request_retries_total",
+			"The number of the retried requests sent to SP.", "api", "endpoint"),
+		spUploadedBytes: registry.Counter("gnfd_sp_uploaded_bytes_total",
+			"The bytes uploaded to SP.", "api", "endpoint"),
+		spDownloadedBytes: registry.Counter("gnfd_sp_downloaded_bytes_total",
+			"The bytes downloaded from SP.", "api", "endpoint"),
+		txBroadcasts: registry.Counter("gnfd_tx_broadcasts_total",
+			"The number of the broadcast txs by the result, which is one of success, failed and error.", "result"),
+		txGasUsed: registry.Histogram("gnfd_tx_gas_used",
+			"The gas used by the committed txs.", DefaultGasBuckets, "result"),
+		chainQueries: registry.Counter("gnfd_chain_queries_total",
+			"The number of the queries sent to the chain by the result, which is one of success and error.", "method", "result"),
+		chainQueryDuration: registry.Histogram("gnfd_chain_query_duration_seconds",
+			"The latency of the queries sent to the chain.", DefaultLatencyBuckets, "method"),
+	}
+}
+
+// apiNameKey is the context key of the name of the API being called.
+type apiNameKey struct{}
+
+// apiName returns the name of the API being called, it is set by startSpan.
+func apiName(ctx context.Context) string {
+	if name, ok := ctx.Value(apiNameKey{}).(string); ok {
+		return name
+	}
+	return "unknown"
+}
+
+// statusLabel returns the status label of an SP request.
+func statusLabel(statusCode int) string {
+	if statusCode == 0 {
+		return "error"
+	}
+	return strconv.Itoa(statusCode)
+}
+
+// resultLabel returns the result label of a chain call.
+func resultLabel(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "success"
+}
+
+// rpcMethodLabel trims the leading slash of the gRPC method, e.g. "greenfield.storage.Query/HeadBucket".
+func rpcMethodLabel(method string) string {
+	return strings.TrimPrefix(method, "/")
+}
+
+// countingReadCloser counts the bytes downloaded from SP as they are read.
+type countingReadCloser struct {
+	io.ReadCloser
+	counter     Counter
+	labelValues []string
+}
+
+func (r *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		r.counter.Add(float64(n), r.labelValues...)
+	}
+	return n, err
+}
@@ -0,0 +1,466 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// auditGroup is a group referred by the audited policies or owned by the bucket owner.
+type auditGroup struct {
+	id   uint64
+	info *storageTypes.GroupInfo // it is nil if the group has no members or does not exist
+	// exists is false if the group has been deleted
+	exists bool
+	// granting means the group is the principal of a policy on the bucket or its objects
+	granting bool
+	// members maps the members to their expiration time, nil means the membership never expires
+	members     map[string]*time.Time
+	memberOrder []string
+}
+
+// permissionAudit collects the entries of the audit report of a bucket.
+type permissionAudit struct {
+	c        *Client
+	now      time.Time
+	bucket   *storageTypes.BucketInfo
+	report   *types.PermissionAuditReport
+	accounts map[string]bool
+	// the accounts and the groups are kept in the order they are found to make the report stable
+	accountOrder []string
+	groups       map[uint64]*auditGroup
+	groupOrder   []uint64
+	// the policies are turned into entries after the members of the groups are loaded
+	policies []auditedPolicy
+}
+
+type auditedPolicy struct {
+	resource      string
+	principalType permTypes.PrincipalType
+	principal     string
+	policy        *permTypes.Policy
+}
+
+// AuditBucketPermissions - Report who can access what in the bucket, including the owner, the public-read visibility of
+// the bucket and the objects, the bucket and object policies of the accounts and the groups, the members of the groups,
+// and the group policies of the groups granting permissions on the bucket.
+//
+// The objects are listed by ListObjects and their policies are listed by ListObjectPolicies for every action type.
+// Since the bucket policies can not be listed, they are audited for the principals found in the object policies, the
+// groups of the bucket owner, the members of these groups, and the accounts in opts.Accounts.
+//
+// - ctx: Context variables for the current API call.
+//
+// - bucketName: The bucket name identifies the bucket.
+//
+// - opts: The options to set the extra accounts to be audited and the time when the expiration is evaluated.
+//
+// - ret1: The audit report with one entry per principal, resource and action, which can be exported in JSON or CSV.
+//
+// - ret2: Return error when the bucket or the policies can not be read, otherwise return nil.
+func (c *Client) AuditBucketPermissions(ctx context.Context, bucketName string, opts types.AuditBucketPermissionsOptions) (_ *types.PermissionAuditReport, err error) {
+	ctx, span := c.startSpan(ctx, "client.AuditBucketPermissions", attrBucket.String(bucketName))
+	defer func() { endSpan(span, err) }()
+
+	bucketInfo, err := c.HeadBucket(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	audit := &permissionAudit{
+		c:        c,
+		now:      opts.Time,
+		bucket:   bucketInfo,
+		report:   &types.PermissionAuditReport{Bucket: bucketName, Owner: bucketInfo.Owner, GeneratedAt: time.Now().UTC()},
+		accounts: make(map[string]bool),
+		groups:   make(map[uint64]*auditGroup),
+	}
+	if audit.now.IsZero() {
+		audit.now = time.Now()
+	}
+	for _, account := range opts.Accounts {
+		if err = audit.addAccount(account); err != nil {
+			return nil, err
+		}
+	}
+
+	bucketGRN := gnfdTypes.NewBucketGRN(bucketName).String()
+	audit.report.Entries = append(audit.report.Entries, types.PermissionAuditEntry{
+		Resource:      bucketGRN,
+		PrincipalType: types.AuditPrincipalOwner,
+		Principal:     bucketInfo.Owner,
+		Action:        permTypes.ACTION_TYPE_ALL.String(),
+		Effect:        permTypes.EFFECT_ALLOW.String(),
+	})
+	if bucketInfo.Visibility == storageTypes.VISIBILITY_TYPE_PUBLIC_READ {
+		audit.addPublicEntries(bucketGRN, types.PublicReadBucketAllowedActions, types.AuditFlagPublic)
+	}
+
+	if err = audit.auditObjects(ctx); err != nil {
+		return nil, err
+	}
+	if err = audit.loadOwnerGroups(ctx); err != nil {
+		return nil, err
+	}
+	if err = audit.loadGroups(ctx); err != nil {
+		return nil, err
+	}
+	if err = audit.auditBucketPolicies(ctx, bucketGRN); err != nil {
+		return nil, err
+	}
+	if err = audit.auditGroupPolicies(ctx); err != nil {
+		return nil, err
+	}
+	for _, policy := range audit.policies {
+		audit.addPolicyEntries(policy)
+	}
+	return audit.report, nil
+}
+
+func (a *permissionAudit) addAccount(account string) error {
+	addr, err := sdk.AccAddressFromHexUnsafe(account)
+	if err != nil {
+		return err
+	}
+	if !a.accounts[addr.String()] {
+		a.accounts[addr.String()] = true
+		a.accountOrder = append(a.accountOrder, addr.String())
+	}
+	return nil
+}
+
+func (a *permissionAudit) addGroup(id uint64) *auditGroup {
+	group, ok := a.groups[id]
+	if !ok {
+		group = &auditGroup{id: id, exists: true}
+		a.groups[id] = group
+		a.groupOrder = append(a.groupOrder, id)
+	}
+	return group
+}
+
+func (a *permissionAudit) addPublicEntries(resource string, actions map[permTypes.ActionType]bool, flags ...string) {
+	for _, action := range sortedActions(actions) {
+		a.report.Entries = append(a.report.Entries, types.PermissionAuditEntry{
+			Resource:      resource,
+			PrincipalType: types.AuditPrincipalPublic,
+			Principal:     "*",
+			Action:        action.String(),
+			Effect:        permTypes.EFFECT_ALLOW.String(),
+			Flags:         flags,
+		})
+	}
+}
+
+// auditObjects walks the objects and audits their visibility and their policies.
+func (a *permissionAudit) auditObjects(ctx context.Context) error {
+	actions := sortedActions(permTypes.ObjectAllowedActions)
+	listOpts := types.ListObjectsOptions{MaxKeys: maxListLimit}
+	for {
+		result, err := a.c.ListObjects(ctx, a.bucket.BucketName, listOpts)
+		if err != nil {
+			return err
+		}
+		for _, objectMeta := range result.Objects {
+			if objectMeta.Removed || objectMeta.ObjectInfo == nil {
+				continue
+			}
+			if err = a.auditObject(ctx, objectMeta.ObjectInfo, actions); err != nil {
+				return err
+			}
+		}
+		if !result.IsTruncated {
+			return nil
+		}
+		listOpts.ContinuationToken = result.NextContinuationToken
+	}
+}
+
+func (a *permissionAudit) auditObject(ctx context.Context, objectInfo *storageTypes.ObjectInfo, actions []permTypes.ActionType) error {
+	objectGRN := gnfdTypes.NewObjectGRN(objectInfo.BucketName, objectInfo.ObjectName).String()
+	switch {
+	case objectInfo.Visibility == storageTypes.VISIBILITY_TYPE_PUBLIC_READ:
+		a.addPublicEntries(objectGRN, types.PublicReadObjectAllowedActions, types.AuditFlagPublic)
+	case objectInfo.Visibility == storageTypes.VISIBILITY_TYPE_INHERIT && a.bucket.Visibility == storageTypes.VISIBILITY_TYPE_PUBLIC_READ:
+		a.addPublicEntries(objectGRN, types.PublicReadObjectAllowedActions, types.AuditFlagPublic, types.AuditFlagPublicInherited)
+	}
+
+	// the policies are listed by action, a principal is audited once with its whole policy
+	audited := make(map[string]bool)
+	for _, action := range actions {
+		policies, err := listAllObjectPolicies(ctx, a.c, objectInfo.BucketName, objectInfo.ObjectName, uint32(action))
+		if err != nil {
+			return err
+		}
+		for _, meta := range policies {
+			principalType := permTypes.PrincipalType(meta.PrincipalType)
+			key := principalType.String() + "/" + meta.PrincipalValue
+			if audited[key] {
+				continue
+			}
+			audited[key] = true
+
+			var policy *permTypes.Policy
+			switch principalType {
+			case permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT:
+				if err = a.addAccount(meta.PrincipalValue); err != nil {
+					return err
+				}
+				policy, err = a.c.GetObjectPolicy(ctx, objectInfo.BucketName, objectInfo.ObjectName, meta.PrincipalValue)
+			case permTypes.PRINCIPAL_TYPE_GNFD_GROUP:
+				var groupID uint64
+				if groupID, err = strconv.ParseUint(meta.PrincipalValue, 10, 64); err != nil {
+					return err
+				}
+				a.addGroup(groupID).granting = true
+				policy, err = a.c.GetObjectPolicyOfGroup(ctx, objectInfo.BucketName, objectInfo.ObjectName, groupID)
+			default:
+				continue
+			}
+			if types.IsNotFound(err) {
+				continue
+			} else if err != nil {
+				return err
+			}
+			a.addPolicy(objectGRN, principalType, meta.PrincipalValue, policy)
+		}
+	}
+	return nil
+}
+
+// loadOwnerGroups adds the groups of the bucket owner, whose bucket policies are audited.
+func (a *permissionAudit) loadOwnerGroups(ctx context.Context) error {
+	startAfter := ""
+	for {
+		pageStart := startAfter
+		result, err := a.c.ListGroupsByOwner(ctx, types.GroupsOwnerPaginationOptions{Limit: maxListLimit, StartAfter: startAfter, Owner: a.bucket.Owner})
+		if err != nil {
+			return err
+		}
+		for _, group := range result.Groups {
+			if group.Group == nil {
+				continue
+			}
+			// the page continues after the last group with the info, including the removed ones
+			startAfter = group.Group.Id.String()
+			if !group.Removed {
+				a.addGroup(group.Group.Id.Uint64()).info = group.Group
+			}
+		}
+		if len(result.Groups) < maxListLimit {
+			return nil
+		}
+		if startAfter == pageStart {
+			return errors.New("fail to page the groups of the bucket owner: the groups of the page have no info")
+		}
+	}
+}
+
+// loadGroups lists the members of the groups and checks whether the groups still exist.
+func (a *permissionAudit) loadGroups(ctx context.Context) error {
+	for _, groupID := range a.groupOrder {
+		group := a.groups[groupID]
+		group.members = make(map[string]*time.Time)
+		startAfter := ""
+		for {
+			result, err := a.c.ListGroupMembers(ctx, int64(groupID), types.GroupMembersPaginationOptions{Limit: maxListLimit, StartAfter: startAfter})
+			if err != nil {
+				return err
+			}
+			for _, member := range result.Groups {
+				if member.Removed {
+					continue
+				}
+				if group.info == nil && member.Group != nil {
+					group.info = member.Group
+				}
+				var expiration *time.Time
+				if seconds, err := strconv.ParseInt(member.ExpirationTime, 10, 64); err == nil && seconds > 0 &&
+					seconds < storageTypes.MaxTimeStamp.Unix() {
+					t := time.Unix(seconds, 0).UTC()
+					expiration = &t
+				}
+				if _, ok := group.members[member.AccountID]; !ok {
+					group.memberOrder = append(group.memberOrder, member.AccountID)
+				}
+				group.members[member.AccountID] = expiration
+				if err = a.addAccount(member.AccountID); err != nil {
+					return err
+				}
+			}
+			if len(result.Groups) < maxListLimit {
+				break
+			}
+			startAfter = result.Groups[len(result.Groups)-1].AccountID
+		}
+
+		if group.info == nil {
+			_, err := a.c.chainClient.HeadGroupNFT(ctx, &storageTypes.QueryNFTRequest{TokenId: strconv.FormatUint(groupID, 10)})
+			if types.IsNotFound(err) {
+				group.exists = false
+			} else if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (a *permissionAudit) addPolicy(resource string, principalType permTypes.PrincipalType, principal string, policy *permTypes.Policy) {
+	a.policies = append(a.policies, auditedPolicy{resource: resource, principalType: principalType, principal: principal, policy: policy})
+}
+
+// auditBucketPolicies audits the bucket policies of the known groups and accounts.
+func (a *permissionAudit) auditBucketPolicies(ctx context.Context, bucketGRN string) error {
+	for _, groupID := range a.groupOrder {
+		if !a.groups[groupID].exists {
+			continue
+		}
+		policy, err := a.c.GetBucketPolicyOfGroup(ctx, a.bucket.BucketName, groupID)
+		if types.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		a.groups[groupID].granting = true
+		a.addPolicy(bucketGRN, permTypes.PRINCIPAL_TYPE_GNFD_GROUP, strconv.FormatUint(groupID, 10), policy)
+	}
+	for _, account := range a.accountOrder {
+		if strings.EqualFold(account, a.bucket.Owner) {
+			continue
+		}
+		policy, err := a.c.GetBucketPolicy(ctx, a.bucket.BucketName, account)
+		if types.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		a.addPolicy(bucketGRN, permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT, account, policy)
+	}
+	return nil
+}
+
+// auditGroupPolicies audits the policies on the groups granting permissions, since the accounts allowed to update
+// the members of these groups control the access to the bucket too.
+func (a *permissionAudit) auditGroupPolicies(ctx context.Context) error {
+	for _, groupID := range a.groupOrder {
+		group := a.groups[groupID]
+		if !group.granting || group.info == nil {
+			continue
+		}
+		groupOwner, err := sdk.AccAddressFromHexUnsafe(group.info.Owner)
+		if err != nil {
+			return err
+		}
+		groupGRN := gnfdTypes.NewGroupGRN(groupOwner, group.info.GroupName).String()
+		for _, account := range a.accountOrder {
+			if strings.EqualFold(account, group.info.Owner) {
+				continue
+			}
+			resp, err := a.c.chainClient.QueryPolicyForAccount(ctx, &storageTypes.QueryPolicyForAccountRequest{
+				Resource:         groupGRN,
+				PrincipalAddress: account,
+			})
+			if types.IsNotFound(err) {
+				continue
+			} else if err != nil {
+				return err
+			}
+			a.addPolicy(groupGRN, permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT, account, resp.Policy)
+		}
+	}
+	return nil
+}
+
+// addPolicyEntries adds an entry per action of every statement of the policy, the entries of a group policy are
+// repeated for the members of the group.
+func (a *permissionAudit) addPolicyEntries(audited auditedPolicy) {
+	for _, statement := range audited.policy.Statements {
+		expiration, flags := a.expiration(audited.policy, statement)
+		for _, action := range statement.Actions {
+			entry := types.PermissionAuditEntry{
+				Resource:   audited.resource,
+				Principal:  audited.principal,
+				Action:     action.String(),
+				Effect:     statement.Effect.String(),
+				Scope:      statement.Resources,
+				Expiration: expiration,
+				Flags:      flags,
+			}
+			if audited.principalType == permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT {
+				entry.PrincipalType = types.AuditPrincipalAccount
+				a.report.Entries = append(a.report.Entries, entry)
+				continue
+			}
+
+			entry.PrincipalType = types.AuditPrincipalGroup
+			groupID, _ := strconv.ParseUint(audited.principal, 10, 64)
+			group := a.groups[groupID]
+			if !group.exists {
+				entry.Flags = append(append([]string{}, flags...), types.AuditFlagPrincipalNotFound)
+			}
+			a.report.Entries = append(a.report.Entries, entry)
+			for _, member := range group.memberOrder {
+				memberEntry := entry
+				memberEntry.PrincipalType = types.AuditPrincipalMember
+				memberEntry.Principal = member
+				memberEntry.Group = audited.principal
+				memberEntry.Flags = append([]string(nil), entry.Flags...)
+				if memberExpiration := group.members[member]; memberExpiration != nil {
+					if memberEntry.Expiration == nil || memberExpiration.Before(*memberEntry.Expiration) {
+						memberEntry.Expiration = memberExpiration
+					}
+					if !memberExpiration.After(a.now) {
+						memberEntry.Flags = append(memberEntry.Flags, types.AuditFlagExpiredMember)
+					}
+				}
+				a.report.Entries = append(a.report.Entries, memberEntry)
+			}
+		}
+	}
+}
+
+// expiration returns the earliest expiration time of the policy and the statement, and the flags if they have expired.
+func (a *permissionAudit) expiration(policy *permTypes.Policy, statement *permTypes.Statement) (*time.Time, []string) {
+	var (
+		expiration *time.Time
+		flags      []string
+	)
+	if policy.ExpirationTime != nil {
+		t := policy.ExpirationTime.UTC()
+		expiration = &t
+		if policy.ExpirationTime.Before(a.now) {
+			flags = append(flags, types.AuditFlagExpiredPolicy)
+		}
+	}
+	if statement.ExpirationTime != nil {
+		if expiration == nil || statement.ExpirationTime.Before(*expiration) {
+			t := statement.ExpirationTime.UTC()
+			expiration = &t
+		}
+		if statement.ExpirationTime.Before(a.now) {
+			flags = append(flags, types.AuditFlagExpiredStatement)
+		}
+	}
+	return expiration, flags
+}
+
+func sortedActions(actions map[permTypes.ActionType]bool) []permTypes.ActionType {
+	sorted := make([]permTypes.ActionType, 0, len(actions))
+	for action, allowed := range actions {
+		if allowed {
+			sorted = append(sorted, action)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
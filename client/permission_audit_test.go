@@ -0,0 +1,102 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+)
+
+func TestPermissionAuditPolicyEntries(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	past, soon, later := now.Add(-time.Hour), now.Add(time.Hour), now.Add(2*time.Hour)
+	statement := func(expiration *time.Time, actions ...permTypes.ActionType) *permTypes.Statement {
+		return &permTypes.Statement{Effect: permTypes.EFFECT_ALLOW, Actions: actions, ExpirationTime: expiration}
+	}
+	entry := func(principalType, principal, group string, action permTypes.ActionType, expiration *time.Time, flags ...string) types.PermissionAuditEntry {
+		return types.PermissionAuditEntry{
+			Resource: "grn:o::bucket/object", PrincipalType: principalType, Principal: principal, Group: group,
+			Action: action.String(), Effect: permTypes.EFFECT_ALLOW.String(), Expiration: expiration, Flags: flags,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		policy  auditedPolicy
+		group   *auditGroup
+		entries []types.PermissionAuditEntry
+	}{
+		{
+			"account policy with an entry per action",
+			auditedPolicy{principalType: permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT, principal: "0x01", policy: &permTypes.Policy{
+				Statements: []*permTypes.Statement{statement(nil, permTypes.ACTION_GET_OBJECT, permTypes.ACTION_DELETE_OBJECT)},
+			}},
+			nil,
+			[]types.PermissionAuditEntry{
+				entry(types.AuditPrincipalAccount, "0x01", "", permTypes.ACTION_GET_OBJECT, nil),
+				entry(types.AuditPrincipalAccount, "0x01", "", permTypes.ACTION_DELETE_OBJECT, nil),
+			},
+		},
+		{
+			"earliest expiration of the policy and the statement",
+			auditedPolicy{principalType: permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT, principal: "0x01", policy: &permTypes.Policy{
+				ExpirationTime: &later,
+				Statements:     []*permTypes.Statement{statement(&past, permTypes.ACTION_GET_OBJECT)},
+			}},
+			nil,
+			[]types.PermissionAuditEntry{
+				entry(types.AuditPrincipalAccount, "0x01", "", permTypes.ACTION_GET_OBJECT, &past, types.AuditFlagExpiredStatement),
+			},
+		},
+		{
+			"expired policy",
+			auditedPolicy{principalType: permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT, principal: "0x01", policy: &permTypes.Policy{
+				ExpirationTime: &past,
+				Statements:     []*permTypes.Statement{statement(&soon, permTypes.ACTION_GET_OBJECT)},
+			}},
+			nil,
+			[]types.PermissionAuditEntry{
+				entry(types.AuditPrincipalAccount, "0x01", "", permTypes.ACTION_GET_OBJECT, &past, types.AuditFlagExpiredPolicy),
+			},
+		},
+		{
+			"group policy repeated for the members",
+			auditedPolicy{principalType: permTypes.PRINCIPAL_TYPE_GNFD_GROUP, principal: "7", policy: &permTypes.Policy{
+				ExpirationTime: &later,
+				Statements:     []*permTypes.Statement{statement(nil, permTypes.ACTION_GET_OBJECT)},
+			}},
+			&auditGroup{id: 7, exists: true, memberOrder: []string{"0x02", "0x03", "0x04"},
+				members: map[string]*time.Time{"0x02": nil, "0x03": &soon, "0x04": &past}},
+			[]types.PermissionAuditEntry{
+				entry(types.AuditPrincipalGroup, "7", "", permTypes.ACTION_GET_OBJECT, &later),
+				entry(types.AuditPrincipalMember, "0x02", "7", permTypes.ACTION_GET_OBJECT, &later),
+				entry(types.AuditPrincipalMember, "0x03", "7", permTypes.ACTION_GET_OBJECT, &soon),
+				entry(types.AuditPrincipalMember, "0x04", "7", permTypes.ACTION_GET_OBJECT, &past, types.AuditFlagExpiredMember),
+			},
+		},
+		{
+			"policy of a deleted group",
+			auditedPolicy{principalType: permTypes.PRINCIPAL_TYPE_GNFD_GROUP, principal: "8", policy: &permTypes.Policy{
+				Statements: []*permTypes.Statement{statement(nil, permTypes.ACTION_GET_OBJECT)},
+			}},
+			&auditGroup{id: 8, members: map[string]*time.Time{}},
+			[]types.PermissionAuditEntry{
+				entry(types.AuditPrincipalGroup, "8", "", permTypes.ACTION_GET_OBJECT, nil, types.AuditFlagPrincipalNotFound),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.policy.resource = "grn:o::bucket/object"
+			audit := &permissionAudit{now: now, report: &types.PermissionAuditReport{}, groups: make(map[uint64]*auditGroup)}
+			if tt.group != nil {
+				audit.groups[tt.group.id] = tt.group
+			}
+			audit.addPolicyEntries(tt.policy)
+			require.Equal(t, tt.entries, audit.report.Entries)
+		})
+	}
+}
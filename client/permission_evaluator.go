@@ -0,0 +1,164 @@
+package client
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+)
+
+// PermissionEvaluator - Explain the permission of a user on a bucket or an object, while IsBucketPermissionAllowed and
+// IsObjectPermissionAllowed only return the effect decided by the chain.
+//
+// The bucket and object policies of the user and of the groups the user is a member of are collected into a
+// types.PermissionSnapshot, which is evaluated locally by types.EvaluatePermission with the same rules as the chain.
+type PermissionEvaluator struct {
+	client IClient
+}
+
+// NewPermissionEvaluator - Create a permission evaluator collecting the state through the client.
+//
+// - client: The client reading the buckets, the objects, the groups and the policies.
+//
+// - ret: The new permission evaluator.
+func NewPermissionEvaluator(client IClient) *PermissionEvaluator {
+	return &PermissionEvaluator{client: client}
+}
+
+// Snapshot - Collect the state deciding the permission of the user on the bucket or the object.
+//
+// - ctx: Context variables for the current API call.
+//
+// - userAddr: The HEX-encoded string of the user address, it is empty for the anonymous users.
+//
+// - bucketName: The bucket name identifies the bucket.
+//
+// - objectName: The object name identifies the object, it is empty when the permission on the bucket is collected.
+//
+// - ret1: The snapshot which can be evaluated offline by types.EvaluatePermission.
+//
+// - ret2: Return error when the state can not be read, otherwise return nil.
+func (e *PermissionEvaluator) Snapshot(ctx context.Context, userAddr, bucketName, objectName string) (*types.PermissionSnapshot, error) {
+	bucketInfo, err := e.client.HeadBucket(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &types.PermissionSnapshot{Operator: userAddr, Bucket: bucketInfo}
+	if objectName != "" {
+		objectDetail, err := e.client.HeadObject(ctx, bucketName, objectName)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Object = objectDetail.ObjectInfo
+	}
+	// the policies of the anonymous users and the owners are never evaluated
+	if userAddr == "" || strings.EqualFold(bucketInfo.Owner, userAddr) && snapshot.Object == nil ||
+		snapshot.Object != nil && strings.EqualFold(snapshot.Object.Owner, userAddr) {
+		return snapshot, nil
+	}
+
+	if snapshot.BucketPolicy, err = ignoreNotFound(e.client.GetBucketPolicy(ctx, bucketName, userAddr)); err != nil {
+		return nil, err
+	}
+	if objectName != "" {
+		if snapshot.ObjectPolicy, err = ignoreNotFound(e.client.GetObjectPolicy(ctx, bucketName, objectName, userAddr)); err != nil {
+			return nil, err
+		}
+	}
+
+	startAfter := ""
+	for {
+		groups, err := e.client.ListGroupsByAccount(ctx, types.GroupsPaginationOptions{Limit: maxListLimit, StartAfter: startAfter, Account: userAddr})
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range groups.Groups {
+			if group.Removed || group.Group == nil {
+				continue
+			}
+			groupSnapshot := types.GroupPermissionSnapshot{GroupID: group.Group.Id.Uint64(), GroupName: group.Group.GroupName}
+			if seconds, err := strconv.ParseInt(group.ExpirationTime, 10, 64); err == nil && seconds > 0 {
+				expiration := time.Unix(seconds, 0)
+				groupSnapshot.MemberExpiration = &expiration
+			}
+			if groupSnapshot.BucketPolicy, err = ignoreNotFound(e.client.GetBucketPolicyOfGroup(ctx, bucketName, groupSnapshot.GroupID)); err != nil {
+				return nil, err
+			}
+			if objectName != "" {
+				if groupSnapshot.ObjectPolicy, err = ignoreNotFound(e.client.GetObjectPolicyOfGroup(ctx, bucketName, objectName, groupSnapshot.GroupID)); err != nil {
+					return nil, err
+				}
+			}
+			if groupSnapshot.BucketPolicy != nil || groupSnapshot.ObjectPolicy != nil {
+				snapshot.Groups = append(snapshot.Groups, groupSnapshot)
+			}
+		}
+		if len(groups.Groups) < maxListLimit {
+			return snapshot, nil
+		}
+		startAfter = groups.Groups[len(groups.Groups)-1].Group.Id.String()
+	}
+}
+
+// ExplainBucketPermission - Evaluate the permission of the user on the bucket and explain the decision.
+//
+// - ctx: Context variables for the current API call.
+//
+// - userAddr: The HEX-encoded string of the user address, it is empty for the anonymous users.
+//
+// - bucketName: The bucket name identifies the bucket.
+//
+// - action: The action to be evaluated.
+//
+// - opts: The options to set the evaluation time and the wanted size of ACTION_CREATE_OBJECT.
+//
+// - ret1: The decision with the reason and the statements producing it.
+//
+// - ret2: Return error when the state can not be read, otherwise return nil.
+func (e *PermissionEvaluator) ExplainBucketPermission(ctx context.Context, userAddr, bucketName string, action permTypes.ActionType,
+	opts types.EvaluatePermissionOptions,
+) (*types.PermissionDecision, error) {
+	snapshot, err := e.Snapshot(ctx, userAddr, bucketName, "")
+	if err != nil {
+		return nil, err
+	}
+	return types.EvaluatePermission(snapshot, action, opts)
+}
+
+// ExplainObjectPermission - Evaluate the permission of the user on the object and explain the decision.
+//
+// - ctx: Context variables for the current API call.
+//
+// - userAddr: The HEX-encoded string of the user address, it is empty for the anonymous users.
+//
+// - bucketName: The bucket name identifies the bucket.
+//
+// - objectName: The object name identifies the object.
+//
+// - action: The action to be evaluated.
+//
+// - opts: The options to set the evaluation time.
+//
+// - ret1: The decision with the reason and the statements producing it.
+//
+// - ret2: Return error when the state can not be read, otherwise return nil.
+func (e *PermissionEvaluator) ExplainObjectPermission(ctx context.Context, userAddr, bucketName, objectName string, action permTypes.ActionType,
+	opts types.EvaluatePermissionOptions,
+) (*types.PermissionDecision, error) {
+	snapshot, err := e.Snapshot(ctx, userAddr, bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	return types.EvaluatePermission(snapshot, action, opts)
+}
+
+// ignoreNotFound returns a nil policy instead of the not found error.
+func ignoreNotFound(policy *permTypes.Policy, err error) (*permTypes.Policy, error) {
+	if types.IsNotFound(err) {
+		return nil, nil
+	}
+	return policy, err
+}
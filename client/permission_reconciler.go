@@ -0,0 +1,641 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/types/resource"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// PermissionChangeType - The type of a change in the permission plan.
+type PermissionChangeType string
+
+const (
+	PermissionChangeCreate PermissionChangeType = "create"
+	PermissionChangeUpdate PermissionChangeType = "update"
+	PermissionChangeDelete PermissionChangeType = "delete"
+)
+
+// PermissionChange - A change to reconcile the chain with the desired permissions.
+type PermissionChange struct {
+	Type PermissionChangeType
+	// Resource is the changed resource, e.g. "group analysts" or "bucket policy of reports for group analysts".
+	Resource string
+	// Details describes the change, e.g. the members to add and to remove.
+	Details string
+
+	// stage is the stage in which the change is applied, the groups are created before the other changes which may
+	// refer to their ids.
+	stage int
+	msgs  func(ctx context.Context) ([]sdk.Msg, error)
+}
+
+// String returns the change in the plan format, e.g. "+ create group analysts".
+func (c *PermissionChange) String() string {
+	symbol := map[PermissionChangeType]string{PermissionChangeCreate: "+", PermissionChangeUpdate: "~", PermissionChangeDelete: "-"}[c.Type]
+	s := fmt.Sprintf("%s %s %s", symbol, c.Type, c.Resource)
+	if c.Details != "" {
+		s += ": " + c.Details
+	}
+	return s
+}
+
+// PermissionPlan - The changes to reconcile the chain with the desired permissions, it is applied by PermissionReconciler.Apply.
+type PermissionPlan struct {
+	// Owner is the reconciling account owning the groups.
+	Owner   string
+	Changes []*PermissionChange
+}
+
+// Empty returns whether the chain is in the desired state already.
+func (p *PermissionPlan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String returns the plan in a human-readable format, one change per line followed by a summary.
+func (p *PermissionPlan) String() string {
+	var b strings.Builder
+	counts := make(map[PermissionChangeType]int)
+	fmt.Fprintf(&b, "Permission plan of %s:\n", p.Owner)
+	for _, change := range p.Changes {
+		fmt.Fprintf(&b, "  %s\n", change)
+		counts[change.Type]++
+	}
+	if p.Empty() {
+		b.WriteString("No changes, the permissions are in the desired state.\n")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "Plan: %d to create, %d to update, %d to delete.\n",
+		counts[PermissionChangeCreate], counts[PermissionChangeUpdate], counts[PermissionChangeDelete])
+	return b.String()
+}
+
+// PermissionReconciler - Reconcile the groups, the group members and the policies on chain with the desired
+// permissions in a plan and apply flow.
+//
+// The current state is read through HeadGroup, ListGroupMembers, GetBucketPolicy, GetBucketPolicyOfGroup,
+// GetObjectPolicy, GetObjectPolicyOfGroup, GetGroupPolicy and ListObjectPolicies. Only the groups and the policies in
+// the desired permissions are managed, except that the undesired members of the desired groups are removed, and the
+// policies of the undesired principals on the desired objects are deleted if PruneObjectPolicies is set.
+// The changes are batched into multi-msg txs, the groups are created first so that the policies can refer to them.
+type PermissionReconciler struct {
+	client IClient
+	opts   types.PermissionReconcilerOptions
+}
+
+// NewPermissionReconciler - Create a permission reconciler.
+//
+// - client: The client reading the current state and broadcasting the txs.
+//
+// - opts: The options of the reconciler, the groups are owned by opts.Account or the default account of the client.
+//
+// - ret: The new permission reconciler.
+func NewPermissionReconciler(client IClient, opts types.PermissionReconcilerOptions) *PermissionReconciler {
+	if opts.MaxMsgsPerTx <= 0 {
+		opts.MaxMsgsPerTx = types.DefaultMaxMsgsPerTx
+	}
+	return &PermissionReconciler{client: client, opts: opts}
+}
+
+// maxListLimit is the maximum number of records returned by a page of the list APIs.
+const maxListLimit = 1000
+
+// reconcileState is the state of a plan, the ids of the groups are resolved lazily.
+type reconcileState struct {
+	owner       sdk.AccAddress
+	groupIDs    map[string]sdkmath.Uint // the ids of the existing groups of the owner
+	newGroups   map[string]bool         // the groups to be created in the plan
+	groupExists map[string]bool
+}
+
+// Plan - Read the current state and compute the changes to reconcile it with the desired permissions, nothing is changed on chain.
+//
+// - ctx: Context variables for the current API call.
+//
+// - desired: The desired permissions.
+//
+// - ret1: The plan of the changes.
+//
+// - ret2: Return error when the desired permissions are invalid or the current state can not be read, otherwise return nil.
+func (r *PermissionReconciler) Plan(ctx context.Context, desired *types.DesiredPermissions) (*PermissionPlan, error) {
+	if err := desired.Validate(); err != nil {
+		return nil, err
+	}
+	signer, err := r.signer()
+	if err != nil {
+		return nil, err
+	}
+	state := &reconcileState{
+		owner:       signer.GetAddress(),
+		groupIDs:    make(map[string]sdkmath.Uint),
+		newGroups:   make(map[string]bool),
+		groupExists: make(map[string]bool),
+	}
+	plan := &PermissionPlan{Owner: state.owner.String()}
+
+	for _, group := range desired.Groups {
+		changes, err := r.planGroup(ctx, state, group)
+		if err != nil {
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, changes...)
+	}
+	for i := range desired.BucketPolicies {
+		if err = r.planPolicy(ctx, state, plan, resource.RESOURCE_TYPE_BUCKET, &desired.BucketPolicies[i]); err != nil {
+			return nil, err
+		}
+	}
+	for i := range desired.ObjectPolicies {
+		if err = r.planPolicy(ctx, state, plan, resource.RESOURCE_TYPE_OBJECT, &desired.ObjectPolicies[i]); err != nil {
+			return nil, err
+		}
+	}
+	if r.opts.PruneObjectPolicies {
+		if err = r.planObjectPolicyPruning(ctx, state, plan, desired.ObjectPolicies); err != nil {
+			return nil, err
+		}
+	}
+	for i := range desired.GroupPolicies {
+		if err = r.planPolicy(ctx, state, plan, resource.RESOURCE_TYPE_GROUP, &desired.GroupPolicies[i]); err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
+
+// Apply - Apply the changes of the plan in batched multi-msg txs, the txs are confirmed one by one.
+//
+// - ctx: Context variables for the current API call.
+//
+// - plan: The plan returned by Plan.
+//
+// - ret1: The hashes of the applied txs.
+//
+// - ret2: Return error when a tx failed, the txs applied before it are kept and the plan should be computed again.
+func (r *PermissionReconciler) Apply(ctx context.Context, plan *PermissionPlan) ([]string, error) {
+	signer, err := r.signer()
+	if err != nil {
+		return nil, err
+	}
+	if signer.GetAddress().String() != plan.Owner {
+		return nil, fmt.Errorf("the plan of %s can not be applied by %s", plan.Owner, signer.GetAddress())
+	}
+
+	var txHashes []string
+	for stage := 0; stage < 2; stage++ {
+		var msgs []sdk.Msg
+		for _, change := range plan.Changes {
+			if change.stage != stage {
+				continue
+			}
+			changeMsgs, err := change.msgs(ctx)
+			if err != nil {
+				return txHashes, fmt.Errorf("fail to %s %s: %w", change.Type, change.Resource, err)
+			}
+			msgs = append(msgs, changeMsgs...)
+		}
+		stageTxHashes, err := broadcastInBatches(ctx, r.client, signer, r.opts.TxOpts, msgs, r.opts.MaxMsgsPerTx)
+		txHashes = append(txHashes, stageTxHashes...)
+		if err != nil {
+			return txHashes, err
+		}
+	}
+	return txHashes, nil
+}
+
+// Reconcile - Plan the changes and apply them unless DryRun is set, the plan is logged before it is applied.
+//
+// - ctx: Context variables for the current API call.
+//
+// - desired: The desired permissions.
+//
+// - ret1: The plan of the changes.
+//
+// - ret2: The hashes of the applied txs, it is empty in the dry run.
+//
+// - ret3: Return error when the plan or any tx failed, otherwise return nil.
+func (r *PermissionReconciler) Reconcile(ctx context.Context, desired *types.DesiredPermissions) (*PermissionPlan, []string, error) {
+	plan, err := r.Plan(ctx, desired)
+	if err != nil {
+		return nil, nil, err
+	}
+	log.Info().Msg(plan.String())
+	if r.opts.DryRun || plan.Empty() {
+		return plan, nil, nil
+	}
+	txHashes, err := r.Apply(ctx, plan)
+	return plan, txHashes, err
+}
+
+func (r *PermissionReconciler) signer() (*types.Account, error) {
+	if r.opts.Account != nil {
+		return r.opts.Account, nil
+	}
+	return r.client.GetDefaultAccount()
+}
+
+// headGroup returns whether the group of the owner exists, the id of an existing group is cached in the state.
+func (r *PermissionReconciler) headGroup(ctx context.Context, state *reconcileState, groupName string) (bool, error) {
+	if exists, ok := state.groupExists[groupName]; ok {
+		return exists, nil
+	}
+	groupInfo, err := r.client.HeadGroup(ctx, groupName, state.owner.String())
+	if err != nil && !types.IsNotFound(err) {
+		return false, err
+	}
+	state.groupExists[groupName] = err == nil
+	if err == nil {
+		state.groupIDs[groupName] = groupInfo.Id
+	}
+	return err == nil, nil
+}
+
+// groupID returns the id of the group of the owner, the groups created in the plan are resolved when it is applied.
+func (r *PermissionReconciler) groupID(ctx context.Context, state *reconcileState, groupName string) (sdkmath.Uint, error) {
+	if id, ok := state.groupIDs[groupName]; ok {
+		return id, nil
+	}
+	groupInfo, err := r.client.HeadGroup(ctx, groupName, state.owner.String())
+	if err != nil {
+		return sdkmath.Uint{}, err
+	}
+	state.groupIDs[groupName] = groupInfo.Id
+	return groupInfo.Id, nil
+}
+
+func (r *PermissionReconciler) planGroup(ctx context.Context, state *reconcileState, group types.DesiredGroup) ([]*PermissionChange, error) {
+	exists, err := r.headGroup(ctx, state, group.Name)
+	if err != nil {
+		return nil, err
+	}
+	var changes []*PermissionChange
+	current := make(map[string]time.Time)
+	if !exists {
+		state.newGroups[group.Name] = true
+		changes = append(changes, &PermissionChange{
+			Type:     PermissionChangeCreate,
+			Resource: "group " + group.Name,
+			msgs: func(context.Context) ([]sdk.Msg, error) {
+				return []sdk.Msg{storageTypes.NewMsgCreateGroup(state.owner, group.Name, group.Extra)}, nil
+			},
+		})
+	} else if current, err = listGroupMemberExpirations(ctx, r.client, state.groupIDs[group.Name]); err != nil {
+		return nil, err
+	}
+
+	diff, err := diffGroupMembers(current, group.Members)
+	if err != nil {
+		return nil, fmt.Errorf("the group %s: %w", group.Name, err)
+	}
+	if diff.empty() {
+		return changes, nil
+	}
+
+	changes = append(changes, &PermissionChange{
+		Type:     PermissionChangeUpdate,
+		Resource: "members of group " + group.Name,
+		Details:  strings.Join(diff.details(), ", "),
+		stage:    1,
+		msgs: func(context.Context) ([]sdk.Msg, error) {
+			return diff.msgs(state.owner, state.owner, group.Name), nil
+		},
+	})
+	return changes, nil
+}
+
+// policyTarget is the resource and the principal of a desired policy.
+type policyTarget struct {
+	resourceType resource.ResourceType
+	grn          *gnfdTypes.GRN
+	description  string
+	// principal is resolved when the plan is applied, since it can be a group created in the plan
+	principal func(ctx context.Context) (*permTypes.Principal, error)
+	// current is the current policy, it is nil if the policy does not exist
+	current *permTypes.Policy
+}
+
+func (r *PermissionReconciler) resolvePolicyTarget(ctx context.Context, state *reconcileState, resourceType resource.ResourceType,
+	desired *types.DesiredPolicy,
+) (*policyTarget, error) {
+	target := &policyTarget{resourceType: resourceType}
+	switch resourceType {
+	case resource.RESOURCE_TYPE_BUCKET:
+		target.grn = gnfdTypes.NewBucketGRN(desired.Bucket)
+		target.description = "bucket policy of " + desired.Bucket
+	case resource.RESOURCE_TYPE_OBJECT:
+		target.grn = gnfdTypes.NewObjectGRN(desired.Bucket, desired.Object)
+		target.description = "object policy of " + desired.Bucket + "/" + desired.Object
+	case resource.RESOURCE_TYPE_GROUP:
+		target.grn = gnfdTypes.NewGroupGRN(state.owner, desired.Group)
+		target.description = "group policy of " + desired.Group
+	}
+
+	var err error
+	if desired.PrincipalGroup != "" {
+		groupName := desired.PrincipalGroup
+		target.description += " for group " + groupName
+		target.principal = func(ctx context.Context) (*permTypes.Principal, error) {
+			groupID, err := r.groupID(ctx, state, groupName)
+			if err != nil {
+				return nil, err
+			}
+			return permTypes.NewPrincipalWithGroupId(groupID), nil
+		}
+		var exists bool
+		if exists, err = r.headGroup(ctx, state, groupName); err != nil {
+			return nil, err
+		}
+		if !exists && !state.newGroups[groupName] {
+			return nil, fmt.Errorf("the principal group %s of the %s does not exist and is not desired", groupName, target.description)
+		}
+		if exists {
+			target.current, err = r.getPolicyOfGroup(ctx, desired, state.groupIDs[groupName].Uint64())
+		}
+	} else {
+		var principalStr types.Principal
+		if principalStr, err = desired.ToPrincipal(); err != nil {
+			return nil, err
+		}
+		principal := &permTypes.Principal{}
+		if err = principal.Unmarshal([]byte(principalStr)); err != nil {
+			return nil, err
+		}
+		target.principal = func(context.Context) (*permTypes.Principal, error) { return principal, nil }
+		if desired.Principal.GroupID != 0 {
+			target.description += fmt.Sprintf(" for group id %d", desired.Principal.GroupID)
+			target.current, err = r.getPolicyOfGroup(ctx, desired, desired.Principal.GroupID)
+		} else {
+			target.description += " for account " + principal.Value
+			target.current, err = r.getPolicyOfAccount(ctx, state, desired, principal.Value)
+		}
+	}
+	if err != nil && !types.IsNotFound(err) {
+		return nil, err
+	}
+	return target, nil
+}
+
+func (r *PermissionReconciler) getPolicyOfGroup(ctx context.Context, desired *types.DesiredPolicy, groupID uint64) (*permTypes.Policy, error) {
+	if desired.Object != "" {
+		return r.client.GetObjectPolicyOfGroup(ctx, desired.Bucket, desired.Object, groupID)
+	}
+	return r.client.GetBucketPolicyOfGroup(ctx, desired.Bucket, groupID)
+}
+
+func (r *PermissionReconciler) getPolicyOfAccount(ctx context.Context, state *reconcileState, desired *types.DesiredPolicy,
+	principalAddr string,
+) (*permTypes.Policy, error) {
+	switch {
+	case desired.Group != "":
+		if state.newGroups[desired.Group] {
+			return nil, nil
+		}
+		return r.client.GetGroupPolicy(ctx, desired.Group, principalAddr)
+	case desired.Object != "":
+		return r.client.GetObjectPolicy(ctx, desired.Bucket, desired.Object, principalAddr)
+	default:
+		return r.client.GetBucketPolicy(ctx, desired.Bucket, principalAddr)
+	}
+}
+
+func (r *PermissionReconciler) planPolicy(ctx context.Context, state *reconcileState, plan *PermissionPlan,
+	resourceType resource.ResourceType, desired *types.DesiredPolicy,
+) error {
+	target, err := r.resolvePolicyTarget(ctx, state, resourceType, desired)
+	if err != nil {
+		return err
+	}
+	if desired.Absent {
+		if target.current != nil {
+			plan.Changes = append(plan.Changes, r.deletePolicyChange(state, target))
+		}
+		return nil
+	}
+
+	statements, err := desired.ToStatements()
+	if err != nil {
+		return err
+	}
+	change := &PermissionChange{Type: PermissionChangeCreate, Resource: target.description, stage: 1}
+	if target.current != nil {
+		equal, err := policyEqual(target.current, &desired.PolicyDocument)
+		if err != nil {
+			return err
+		}
+		if equal {
+			return nil
+		}
+		change.Type = PermissionChangeUpdate
+	}
+	change.Details = statementsDescription(statements)
+	change.msgs = func(ctx context.Context) ([]sdk.Msg, error) {
+		principal, err := target.principal(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{storageTypes.NewMsgPutPolicy(state.owner, target.grn.String(), principal, statements, desired.Expiration)}, nil
+	}
+	plan.Changes = append(plan.Changes, change)
+	return nil
+}
+
+func (r *PermissionReconciler) deletePolicyChange(state *reconcileState, target *policyTarget) *PermissionChange {
+	return &PermissionChange{
+		Type:     PermissionChangeDelete,
+		Resource: target.description,
+		stage:    1,
+		msgs: func(ctx context.Context) ([]sdk.Msg, error) {
+			principal, err := target.principal(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return []sdk.Msg{storageTypes.NewMsgDeletePolicy(state.owner, target.grn.String(), principal)}, nil
+		},
+	}
+}
+
+// planObjectPolicyPruning deletes the policies of the principals which are not desired on the desired objects.
+func (r *PermissionReconciler) planObjectPolicyPruning(ctx context.Context, state *reconcileState, plan *PermissionPlan,
+	desiredPolicies []types.DesiredPolicy,
+) error {
+	type objectKey struct{ bucket, object string }
+	desiredPrincipals := make(map[objectKey]map[string]bool)
+	var objects []objectKey
+	for i := range desiredPolicies {
+		desired := &desiredPolicies[i]
+		key := objectKey{desired.Bucket, desired.Object}
+		if desiredPrincipals[key] == nil {
+			desiredPrincipals[key] = make(map[string]bool)
+			objects = append(objects, key)
+		}
+		// the absent principals are handled too, since their policies have been deleted by planPolicy
+		switch {
+		case desired.PrincipalGroup != "":
+			if id, ok := state.groupIDs[desired.PrincipalGroup]; ok {
+				desiredPrincipals[key]["group:"+id.String()] = true
+			}
+		case desired.Principal.GroupID != 0:
+			desiredPrincipals[key]["group:"+strconv.FormatUint(desired.Principal.GroupID, 10)] = true
+		default:
+			addr, err := sdk.AccAddressFromHexUnsafe(desired.Principal.Account)
+			if err != nil {
+				return err
+			}
+			desiredPrincipals[key]["account:"+addr.String()] = true
+		}
+	}
+
+	actions := make([]int32, 0, len(permTypes.ObjectAllowedActions))
+	for action := range permTypes.ObjectAllowedActions {
+		actions = append(actions, int32(action))
+	}
+	sort.Slice(actions, func(i, j int) bool { return actions[i] < actions[j] })
+	for _, key := range objects {
+		pruned := make(map[string]bool)
+		for _, action := range actions {
+			policies, err := listAllObjectPolicies(ctx, r.client, key.bucket, key.object, uint32(action))
+			if err != nil {
+				return err
+			}
+			for _, meta := range policies {
+				principal := &permTypes.Principal{Type: permTypes.PrincipalType(meta.PrincipalType), Value: meta.PrincipalValue}
+				var principalKey, principalDescription string
+				switch principal.Type {
+				case permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT:
+					addr, err := sdk.AccAddressFromHexUnsafe(principal.Value)
+					if err != nil {
+						return err
+					}
+					principalKey = "account:" + addr.String()
+					principalDescription = "account " + addr.String()
+				case permTypes.PRINCIPAL_TYPE_GNFD_GROUP:
+					principalKey = "group:" + principal.Value
+					principalDescription = "group id " + principal.Value
+				default:
+					continue
+				}
+				if desiredPrincipals[key][principalKey] || pruned[principalKey] {
+					continue
+				}
+				pruned[principalKey] = true
+				plan.Changes = append(plan.Changes, r.deletePolicyChange(state, &policyTarget{
+					grn:         gnfdTypes.NewObjectGRN(key.bucket, key.object),
+					description: fmt.Sprintf("object policy of %s/%s for %s", key.bucket, key.object, principalDescription),
+					principal:   func(context.Context) (*permTypes.Principal, error) { return principal, nil },
+				}))
+			}
+		}
+	}
+	return nil
+}
+
+// listAllObjectPolicies pages through the policies of the action on the object. The SP pages the policies by their ids,
+// which are not in the response, so the id of the last policy of a full page is queried from the chain.
+func listAllObjectPolicies(ctx context.Context, client IClient, bucketName, objectName string, action uint32) ([]*types.PolicyMeta, error) {
+	var (
+		policies   []*types.PolicyMeta
+		startAfter string
+	)
+	for {
+		page, err := client.ListObjectPolicies(ctx, objectName, bucketName, action,
+			types.ListObjectPoliciesOptions{Limit: maxListLimit, StartAfter: startAfter})
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, page.Policies...)
+		if len(page.Policies) < maxListLimit {
+			return policies, nil
+		}
+
+		last := page.Policies[len(page.Policies)-1]
+		var policy *permTypes.Policy
+		switch permTypes.PrincipalType(last.PrincipalType) {
+		case permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT:
+			policy, err = client.GetObjectPolicy(ctx, bucketName, objectName, last.PrincipalValue)
+		case permTypes.PRINCIPAL_TYPE_GNFD_GROUP:
+			var groupID uint64
+			if groupID, err = strconv.ParseUint(last.PrincipalValue, 10, 64); err == nil {
+				policy, err = client.GetObjectPolicyOfGroup(ctx, bucketName, objectName, groupID)
+			}
+		default:
+			err = fmt.Errorf("unknown principal type %d", last.PrincipalType)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("fail to page the policies of %s/%s: %w", bucketName, objectName, err)
+		}
+		if policy.Id.String() == startAfter {
+			return nil, fmt.Errorf("fail to page the policies of %s/%s: the page after the policy %s does not advance",
+				bucketName, objectName, startAfter)
+		}
+		startAfter = policy.Id.String()
+	}
+}
+
+// policyEqual compares the statements and the expiration time of the current policy with the desired ones.
+func policyEqual(current *permTypes.Policy, desired *types.PolicyDocument) (bool, error) {
+	currentDoc, err := types.NewPolicyDocument(current)
+	if err != nil {
+		return false, err
+	}
+	normalize := func(doc *types.PolicyDocument) ([]byte, error) {
+		statements, err := doc.ToStatements()
+		if err != nil {
+			return nil, err
+		}
+		normalized, err := types.NewPolicyDocument(&permTypes.Policy{Statements: statements, ExpirationTime: doc.Expiration})
+		if err != nil {
+			return nil, err
+		}
+		normalized.Expiration = utcTime(normalized.Expiration)
+		for i := range normalized.Statements {
+			normalized.Statements[i].Expiration = utcTime(normalized.Statements[i].Expiration)
+		}
+		return json.Marshal(normalized)
+	}
+	currentJSON, err := normalize(currentDoc)
+	if err != nil {
+		return false, err
+	}
+	desiredJSON, err := normalize(desired)
+	if err != nil {
+		return false, err
+	}
+	return string(currentJSON) == string(desiredJSON), nil
+}
+
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+func statementsDescription(statements []*permTypes.Statement) string {
+	descriptions := make([]string, 0, len(statements))
+	for _, statement := range statements {
+		actions := make([]string, 0, len(statement.Actions))
+		for _, action := range statement.Actions {
+			actions = append(actions, action.String())
+		}
+		description := fmt.Sprintf("%s %s", strings.TrimPrefix(statement.Effect.String(), "EFFECT_"), strings.Join(actions, ","))
+		if len(statement.Resources) > 0 {
+			description += " on " + strings.Join(statement.Resources, ",")
+		}
+		descriptions = append(descriptions, description)
+	}
+	return strings.Join(descriptions, "; ")
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// RetryPolicy - The policy for retrying the requests sent to SP.
+//
+// The idempotent GET and HEAD requests are retried by default. The upload requests are retried only when the upload can
+// be resumed from the offset safely, i.e. the parts of a resumable upload, which are read into memory one part at a time.
+// The objects larger than PutObjectOptions.PartSize are uploaded in parts unless DisableResumable is set. A PutObject
+// uploading the whole object in one request is never retried, whether its reader is seekable or not, and neither is any
+// other request whose body can not be rewound, since the bodies are not buffered.
+// The request is re-signed on each attempt, since the Date and expiry headers are stamped when the request is built.
+type RetryPolicy struct {
+	// MaxAttempts is the max number of attempts of a request including the first one, setting it to 1 disables the retry.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff is the upper bound of the delay between two attempts.
+	MaxBackoff time.Duration
+	// Multiplier is the factor by which the delay grows after each attempt.
+	Multiplier float64
+	// Jitter is the fraction of the delay which is randomized, it should be in [0, 1].
+	Jitter float64
+	// RetryableStatusCodes defines the HTTP status codes to be retried.
+	RetryableStatusCodes []int
+	// RetryableSPCodes defines the error codes in the SP error response to be retried.
+	RetryableSPCodes []string
+}
+
+// DefaultRetryPolicy - Return the retry policy used when Option.RetryPolicy is not set.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    types.DefaultRetryMaxAttempts,
+		InitialBackoff: types.DefaultRetryInitialBackoff,
+		MaxBackoff:     types.DefaultRetryMaxBackoff,
+		Multiplier:     2,
+		Jitter:         0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableSPCodes: []string{"InternalError", "SlowDown", "ServiceUnavailable", "RequestTimeout"},
+	}
+}
+
+// backoff returns the delay before the given retry, the first retry is 1.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	delay := float64(p.InitialBackoff) * math.Pow(multiplier, float64(retry-1))
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		delay = delay*(1-jitter) + delay*jitter*rand.Float64()
+	}
+	return time.Duration(delay)
+}
+
+// isRetryable checks whether the error returned by doAPI is transient.
+func (p *RetryPolicy) isRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var errResp types.ErrResponse
+	if errors.As(err, &errResp) {
+		for _, code := range p.RetryableStatusCodes {
+			if errResp.StatusCode == code {
+				return true
+			}
+		}
+		for _, code := range p.RetryableSPCodes {
+			if errResp.Code == code {
+				return true
+			}
+		}
+		return false
+	}
+	return types.IsRetryable(err)
+}
+
+// retryAttempts returns the number of attempts allowed for the request, and the seeker used to rewind the body
+// before each retry if the body is an io.Reader.
+func (c *Client) retryAttempts(opt *sendOptions) (int, io.Seeker, int64) {
+	policy := c.retryPolicy
+	if policy == nil || policy.MaxAttempts <= 1 {
+		return 1, nil, 0
+	}
+	if opt.method != http.MethodGet && opt.method != http.MethodHead && !opt.retryable {
+		return 1, nil, 0
+	}
+
+	// the xml body is marshaled again when the request is rebuilt
+	reader, ok := opt.body.(io.Reader)
+	if !ok {
+		return policy.MaxAttempts, nil, 0
+	}
+	seeker, ok := reader.(io.Seeker)
+	if !ok {
+		return 1, nil, 0
+	}
+	offset, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 1, nil, 0
+	}
+	return policy.MaxAttempts, seeker, offset
+}
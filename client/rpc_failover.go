@@ -0,0 +1,328 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/utils"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	sdkclient "github.com/bnb-chain/greenfield/sdk/client"
+)
+
+// RPCFailoverOption - The configurations for failing over among several RPC endpoints of the Greenfield Blockchain.
+//
+// The queries are sent to the healthiest endpoint and fail over to the other endpoints when it is unreachable.
+// The broadcasts stick to one endpoint as long as it is healthy, so that the mempool of one node sees the txs of the
+// account in sequence order.
+type RPCFailoverOption struct {
+	// Endpoints are the backup RPC endpoints besides the endpoint passed to New.
+	Endpoints []string
+	// HealthCheckInterval is the interval of the health checks, the default value is 30s.
+	HealthCheckInterval time.Duration
+	// MaxBlockLag is the number of blocks an endpoint can lag behind the highest one before it is regarded unhealthy, the default value is 5.
+	MaxBlockLag int64
+	// BroadcastFanOut is the number of additional endpoints a broadcast tx is also sent to, the responses of them are ignored.
+	BroadcastFanOut int
+}
+
+// RPCEndpointStatus - The health status of an RPC endpoint.
+type RPCEndpointStatus struct {
+	Endpoint    string
+	Healthy     bool
+	Syncing     bool
+	Height      int64
+	LastError   string
+	LastChecked time.Time
+}
+
+type rpcEndpoint struct {
+	url     *url.URL
+	checker *sdkclient.GreenfieldClient // checker queries the health of the endpoint
+	status  RPCEndpointStatus
+}
+
+// rpcFailover is the http.RoundTripper of the tendermint RPC client, it routes every JSON-RPC request to one of the endpoints.
+type rpcFailover struct {
+	transport http.RoundTripper
+	opt       RPCFailoverOption
+
+	mtx       sync.RWMutex
+	endpoints []*rpcEndpoint
+	active    int // the endpoint serving the queries
+	broadcast int // the endpoint serving the broadcasts
+}
+
+func newRPCFailover(chainID string, endpoints []string, opt RPCFailoverOption) (*rpcFailover, error) {
+	if opt.HealthCheckInterval <= 0 {
+		opt.HealthCheckInterval = types.DefaultRPCHealthCheckInterval
+	}
+	if opt.MaxBlockLag <= 0 {
+		opt.MaxBlockLag = types.DefaultRPCMaxBlockLag
+	}
+	f := &rpcFailover{
+		transport: http.DefaultTransport,
+		opt:       opt,
+	}
+	for _, endpoint := range endpoints {
+		u, err := url.Parse(strings.Replace(endpoint, "tcp://", "http://", 1))
+		if err != nil {
+			return nil, err
+		}
+		checker, err := sdkclient.NewGreenfieldClient(endpoint, chainID)
+		if err != nil {
+			return nil, err
+		}
+		f.endpoints = append(f.endpoints, &rpcEndpoint{
+			url:     u,
+			checker: checker,
+			status:  RPCEndpointStatus{Endpoint: endpoint, Healthy: true},
+		})
+	}
+	return f, nil
+}
+
+// startHealthCheck keeps checking the endpoints in the background until done is closed.
+func (f *rpcFailover) startHealthCheck(done <-chan struct{}) {
+	go func() {
+		ticker := time.NewTicker(f.opt.HealthCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+			f.checkHealth(context.Background())
+		}
+	}()
+}
+
+// checkHealth queries the status of all endpoints in one call each, an endpoint is healthy if it is reachable, not
+// catching up and not lagging behind the highest endpoint by more than MaxBlockLag blocks.
+func (f *rpcFailover) checkHealth(ctx context.Context) {
+	statuses := make([]RPCEndpointStatus, len(f.endpoints))
+	var wg sync.WaitGroup
+	for i, e := range f.endpoints {
+		wg.Add(1)
+		go func(i int, e *rpcEndpoint) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, types.ContextTimeout)
+			defer cancel()
+			status := RPCEndpointStatus{Endpoint: e.status.Endpoint, LastChecked: time.Now()}
+			if resp, err := e.checker.GetStatus(checkCtx); err != nil {
+				status.LastError = err.Error()
+			} else {
+				status.Syncing = resp.SyncInfo.CatchingUp
+				status.Height = resp.SyncInfo.LatestBlockHeight
+			}
+			statuses[i] = status
+		}(i, e)
+	}
+	wg.Wait()
+
+	var maxHeight int64
+	for _, status := range statuses {
+		if status.Height > maxHeight {
+			maxHeight = status.Height
+		}
+	}
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	for i := range statuses {
+		statuses[i].Healthy = statuses[i].LastError == "" && !statuses[i].Syncing && statuses[i].Height >= maxHeight-f.opt.MaxBlockLag
+		if f.endpoints[i].status.Healthy && !statuses[i].Healthy {
+			log.Warn().Msg(fmt.Sprintf("rpc endpoint %s is unhealthy, syncing: %v, height: %d, err: %s",
+				statuses[i].Endpoint, statuses[i].Syncing, statuses[i].Height, statuses[i].LastError))
+		}
+		f.endpoints[i].status = statuses[i]
+	}
+	// the queries always go to the highest healthy endpoint, while the broadcasts stay on their endpoint until it fails
+	f.active = f.pickLocked(-1)
+	if !f.endpoints[f.broadcast].status.Healthy {
+		f.broadcast = f.active
+	}
+}
+
+// pickLocked returns the healthy endpoint with the highest block, skipping the excluded one. The excluded endpoint
+// or the first endpoint is returned if none is healthy.
+func (f *rpcFailover) pickLocked(exclude int) int {
+	best := -1
+	for i, e := range f.endpoints {
+		if i == exclude || !e.status.Healthy {
+			continue
+		}
+		if best < 0 || e.status.Height > f.endpoints[best].status.Height {
+			best = i
+		}
+	}
+	if best < 0 {
+		if exclude >= 0 {
+			return (exclude + 1) % len(f.endpoints)
+		}
+		return 0
+	}
+	return best
+}
+
+// markFailed marks the endpoint unhealthy until the next health check, and moves the queries and broadcasts routed
+// to it to another endpoint.
+func (f *rpcFailover) markFailed(index int, err error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	e := f.endpoints[index]
+	e.status.Healthy = false
+	e.status.LastError = err.Error()
+	if f.active == index {
+		f.active = f.pickLocked(index)
+	}
+	if f.broadcast == index {
+		f.broadcast = f.pickLocked(index)
+	}
+	log.Warn().Msg(fmt.Sprintf("rpc endpoint %s failed, err: %s", e.status.Endpoint, err))
+}
+
+func (f *rpcFailover) statuses() []RPCEndpointStatus {
+	f.mtx.RLock()
+	defer f.mtx.RUnlock()
+	statuses := make([]RPCEndpointStatus, 0, len(f.endpoints))
+	for _, e := range f.endpoints {
+		statuses = append(statuses, e.status)
+	}
+	return statuses
+}
+
+// RoundTrip sends the JSON-RPC request to the current endpoint, and tries the other endpoints in turn if it is unreachable.
+func (f *rpcFailover) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	isBroadcast := isBroadcastRequest(body)
+
+	var lastErr error
+	tried := make(map[int]bool, len(f.endpoints))
+	for len(tried) < len(f.endpoints) {
+		f.mtx.RLock()
+		index := f.active
+		if isBroadcast {
+			index = f.broadcast
+		}
+		f.mtx.RUnlock()
+		if tried[index] {
+			// the routed endpoint has been tried, go through the rest in order
+			for i := range f.endpoints {
+				if !tried[i] {
+					index = i
+					break
+				}
+			}
+		}
+		tried[index] = true
+
+		resp, err := f.send(req, body, index)
+		if err == nil && resp.StatusCode < http.StatusInternalServerError {
+			if isBroadcast {
+				f.fanOut(req, body, index)
+			}
+			return resp, nil
+		}
+		if err == nil {
+			err = fmt.Errorf("rpc endpoint responds with status %s", resp.Status)
+			utils.CloseResponse(resp)
+		}
+		if req.Context().Err() != nil {
+			return nil, req.Context().Err()
+		}
+		lastErr = err
+		f.markFailed(index, err)
+	}
+	return nil, lastErr
+}
+
+// fanOut sends the broadcast tx to BroadcastFanOut other healthy endpoints in the background.
+func (f *rpcFailover) fanOut(req *http.Request, body []byte, sent int) {
+	if f.opt.BroadcastFanOut <= 0 {
+		return
+	}
+	f.mtx.RLock()
+	targets := make([]int, 0, f.opt.BroadcastFanOut)
+	for i, e := range f.endpoints {
+		if len(targets) == f.opt.BroadcastFanOut {
+			break
+		}
+		if i != sent && e.status.Healthy {
+			targets = append(targets, i)
+		}
+	}
+	f.mtx.RUnlock()
+
+	for _, index := range targets {
+		go func(index int) {
+			ctx, cancel := context.WithTimeout(context.Background(), types.ContextTimeout)
+			defer cancel()
+			resp, err := f.send(req.WithContext(ctx), body, index)
+			if err != nil {
+				log.Debug().Msg(fmt.Sprintf("fan out broadcast to rpc endpoint %d failed, err: %s", index, err))
+				return
+			}
+			utils.CloseResponse(resp)
+		}(index)
+	}
+}
+
+func (f *rpcFailover) send(req *http.Request, body []byte, index int) (*http.Response, error) {
+	target := f.endpoints[index].url
+	outReq := req.Clone(req.Context())
+	outReq.URL.Scheme = target.Scheme
+	outReq.URL.Host = target.Host
+	outReq.URL.Path = target.Path
+	outReq.Host = ""
+	if target.User != nil {
+		outReq.URL.User = target.User
+	}
+	if body != nil {
+		outReq.Body = io.NopCloser(bytes.NewReader(body))
+		outReq.ContentLength = int64(len(body))
+	}
+	return f.transport.RoundTrip(outReq)
+}
+
+// isBroadcastRequest checks whether the JSON-RPC request broadcasts a tx.
+func isBroadcastRequest(body []byte) bool {
+	var request struct {
+		Method string `json:"method"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return false
+	}
+	return strings.HasPrefix(request.Method, "broadcast_tx")
+}
+
+// GetRPCEndpointsStatus - Get the health status of the RPC endpoints of the Greenfield Blockchain.
+//
+// - ret1: The status of the endpoint passed to New and the backup endpoints, in the configured order.
+//
+// - ret2: Return error when the RPC failover is not configured, otherwise return nil.
+func (c *Client) GetRPCEndpointsStatus() ([]RPCEndpointStatus, error) {
+	if c.rpcFailover == nil {
+		return nil, errors.New("the rpc failover is not configured")
+	}
+	return c.rpcFailover.statuses(), nil
+}
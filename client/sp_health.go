@@ -0,0 +1,241 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/bnb-chain/greenfield-go-sdk/pkg/utils"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// spHealth records the health of one SP endpoint.
+type spHealth struct {
+	avgLatency          time.Duration // the exponentially weighted moving average of the request latency
+	requests            uint64
+	failures            uint64
+	consecutiveFailures int
+	cooldownUntil       time.Time
+	lastError           string
+	lastProbe           time.Time
+}
+
+// spHealthTracker records the latency and the error rate of the requests sent to SPs, and puts an SP into cooldown
+// after it fails several times in a row.
+type spHealthTracker struct {
+	mtx              sync.RWMutex
+	endpoints        map[string]*spHealth // keyed by the host of the SP endpoint
+	cooldown         time.Duration
+	failureThreshold int
+}
+
+func newSPHealthTracker() *spHealthTracker {
+	return &spHealthTracker{
+		endpoints:        make(map[string]*spHealth),
+		cooldown:         types.DefaultSPCooldown,
+		failureThreshold: types.DefaultSPFailureThreshold,
+	}
+}
+
+// isSPFailure checks whether the error indicates the SP is unhealthy, the errors caused by the request itself such as
+// a 4xx response do not count.
+func isSPFailure(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var errResp types.ErrResponse
+	if errors.As(err, &errResp) {
+		return errResp.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
+
+// record updates the health of the SP endpoint with the result of a request.
+func (t *spHealthTracker) record(host string, latency time.Duration, err error) {
+	if err != nil && !isSPFailure(err) {
+		err = nil
+	}
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	h, ok := t.endpoints[host]
+	if !ok {
+		h = &spHealth{}
+		t.endpoints[host] = h
+	}
+	h.requests++
+	if h.avgLatency == 0 {
+		h.avgLatency = latency
+	} else {
+		h.avgLatency = (h.avgLatency*4 + latency) / 5
+	}
+	if err == nil {
+		h.consecutiveFailures = 0
+		return
+	}
+	h.failures++
+	h.consecutiveFailures++
+	h.lastError = err.Error()
+	if h.consecutiveFailures >= t.failureThreshold {
+		h.cooldownUntil = time.Now().Add(t.cooldown)
+		log.Warn().Msg(fmt.Sprintf("sp %s failed %d times in a row, cool down until %s", host, h.consecutiveFailures, h.cooldownUntil.Format(time.RFC3339)))
+	}
+}
+
+// score returns the score of the SP endpoint in [0, 1], a higher score means a healthier SP. The score is the success
+// rate discounted by the average latency, and it is 0 during the cooldown. An SP without any request scores 1.
+func (h *spHealth) score(now time.Time) float64 {
+	if h == nil || h.requests == 0 {
+		return 1
+	}
+	if now.Before(h.cooldownUntil) {
+		return 0
+	}
+	successRate := float64(h.requests-h.failures) / float64(h.requests)
+	return successRate / (1 + h.avgLatency.Seconds())
+}
+
+// pick returns the endpoint with the highest score, the endpoints with the same score keep their order.
+func (t *spHealthTracker) pick(endpoints []*url.URL) *url.URL {
+	if len(endpoints) == 0 {
+		return nil
+	}
+	now := time.Now()
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+	best, bestScore := endpoints[0], -1.0
+	for _, endpoint := range endpoints {
+		if score := t.endpoints[endpoint.Host].score(now); score > bestScore {
+			best, bestScore = endpoint, score
+		}
+	}
+	return best
+}
+
+func (t *spHealthTracker) markProbed(host string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	h, ok := t.endpoints[host]
+	if !ok {
+		h = &spHealth{}
+		t.endpoints[host] = h
+	}
+	h.lastProbe = time.Now()
+}
+
+func (t *spHealthTracker) scores() []types.SPHealthScore {
+	now := time.Now()
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+	scores := make([]types.SPHealthScore, 0, len(t.endpoints))
+	for host, h := range t.endpoints {
+		scores = append(scores, types.SPHealthScore{
+			Endpoint:            host,
+			Score:               h.score(now),
+			AvgLatency:          h.avgLatency,
+			Requests:            h.requests,
+			Failures:            h.failures,
+			ConsecutiveFailures: h.consecutiveFailures,
+			InCooldown:          now.Before(h.cooldownUntil),
+			CooldownUntil:       h.cooldownUntil,
+			LastError:           h.lastError,
+			LastProbe:           h.lastProbe,
+		})
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].Endpoint < scores[j].Endpoint
+	})
+	return scores
+}
+
+// ProbeStorageProviders - Probe the status endpoint of all storage providers and record the latency and the result
+// into their health scores.
+//
+// - ctx: Context variables for the current API call.
+//
+// - ret: Return error when the storage providers can not be fetched from the chain, otherwise return nil.
+func (c *Client) ProbeStorageProviders(ctx context.Context) error {
+	if c.forceToUseSpecifiedSpEndpointForDownloadOnly == nil {
+		if err := c.refreshStorageProviders(ctx); err != nil {
+			return err
+		}
+	}
+	endpoints := c.spEndpoints()
+
+	var wg sync.WaitGroup
+	for _, endpoint := range endpoints {
+		wg.Add(1)
+		go func(endpoint *url.URL) {
+			defer wg.Done()
+			c.probeStorageProvider(ctx, endpoint)
+		}(endpoint)
+	}
+	wg.Wait()
+	return nil
+}
+
+func (c *Client) probeStorageProvider(ctx context.Context, endpoint *url.URL) {
+	probeCtx, cancel := context.WithTimeout(ctx, types.ContextTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(probeCtx, http.MethodGet, endpoint.Scheme+"://"+endpoint.Host+"/status", nil)
+	if err != nil {
+		return
+	}
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
+	if err == nil {
+		if resp.StatusCode >= http.StatusInternalServerError {
+			err = types.ErrResponse{StatusCode: resp.StatusCode, Message: resp.Status}
+		}
+		utils.CloseResponse(resp)
+	}
+	c.spHealth.record(endpoint.Host, time.Since(start), err)
+	c.spHealth.markProbed(endpoint.Host)
+}
+
+// startSPProbe probes the storage providers periodically in the background until the client is closed.
+func (c *Client) startSPProbe(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.closed:
+				return
+			case <-ticker.C:
+			}
+			if err := c.ProbeStorageProviders(context.Background()); err != nil {
+				log.Error().Msg(fmt.Sprintf("probe storage providers fail: %s", err))
+			}
+		}
+	}()
+}
+
+// GetStorageProvidersHealth - Get the health scores of the storage providers which have been requested or probed.
+//
+// - ret: The health scores of the storage providers, sorted from the healthiest one.
+func (c *Client) GetStorageProvidersHealth() []types.SPHealthScore {
+	return c.spHealth.scores()
+}
+
+// spEndpoints returns the endpoints of the storage providers in the order of their ids.
+func (c *Client) spEndpoints() []*url.URL {
+	if c.forceToUseSpecifiedSpEndpointForDownloadOnly != nil {
+		return []*url.URL{c.forceToUseSpecifiedSpEndpointForDownloadOnly}
+	}
+	sps := c.storageProviderList()
+	endpoints := make([]*url.URL, 0, len(sps))
+	for _, sp := range sps {
+		endpoints = append(endpoints, sp.EndPoint)
+	}
+	return endpoints
+}
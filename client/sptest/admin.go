@@ -0,0 +1,204 @@
+package sptest
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	hashlib "github.com/bnb-chain/greenfield-common/go/hash"
+	"github.com/bnb-chain/greenfield-common/go/redundancy"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	"github.com/bnb-chain/greenfield/types/common"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// handleAdmin serves the admin APIs, the path is relative to types.AdminURLPrefix.
+func (s *Server) handleAdmin(w http.ResponseWriter, r *http.Request, path string) error {
+	if r.Method != http.MethodGet {
+		return errMethodNotAllowed(r)
+	}
+	signer, err := s.authenticate(r)
+	if err != nil {
+		return err
+	}
+	if err = requireSigner(signer); err != nil {
+		return err
+	}
+	switch path {
+	case types.AdminURLV1Version + "/get-approval":
+		return s.handleApproval(w, r, signer)
+	case types.AdminURLV1Version + "/" + types.ChallengeUrl:
+		return s.handleChallenge(w, r, false)
+	case types.AdminURLV2Version + "/" + types.ChallengeUrl:
+		return s.handleChallenge(w, r, true)
+	default:
+		return errMethodNotAllowed(r)
+	}
+}
+
+// handleApproval signs the approval of the unsigned msg sent by its creator or operator, the signed msg is responded in
+// the X-Gnfd-Signed-Msg header.
+func (s *Server) handleApproval(w http.ResponseWriter, r *http.Request, signer sdk.AccAddress) error {
+	unsignedMsg, err := hex.DecodeString(r.Header.Get(types.HTTPHeaderUnsignedMsg))
+	if err != nil {
+		return errInvalidHeader("invalid %s header: %v", types.HTTPHeaderUnsignedMsg, err)
+	}
+
+	var (
+		sender   string
+		approval *common.Approval
+		msg      interface {
+			proto.Message
+			GetApprovalBytes() []byte
+		}
+	)
+	switch action := r.URL.Query().Get("action"); action {
+	case types.CreateBucketAction:
+		createBucketMsg := &storageTypes.MsgCreateBucket{}
+		if err = storageTypes.ModuleCdc.UnmarshalJSON(unsignedMsg, createBucketMsg); err != nil {
+			return errInvalidHeader("invalid create bucket msg: %v", err)
+		}
+		if createBucketMsg.PrimarySpApproval == nil {
+			createBucketMsg.PrimarySpApproval = &common.Approval{}
+		}
+		sender, approval, msg = createBucketMsg.Creator, createBucketMsg.PrimarySpApproval, createBucketMsg
+	case types.CreateObjectAction:
+		createObjectMsg := &storageTypes.MsgCreateObject{}
+		if err = storageTypes.ModuleCdc.UnmarshalJSON(unsignedMsg, createObjectMsg); err != nil {
+			return errInvalidHeader("invalid create object msg: %v", err)
+		}
+		s.mtx.Lock()
+		_, ok := s.buckets[createObjectMsg.BucketName]
+		s.mtx.Unlock()
+		if !ok {
+			return errNoSuchBucket(createObjectMsg.BucketName)
+		}
+		if createObjectMsg.PrimarySpApproval == nil {
+			createObjectMsg.PrimarySpApproval = &common.Approval{}
+		}
+		sender, approval, msg = createObjectMsg.Creator, createObjectMsg.PrimarySpApproval, createObjectMsg
+	case types.MigrateBucketAction:
+		migrateBucketMsg := &storageTypes.MsgMigrateBucket{}
+		if err = storageTypes.ModuleCdc.UnmarshalJSON(unsignedMsg, migrateBucketMsg); err != nil {
+			return errInvalidHeader("invalid migrate bucket msg: %v", err)
+		}
+		if migrateBucketMsg.DstPrimarySpApproval == nil {
+			migrateBucketMsg.DstPrimarySpApproval = &common.Approval{}
+		}
+		sender, approval, msg = migrateBucketMsg.Operator, migrateBucketMsg.DstPrimarySpApproval, migrateBucketMsg
+	default:
+		return errInvalidQuery("unsupported approval action %s", action)
+	}
+	if sender != signer.String() {
+		return errAccessDenied("the msg of %s can not be approved for %s", sender, signer)
+	}
+
+	approval.ExpiredHeight = s.approvalExpiredHeight
+	approval.Sig = nil
+	sig, err := s.approvalAccount.Sign(ethcrypto.Keccak256(msg.GetApprovalBytes()))
+	if err != nil {
+		return err
+	}
+	approval.Sig = sig
+	signedMsg, err := storageTypes.ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		return err
+	}
+	w.Header().Set(types.HTTPHeaderSignedMsg, hex.EncodeToString(signedMsg))
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// handleChallenge responds the piece of the sealed object at the piece index, with the hashes of all the pieces and
+// the integrity hash of the primary SP or the secondary SP at the redundancy index.
+func (s *Server) handleChallenge(w http.ResponseWriter, r *http.Request, v2 bool) error {
+	objectID, err := strconv.ParseUint(r.Header.Get(types.HTTPHeaderObjectID), 10, 64)
+	if err != nil {
+		return errInvalidHeader("invalid %s header", types.HTTPHeaderObjectID)
+	}
+	redundancyIndex, err := strconv.Atoi(r.Header.Get(types.HTTPHeaderRedundancyIndex))
+	if err != nil || redundancyIndex < types.PrimaryRedundancyIndex || redundancyIndex >= s.dataShards+s.parityShards {
+		return errInvalidHeader("invalid %s header", types.HTTPHeaderRedundancyIndex)
+	}
+	pieceIndex, err := strconv.Atoi(r.Header.Get(types.HTTPHeaderPieceIndex))
+	if err != nil || pieceIndex < 0 {
+		return errInvalidHeader("invalid %s header", types.HTTPHeaderPieceIndex)
+	}
+
+	s.mtx.Lock()
+	object, ok := s.objectsByID[objectID]
+	var content []byte
+	sealed := false
+	if ok {
+		content, sealed = object.content, object.info.ObjectStatus == storageTypes.OBJECT_STATUS_SEALED
+	}
+	s.mtx.Unlock()
+	if !ok {
+		return newError(http.StatusNotFound, "NoSuchObject", "%s, id: %d", types.NoSuchObjectErr, objectID)
+	}
+	if !sealed {
+		return newError(http.StatusBadRequest, "InvalidObjectState", "the object %d is not sealed", objectID)
+	}
+
+	pieces, err := s.pieces(content, redundancyIndex)
+	if err != nil {
+		return err
+	}
+	if pieceIndex >= len(pieces) {
+		return errInvalidHeader("invalid %s header, the object has %d pieces", types.HTTPHeaderPieceIndex, len(pieces))
+	}
+	checksums := make([][]byte, len(pieces))
+	pieceHashes := make([]string, len(pieces))
+	for i, piece := range pieces {
+		checksums[i] = hashlib.GenerateChecksum(piece)
+		pieceHashes[i] = hex.EncodeToString(checksums[i])
+	}
+	integrityHash := hex.EncodeToString(hashlib.GenerateIntegrityHash(checksums))
+
+	if v2 {
+		writeXML(w, http.StatusOK, types.ChallengeV2Result{
+			ObjectID:        strconv.FormatUint(objectID, 10),
+			RedundancyIndex: strconv.Itoa(redundancyIndex),
+			PieceIndex:      strconv.Itoa(pieceIndex),
+			IntegrityHash:   integrityHash,
+			PieceHash:       strings.Join(pieceHashes, ","),
+			PieceData:       hex.EncodeToString(pieces[pieceIndex]),
+		})
+		return nil
+	}
+	w.Header().Set(types.HTTPHeaderIntegrityHash, integrityHash)
+	w.Header().Set(types.HTTPHeaderPieceHash, strings.Join(pieceHashes, ","))
+	w.Header().Set(types.HTTPHeaderContentType, types.ContentDefault)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(pieces[pieceIndex])
+	return nil
+}
+
+// pieces splits the payload into the segments stored by the primary SP, or the erasure coding pieces of the segments
+// stored by the secondary SP at the redundancy index.
+func (s *Server) pieces(content []byte, redundancyIndex int) ([][]byte, error) {
+	var pieces [][]byte
+	for start := int64(0); start < int64(len(content)); start += s.segmentSize {
+		end := start + s.segmentSize
+		if end > int64(len(content)) {
+			end = int64(len(content))
+		}
+		segment := content[start:end]
+		if redundancyIndex == types.PrimaryRedundancyIndex {
+			pieces = append(pieces, segment)
+			continue
+		}
+		// the segment is encoded in place, so a copy is encoded to keep the payload
+		shards, err := redundancy.EncodeRawSegment(append([]byte{}, segment...), s.dataShards, s.parityShards)
+		if err != nil {
+			return nil, err
+		}
+		pieces = append(pieces, shards[redundancyIndex])
+	}
+	return pieces, nil
+}
@@ -0,0 +1,325 @@
+package sptest
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/twistededwards/eddsa"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	hashlib "github.com/bnb-chain/greenfield-common/go/hash"
+	httplib "github.com/bnb-chain/greenfield-common/go/http"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+const (
+	headerAppDomain    = "X-Gnfd-App-Domain"
+	headerAppRegNonce  = "X-Gnfd-App-Reg-Nonce"
+	headerAppPublicKey = "X-Gnfd-App-Reg-Public-Key"
+)
+
+// offChainKey is an off-chain auth key registered by a user for a domain.
+type offChainKey struct {
+	publicKey string // the hex encoded public key
+	expiry    time.Time
+}
+
+type requestNonceResponse struct {
+	XMLName          xml.Name `xml:"RequestNonceResp"`
+	CurrentNonce     int32    `xml:"CurrentNonce"`
+	NextNonce        int32    `xml:"NextNonce"`
+	CurrentPublicKey string   `xml:"CurrentPublicKey"`
+	ExpiryDate       int64    `xml:"ExpiryDate"`
+}
+
+type updateKeyResponse struct {
+	XMLName xml.Name `xml:"UpdateUserPublicKeyResp"`
+	Result  bool     `xml:"Result"`
+}
+
+type listKeysV2Response struct {
+	XMLName    xml.Name `xml:"ListUserPublicKeyV2Resp"`
+	PublicKeys []string `xml:"Result"`
+}
+
+type deleteKeysV2Response struct {
+	XMLName xml.Name `xml:"DeleteUserPublicKeyV2Resp"`
+	Result  bool     `xml:"Result"`
+}
+
+func authKeyOf(user sdk.AccAddress, domain string) string {
+	return user.String() + "/" + domain
+}
+
+// authenticate verifies the signature of the request and returns the signer, the signer is nil if the request is not
+// signed.
+func (s *Server) authenticate(r *http.Request) (sdk.AccAddress, error) {
+	authorization := r.Header.Get(types.HTTPHeaderAuthorization)
+	if authorization == "" {
+		return nil, nil
+	}
+	s.mtx.Lock()
+	now := s.now()
+	s.mtx.Unlock()
+	expiry, err := time.Parse(types.Iso8601DateFormatSecond, r.Header.Get(httplib.HTTPHeaderExpiryTimestamp))
+	if err != nil {
+		return nil, errInvalidHeader("invalid %s header: %v", httplib.HTTPHeaderExpiryTimestamp, err)
+	}
+	if now.After(expiry) {
+		return nil, newError(http.StatusBadRequest, "RequestExpired", "the request expired at %s", expiry.Format(time.RFC3339))
+	}
+	if expiry.Sub(now) > httplib.MaxExpiryAgeInSec*time.Second {
+		return nil, errInvalidHeader("the expiry timestamp %s exceeds the max expiry age", expiry.Format(time.RFC3339))
+	}
+
+	algorithm, signaturePart, _ := strings.Cut(authorization, ",")
+	signature, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(signaturePart), "Signature="))
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, "InvalidAuthorization", "invalid signature: %v", err)
+	}
+	// the canonical request is built from a copy as its query is rewritten
+	msg := httplib.GetMsgToSignInGNFD1Auth(r.Clone(r.Context()))
+
+	switch strings.TrimSpace(algorithm) {
+	case httplib.Gnfd1Ecdsa:
+		signer, _, err := hashlib.RecoverAddr(msg, signature)
+		if err != nil {
+			return nil, errSignatureMismatch(err.Error())
+		}
+		return signer, nil
+	case httplib.Gnfd1Eddsa:
+		user, domain, err := offChainUser(r)
+		if err != nil {
+			return nil, err
+		}
+		s.mtx.Lock()
+		key, ok := s.authKeys[authKeyOf(user, domain)]
+		s.mtx.Unlock()
+		if !ok || now.After(key.expiry) {
+			return nil, errSignatureMismatch(fmt.Sprintf("no valid off-chain auth key of %s for %s", user, domain))
+		}
+		publicKeyBytes, _ := hex.DecodeString(key.publicKey)
+		var publicKey eddsa.PublicKey
+		if _, err = publicKey.SetBytes(publicKeyBytes); err != nil {
+			return nil, errSignatureMismatch(err.Error())
+		}
+		if ok, err = publicKey.Verify(signature, msg, mimc.NewMiMC()); err != nil || !ok {
+			return nil, errSignatureMismatch("the GNFD1-EDDSA signature does not match")
+		}
+		return user, nil
+	case httplib.Gnfd2Eddsa:
+		user, domain, err := offChainUser(r)
+		if err != nil {
+			return nil, err
+		}
+		publicKeyHex := r.Header.Get(headerAppPublicKey)
+		s.mtx.Lock()
+		key := findKey(s.authKeysV2[authKeyOf(user, domain)], publicKeyHex)
+		s.mtx.Unlock()
+		if key == nil || now.After(key.expiry) {
+			return nil, errSignatureMismatch(fmt.Sprintf("the off-chain auth key %s of %s for %s is not registered", publicKeyHex, user, domain))
+		}
+		publicKey, _ := hex.DecodeString(publicKeyHex)
+		if len(publicKey) != ed25519.PublicKeySize || !ed25519.Verify(publicKey, msg, signature) {
+			return nil, errSignatureMismatch("the GNFD2-EDDSA signature does not match")
+		}
+		return user, nil
+	default:
+		return nil, newError(http.StatusBadRequest, "InvalidAuthorization", "unsupported authorization type %s", algorithm)
+	}
+}
+
+func errSignatureMismatch(reason string) *spError {
+	return newError(http.StatusUnauthorized, "SignatureDoesNotMatch", "the request signature does not match: %s", reason)
+}
+
+// offChainUser returns the user and the domain of the off-chain auth headers.
+func offChainUser(r *http.Request) (sdk.AccAddress, string, error) {
+	user, err := sdk.AccAddressFromHexUnsafe(r.Header.Get(types.HTTPHeaderUserAddress))
+	if err != nil {
+		return nil, "", errInvalidHeader("invalid %s header: %v", types.HTTPHeaderUserAddress, err)
+	}
+	domain := r.Header.Get(headerAppDomain)
+	if domain == "" {
+		return nil, "", errInvalidHeader("missing %s header", headerAppDomain)
+	}
+	return user, domain, nil
+}
+
+func findKey(keys []*offChainKey, publicKey string) *offChainKey {
+	for _, key := range keys {
+		if key.publicKey == publicKey {
+			return key
+		}
+	}
+	return nil
+}
+
+// handleRequestNonce responds the nonce to register the next GNFD1-EDDSA key of the user for the domain.
+func (s *Server) handleRequestNonce(w http.ResponseWriter, r *http.Request) error {
+	user, domain, err := offChainUser(r)
+	if err != nil {
+		return err
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	resp := requestNonceResponse{
+		CurrentNonce: s.authNonces[authKeyOf(user, domain)],
+		NextNonce:    s.authNonces[authKeyOf(user, domain)] + 1,
+	}
+	if key, ok := s.authKeys[authKeyOf(user, domain)]; ok {
+		resp.CurrentPublicKey = key.publicKey
+		resp.ExpiryDate = key.expiry.UnixMilli()
+	}
+	writeXML(w, http.StatusOK, resp)
+	return nil
+}
+
+// handleUpdateKey registers the off-chain auth key signed by the user in the personal sign way. The GNFD1-EDDSA key
+// replaces the previous one and requires the next nonce, while the GNFD2-EDDSA keys are added to the keys of the user.
+func (s *Server) handleUpdateKey(w http.ResponseWriter, r *http.Request, v2 bool) error {
+	if r.Method != http.MethodPost {
+		return errMethodNotAllowed(r)
+	}
+	user, domain, err := offChainUser(r)
+	if err != nil {
+		return err
+	}
+	publicKey := r.Header.Get(headerAppPublicKey)
+	if publicKey == "" {
+		return errInvalidHeader("missing %s header", headerAppPublicKey)
+	}
+	expiry, err := time.Parse(time.RFC3339, r.Header.Get(httplib.HTTPHeaderExpiryTimestamp))
+	if err != nil {
+		return errInvalidHeader("invalid %s header: %v", httplib.HTTPHeaderExpiryTimestamp, err)
+	}
+
+	signedMsg, err := verifyPersonalSign(r.Header.Get(types.HTTPHeaderAuthorization), user)
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(signedMsg, publicKey) || !strings.Contains(signedMsg, domain) {
+		return errSignatureMismatch("the signed message does not contain the public key and the domain")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if now := s.now(); !expiry.After(now) || expiry.Sub(now) > httplib.MaxExpiryAgeInSec*time.Second {
+		return errInvalidHeader("invalid expiry timestamp %s", expiry.Format(time.RFC3339))
+	}
+	key := authKeyOf(user, domain)
+	if v2 {
+		if existing := findKey(s.authKeysV2[key], publicKey); existing != nil {
+			existing.expiry = expiry
+		} else {
+			s.authKeysV2[key] = append(s.authKeysV2[key], &offChainKey{publicKey: publicKey, expiry: expiry})
+		}
+	} else {
+		nextNonce := s.authNonces[key] + 1
+		if nonce := r.Header.Get(headerAppRegNonce); nonce != strconv.Itoa(int(nextNonce)) ||
+			!strings.HasSuffix(signedMsg, "with nonce: "+nonce) {
+			return errInvalidHeader("invalid nonce %s, the next nonce is %d", nonce, nextNonce)
+		}
+		s.authNonces[key] = nextNonce
+		s.authKeys[key] = &offChainKey{publicKey: publicKey, expiry: expiry}
+	}
+	writeXML(w, http.StatusOK, updateKeyResponse{Result: true})
+	return nil
+}
+
+// verifyPersonalSign verifies the GNFD1-ETH-PERSONAL_SIGN authorization is signed by the user and returns the signed
+// message.
+func verifyPersonalSign(authorization string, user sdk.AccAddress) (string, error) {
+	content, ok := strings.CutPrefix(authorization, httplib.Gnfd1EthPersonalSign+",SignedMsg=")
+	i := strings.LastIndex(content, ",Signature=")
+	if !ok || i < 0 {
+		return "", newError(http.StatusBadRequest, "InvalidAuthorization", "the authorization should be %s", httplib.Gnfd1EthPersonalSign)
+	}
+	signedMsg := strings.ReplaceAll(content[:i], "\\n", "\n")
+	signature, err := hexutil.Decode(content[i+len(",Signature="):])
+	if err != nil || len(signature) != ethcrypto.SignatureLength {
+		return "", newError(http.StatusBadRequest, "InvalidAuthorization", "invalid signature")
+	}
+	if signature[ethcrypto.RecoveryIDOffset] >= 27 {
+		signature[ethcrypto.RecoveryIDOffset] -= 27
+	}
+	publicKey, err := ethcrypto.SigToPub(accounts.TextHash([]byte(signedMsg)), signature)
+	if err != nil {
+		return "", errSignatureMismatch(err.Error())
+	}
+	if signer := ethcrypto.PubkeyToAddress(*publicKey); !sdk.AccAddress(signer.Bytes()).Equals(user) {
+		return "", errSignatureMismatch(fmt.Sprintf("the message is signed by %s rather than %s", signer.Hex(), user))
+	}
+	return signedMsg, nil
+}
+
+// handleListKeysV2 responds the GNFD2-EDDSA keys of the user for the domain.
+func (s *Server) handleListKeysV2(w http.ResponseWriter, r *http.Request) error {
+	user, domain, err := offChainUser(r)
+	if err != nil {
+		return err
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	resp := listKeysV2Response{PublicKeys: make([]string, 0)}
+	for _, key := range s.authKeysV2[authKeyOf(user, domain)] {
+		if key.expiry.After(s.now()) {
+			resp.PublicKeys = append(resp.PublicKeys, key.publicKey)
+		}
+	}
+	writeXML(w, http.StatusOK, resp)
+	return nil
+}
+
+// handleDeleteKeysV2 deletes the comma separated GNFD2-EDDSA keys in the body of the request signed by the user.
+func (s *Server) handleDeleteKeysV2(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		return errMethodNotAllowed(r)
+	}
+	signer, err := s.authenticate(r)
+	if err != nil {
+		return err
+	}
+	if signer == nil {
+		return errAccessDenied("the request is not signed")
+	}
+	user, domain, err := offChainUser(r)
+	if err != nil {
+		return err
+	}
+	if !user.Equals(signer) {
+		return errAccessDenied("the keys of %s can not be deleted by %s", user, signer)
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	deleted := make(map[string]bool)
+	for _, publicKey := range strings.Split(string(body), ",") {
+		deleted[strings.TrimSpace(publicKey)] = true
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	key := authKeyOf(user, domain)
+	remained := make([]*offChainKey, 0, len(s.authKeysV2[key]))
+	for _, k := range s.authKeysV2[key] {
+		if !deleted[k.publicKey] {
+			remained = append(remained, k)
+		}
+	}
+	s.authKeysV2[key] = remained
+	writeXML(w, http.StatusOK, deleteKeysV2Response{Result: true})
+	return nil
+}
@@ -0,0 +1,22 @@
+/*
+Package sptest provides an in-process emulator of a Greenfield storage provider for testing the SP requests of the
+client without a running storage provider.
+
+The Server is an httptest.Server serving the SP APIs used by the client: uploading the objects in a single request or
+in parts with the offset and complete queries, querying the upload progress and the resumable upload offset,
+downloading the objects with ranges, listing the objects in the XML format of the SP, signing the approvals of creating
+the buckets and the objects and migrating the buckets, responding the challenges, and registering the off-chain auth
+keys. The requests are authenticated by verifying their GNFD1-ECDSA, GNFD1-EDDSA or GNFD2-EDDSA signatures, and the
+failures are responded with the XML error documents parsed by types.ConstructErrResponse.
+
+The chain is not emulated, the buckets and the objects created on chain are added to the Server before they are
+uploaded or downloaded:
+
+	sp := sptest.NewServer(sptest.Option{})
+	defer sp.Close()
+	err := sp.CreateBucket("bucket", owner, storageTypes.VISIBILITY_TYPE_PRIVATE)
+	_, err = sp.CreateObject("bucket", "object", owner, 5, storageTypes.VISIBILITY_TYPE_INHERIT)
+
+The uploaded objects are sealed once all their payload is received.
+*/
+package sptest
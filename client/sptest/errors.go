@@ -0,0 +1,79 @@
+package sptest
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+// spError is an error responded by the Server in the XML format of the storage providers.
+type spError struct {
+	statusCode int
+	code       string
+	message    string
+}
+
+func (e *spError) Error() string {
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
+
+func newError(statusCode int, code, format string, args ...interface{}) *spError {
+	return &spError{statusCode: statusCode, code: code, message: fmt.Sprintf(format, args...)}
+}
+
+// errorResponse is the XML error document of the storage providers, which is parsed by types.ConstructErrResponse.
+type errorResponse struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string   `xml:"Code"`
+	Message   string   `xml:"Message"`
+	RequestID string   `xml:"RequestId"`
+}
+
+func errNoSuchBucket(bucketName string) *spError {
+	return newError(http.StatusNotFound, "NoSuchBucket", "the specified bucket %s does not exist", bucketName)
+}
+
+func errNoSuchObject(bucketName, objectName string) *spError {
+	// the client looks for types.NoSuchObjectErr in the message before uploading the objects
+	return newError(http.StatusNotFound, "NoSuchObject", "%s, bucket: %s, object: %s", types.NoSuchObjectErr, bucketName, objectName)
+}
+
+func errAccessDenied(format string, args ...interface{}) *spError {
+	return newError(http.StatusForbidden, "AccessDenied", format, args...)
+}
+
+func errInvalidQuery(format string, args ...interface{}) *spError {
+	return newError(http.StatusBadRequest, "InvalidQuery", format, args...)
+}
+
+func errInvalidHeader(format string, args ...interface{}) *spError {
+	return newError(http.StatusBadRequest, "InvalidHeader", format, args...)
+}
+
+func errMethodNotAllowed(r *http.Request) *spError {
+	return newError(http.StatusMethodNotAllowed, "MethodNotAllowed", "the method %s is not allowed for %s", r.Method, r.URL.Path)
+}
+
+// writeError writes the error as the XML error document, the errors other than spError are internal errors.
+func writeError(w http.ResponseWriter, err error, requestID string) {
+	var spErr *spError
+	if !errors.As(err, &spErr) {
+		spErr = newError(http.StatusInternalServerError, "InternalError", "%s", err.Error())
+	}
+	writeXML(w, spErr.statusCode, errorResponse{Code: spErr.code, Message: spErr.message, RequestID: requestID})
+}
+
+// writeXML writes the XML document with the status code.
+func writeXML(w http.ResponseWriter, statusCode int, v interface{}) {
+	body, err := xml.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(types.HTTPHeaderContentType, types.ContentTypeXML)
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(append([]byte(xml.Header), body...))
+}
@@ -0,0 +1,446 @@
+package sptest
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+const listObjectsMaxKeys = 1000
+
+type listObjectsResponse struct {
+	XMLName xml.Name `xml:"GfSpListObjectsByBucketNameResponse"`
+	types.ListObjectsResult
+}
+
+// handleObject serves the bucket and the object APIs.
+func (s *Server) handleObject(w http.ResponseWriter, r *http.Request) error {
+	bucketName, objectName := s.bucketAndObject(r)
+	if bucketName == "" {
+		return errMethodNotAllowed(r)
+	}
+	signer, err := s.authenticate(r)
+	if err != nil {
+		return err
+	}
+	query := r.URL.Query()
+	if objectName == "" {
+		if r.Method != http.MethodGet {
+			return errMethodNotAllowed(r)
+		}
+		return s.handleListObjects(w, bucketName, query)
+	}
+
+	switch {
+	case r.Method == http.MethodPut:
+		return s.handlePutObject(w, r, signer, bucketName, objectName)
+	case r.Method == http.MethodPost && query.Has("create-folder"):
+		return s.handleCreateFolder(w, r, signer, bucketName, objectName)
+	case r.Method == http.MethodPost && query.Has("offset"):
+		return s.handleUploadPart(w, r, signer, bucketName, objectName)
+	case r.Method == http.MethodGet && query.Has("upload-progress"):
+		return s.handleUploadProgress(w, signer, bucketName, objectName)
+	case r.Method == http.MethodGet && query.Has("upload-context"):
+		return s.handleUploadContext(w, signer, bucketName, objectName)
+	case r.Method == http.MethodGet:
+		return s.handleGetObject(w, r, signer, bucketName, objectName)
+	default:
+		return errMethodNotAllowed(r)
+	}
+}
+
+// getObject returns the bucket and the object, or the NoSuchBucket and NoSuchObject errors. The caller must hold the
+// lock.
+func (s *Server) getObject(bucketName, objectName string) (*spBucket, *spObject, error) {
+	bucket, ok := s.buckets[bucketName]
+	if !ok {
+		return nil, nil, errNoSuchBucket(bucketName)
+	}
+	object, ok := bucket.objects[objectName]
+	if !ok {
+		return nil, nil, errNoSuchObject(bucketName, objectName)
+	}
+	return bucket, object, nil
+}
+
+func requireSigner(signer sdk.AccAddress) error {
+	if signer == nil {
+		return errAccessDenied("the request is not signed")
+	}
+	return nil
+}
+
+// uploadableObject returns the object to upload by the signer. For the delegated uploads the object is created for
+// the signer if it does not exist, and the sealed object is reset for the updates. The caller must hold the lock.
+func (s *Server) uploadableObject(r *http.Request, signer sdk.AccAddress, bucketName, objectName string) (*spObject, error) {
+	if err := requireSigner(signer); err != nil {
+		return nil, err
+	}
+	query := r.URL.Query()
+	if query.Has("delegate") {
+		bucket, ok := s.buckets[bucketName]
+		if !ok {
+			return nil, errNoSuchBucket(bucketName)
+		}
+		if !bucket.owner.Equals(signer) {
+			return nil, errAccessDenied("%s is not the owner of the bucket %s", signer, bucketName)
+		}
+		payloadSize, err := strconv.ParseUint(query.Get("payload_size"), 10, 64)
+		if err != nil {
+			return nil, errInvalidQuery("invalid payload_size %s", query.Get("payload_size"))
+		}
+		object, ok := bucket.objects[objectName]
+		switch {
+		case query.Get("is_update") == "true" && !(ok && object.resuming):
+			if !ok || object.info.ObjectStatus != storageTypes.OBJECT_STATUS_SEALED {
+				return nil, newError(http.StatusBadRequest, "InvalidObjectState", "the object %s to update is not sealed", objectName)
+			}
+			object.info.PayloadSize = payloadSize
+			object.info.ObjectStatus = storageTypes.OBJECT_STATUS_CREATED
+			object.info.IsUpdating = true
+			object.content = nil
+		case !ok:
+			visibility, err := strconv.ParseInt(query.Get("visibility"), 10, 32)
+			if err != nil {
+				return nil, errInvalidQuery("invalid visibility %s", query.Get("visibility"))
+			}
+			s.createObject(bucketName, objectName, signer, payloadSize, storageTypes.VisibilityType(visibility),
+				r.Header.Get(types.HTTPHeaderContentType))
+		}
+	}
+
+	_, object, err := s.getObject(bucketName, objectName)
+	if err != nil {
+		return nil, err
+	}
+	if object.info.Owner != signer.String() {
+		return nil, errAccessDenied("%s is not the owner of the object %s", signer, objectName)
+	}
+	if object.info.ObjectStatus != storageTypes.OBJECT_STATUS_CREATED {
+		return nil, newError(http.StatusBadRequest, "InvalidObjectState", "the object %s has been sealed", objectName)
+	}
+	return object, nil
+}
+
+// handlePutObject receives the whole payload of the object in one request.
+func (s *Server) handlePutObject(w http.ResponseWriter, r *http.Request, signer sdk.AccAddress, bucketName, objectName string) error {
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	object, err := s.uploadableObject(r, signer, bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	if object.resuming {
+		return newError(http.StatusBadRequest, "InvalidObjectState", "the object %s is being uploaded in parts", objectName)
+	}
+	if uint64(len(payload)) != object.info.PayloadSize {
+		return newError(http.StatusBadRequest, "InvalidPayloadSize", "the payload size %d does not match the object size %d",
+			len(payload), object.info.PayloadSize)
+	}
+	object.content = payload
+	s.seal(object)
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// handleCreateFolder creates the empty object of the folder for the signer.
+func (s *Server) handleCreateFolder(w http.ResponseWriter, r *http.Request, signer sdk.AccAddress, bucketName, objectName string) error {
+	if err := requireSigner(signer); err != nil {
+		return err
+	}
+	if !strings.HasSuffix(objectName, "/") {
+		return errInvalidQuery("the folder name %s should end with /", objectName)
+	}
+	visibility, err := strconv.ParseInt(r.URL.Query().Get("visibility"), 10, 32)
+	if err != nil {
+		return errInvalidQuery("invalid visibility %s", r.URL.Query().Get("visibility"))
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	bucket, ok := s.buckets[bucketName]
+	if !ok {
+		return errNoSuchBucket(bucketName)
+	}
+	if !bucket.owner.Equals(signer) {
+		return errAccessDenied("%s is not the owner of the bucket %s", signer, bucketName)
+	}
+	if _, ok = bucket.objects[objectName]; ok {
+		return newError(http.StatusConflict, "ObjectAlreadyExists", "the object %s already exists", objectName)
+	}
+	s.createObject(bucketName, objectName, signer, 0, storageTypes.VisibilityType(visibility), r.Header.Get(types.HTTPHeaderContentType))
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// handleUploadPart receives a part of the payload at the offset, the object is sealed after the part with the complete
+// query is received.
+func (s *Server) handleUploadPart(w http.ResponseWriter, r *http.Request, signer sdk.AccAddress, bucketName, objectName string) error {
+	query := r.URL.Query()
+	offset, err := strconv.ParseUint(query.Get("offset"), 10, 64)
+	if err != nil {
+		return errInvalidQuery("invalid offset %s", query.Get("offset"))
+	}
+	complete, err := strconv.ParseBool(query.Get("complete"))
+	if err != nil {
+		return errInvalidQuery("invalid complete %s", query.Get("complete"))
+	}
+	part, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	object, err := s.uploadableObject(r, signer, bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	received := uint64(len(object.content))
+	switch {
+	case offset+uint64(len(part)) <= received && offset < received:
+		// the part has been received, e.g. it is retried after its response is lost
+	case offset != received:
+		return errInvalidQuery("invalid offset %d, the uploaded size is %d", offset, received)
+	case received+uint64(len(part)) > object.info.PayloadSize:
+		return newError(http.StatusBadRequest, "InvalidPayloadSize", "the uploaded size %d exceeds the object size %d",
+			received+uint64(len(part)), object.info.PayloadSize)
+	default:
+		object.content = append(object.content, part...)
+		object.resuming = true
+	}
+	if complete {
+		if uint64(len(object.content)) != object.info.PayloadSize {
+			return newError(http.StatusBadRequest, "InvalidPayloadSize", "the uploaded size %d does not match the object size %d",
+				len(object.content), object.info.PayloadSize)
+		}
+		s.seal(object)
+	}
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// seal seals the object whose payload is received. The caller must hold the lock.
+func (s *Server) seal(object *spObject) {
+	object.resuming = false
+	object.info.ObjectStatus = storageTypes.OBJECT_STATUS_SEALED
+	object.info.IsUpdating = false
+	object.info.UpdatedAt = s.now().Unix()
+}
+
+// handleUploadProgress responds the upload progress of the object created on chain.
+func (s *Server) handleUploadProgress(w http.ResponseWriter, signer sdk.AccAddress, bucketName, objectName string) error {
+	if err := requireSigner(signer); err != nil {
+		return err
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	_, object, err := s.getObject(bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	progress := types.UploadProgress{}
+	switch {
+	case object.info.ObjectStatus == storageTypes.OBJECT_STATUS_SEALED:
+		progress.ProgressDescription = "object is sealed"
+	case len(object.content) > 0:
+		progress.ProgressDescription = fmt.Sprintf("object is uploading, %d of %d bytes uploaded", len(object.content), object.info.PayloadSize)
+	default:
+		progress.ProgressDescription = "object is waiting for uploading"
+	}
+	writeXML(w, http.StatusOK, progress)
+	return nil
+}
+
+// handleUploadContext responds the offset to resume uploading the object in parts.
+func (s *Server) handleUploadContext(w http.ResponseWriter, signer sdk.AccAddress, bucketName, objectName string) error {
+	if err := requireSigner(signer); err != nil {
+		return err
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	_, object, err := s.getObject(bucketName, objectName)
+	if err != nil {
+		return err
+	}
+	if !object.resuming {
+		// the client starts from the beginning for this message
+		return newError(http.StatusNotFound, "NoSuchUploadRecord", "no uploading record of the object %s", objectName)
+	}
+	writeXML(w, http.StatusOK, types.UploadOffset{Offset: uint64(len(object.content))})
+	return nil
+}
+
+// handleGetObject responds the payload of the sealed object in the range, it is readable by the owner of the object
+// and the bucket, or anyone if the object is public.
+func (s *Server) handleGetObject(w http.ResponseWriter, r *http.Request, signer sdk.AccAddress, bucketName, objectName string) error {
+	s.mtx.Lock()
+	bucket, object, err := s.getObject(bucketName, objectName)
+	if err != nil {
+		s.mtx.Unlock()
+		return err
+	}
+	visibility := object.info.Visibility
+	if visibility == storageTypes.VISIBILITY_TYPE_INHERIT {
+		visibility = bucket.visibility
+	}
+	readable := visibility == storageTypes.VISIBILITY_TYPE_PUBLIC_READ ||
+		signer != nil && (object.info.Owner == signer.String() || bucket.owner.Equals(signer))
+	sealed := object.info.ObjectStatus == storageTypes.OBJECT_STATUS_SEALED
+	content, contentType := object.content, object.info.ContentType
+	s.mtx.Unlock()
+
+	if !readable {
+		if signer == nil {
+			return errAccessDenied("the object %s is not public and the request is not signed", objectName)
+		}
+		return errAccessDenied("%s has no permission to read the object %s", signer, objectName)
+	}
+	if !sealed {
+		return newError(http.StatusBadRequest, "InvalidObjectState", "the object %s is not sealed", objectName)
+	}
+
+	if contentType == "" {
+		contentType = types.ContentDefault
+	}
+	w.Header().Set(types.HTTPHeaderContentType, contentType)
+	statusCode := http.StatusOK
+	if rangeInfo := r.Header.Get(types.HTTPHeaderRange); rangeInfo != "" {
+		start, end, err := parseRange(rangeInfo, int64(len(content)))
+		if err != nil {
+			return err
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(content)))
+		content = content[start : end+1]
+		statusCode = http.StatusPartialContent
+	}
+	w.Header().Set(types.HTTPHeaderContentLength, strconv.Itoa(len(content)))
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(content)
+	return nil
+}
+
+// parseRange parses the range in the "bytes=start-end" or "bytes=start-" format.
+func parseRange(rangeInfo string, size int64) (int64, int64, error) {
+	invalidRange := newError(http.StatusRequestedRangeNotSatisfiable, "InvalidRange", "invalid range %s", rangeInfo)
+	spec, ok := strings.CutPrefix(rangeInfo, "bytes=")
+	if !ok {
+		return 0, 0, invalidRange
+	}
+	startStr, endStr, ok := strings.Cut(spec, "-")
+	if !ok {
+		return 0, 0, invalidRange
+	}
+	start, err := strconv.ParseInt(startStr, 10, 64)
+	if err != nil || start >= size {
+		return 0, 0, invalidRange
+	}
+	end := size - 1
+	if endStr != "" {
+		if end, err = strconv.ParseInt(endStr, 10, 64); err != nil || end < start {
+			return 0, 0, invalidRange
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+	return start, end, nil
+}
+
+// handleListObjects responds the objects of the bucket in the lexicographical order, the objects under the delimiter
+// after the prefix are grouped into the common prefixes.
+func (s *Server) handleListObjects(w http.ResponseWriter, bucketName string, query url.Values) error {
+	maxKeys := uint64(listObjectsMaxKeys)
+	if v := query.Get("max-keys"); v != "" {
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return errInvalidQuery("invalid max-keys %s", v)
+		}
+		if n > 0 && n < maxKeys {
+			maxKeys = n
+		}
+	}
+	prefix, delimiter, startAfter := query.Get("prefix"), query.Get("delimiter"), query.Get("start-after")
+	if delimiter != "" && delimiter != "/" {
+		return errInvalidQuery("the delimiter should be /")
+	}
+	continuationToken := query.Get("continuation-token")
+	if continuationToken != "" {
+		decoded, err := base64.StdEncoding.DecodeString(continuationToken)
+		if err != nil || !strings.HasPrefix(string(decoded), prefix) {
+			return errInvalidQuery("invalid continuation-token %s", continuationToken)
+		}
+		startAfter = string(decoded)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	bucket, ok := s.buckets[bucketName]
+	if !ok {
+		return errNoSuchBucket(bucketName)
+	}
+	names := make([]string, 0, len(bucket.objects))
+	for name := range bucket.objects {
+		if strings.HasPrefix(name, prefix) && name > startAfter {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	result := types.ListObjectsResult{
+		Objects:           make([]*types.ObjectMeta, 0),
+		CommonPrefixes:    make([]string, 0),
+		MaxKeys:           strconv.FormatUint(maxKeys, 10),
+		Name:              bucketName,
+		Prefix:            prefix,
+		Delimiter:         delimiter,
+		ContinuationToken: continuationToken,
+	}
+	var count uint64
+	var lastKey string
+	for _, name := range names {
+		commonPrefix := ""
+		if delimiter != "" {
+			if i := strings.Index(name[len(prefix):], delimiter); i >= 0 {
+				commonPrefix = name[:len(prefix)+i+len(delimiter)]
+			}
+		}
+		// the common prefix is returned once, including the one ending the previous page
+		if commonPrefix != "" && (commonPrefix == lastKey || commonPrefix == startAfter) {
+			continue
+		}
+		if count == maxKeys {
+			result.IsTruncated = true
+			break
+		}
+		count++
+		if commonPrefix != "" {
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix)
+			lastKey = commonPrefix
+			continue
+		}
+		info := *bucket.objects[name].info
+		result.Objects = append(result.Objects, &types.ObjectMeta{ObjectInfo: &info})
+		lastKey = name
+	}
+	result.KeyCount = strconv.FormatUint(count, 10)
+	if result.IsTruncated {
+		result.NextContinuationToken = base64.StdEncoding.EncodeToString([]byte(lastKey))
+	}
+	writeXML(w, http.StatusOK, listObjectsResponse{ListObjectsResult: result})
+	return nil
+}
@@ -0,0 +1,282 @@
+package sptest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+const (
+	defaultSegmentSize           = 16 * 1024 * 1024
+	defaultDataShards            = 4
+	defaultParityShards          = 2
+	defaultApprovalExpiredHeight = 10000
+)
+
+// Option - The optional configurations of the Server.
+type Option struct {
+	// ApprovalAccount signs the approvals of creating the buckets and the objects and migrating the buckets, a new
+	// account is created if it is not set.
+	ApprovalAccount *types.Account
+	// ApprovalExpiredHeight is the expired height of the approvals, the default value is 10000.
+	ApprovalExpiredHeight uint64
+	// SegmentSize is the size of the segments of the objects, the default value is 16MB.
+	SegmentSize int64
+	// DataShards and ParityShards are the erasure coding parameters of the secondary pieces, the default values are 4 and 2.
+	DataShards   int
+	ParityShards int
+}
+
+type spBucket struct {
+	owner      sdk.AccAddress
+	visibility storageTypes.VisibilityType
+	objects    map[string]*spObject
+}
+
+type spObject struct {
+	info     *storageTypes.ObjectInfo
+	content  []byte // the payload received so far
+	resuming bool   // whether the object is being uploaded in parts
+}
+
+// Server - The emulator of a storage provider serving the SP APIs over HTTP.
+//
+// The Server is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mtx                   sync.Mutex
+	now                   func() time.Time
+	approvalAccount       *types.Account
+	approvalExpiredHeight uint64
+	segmentSize           int64
+	dataShards            int
+	parityShards          int
+	lastID                uint64
+	requestCount          uint64
+	buckets               map[string]*spBucket
+	objectsByID           map[uint64]*spObject
+	authNonces            map[string]int32          // the nonces of the off-chain auth keys keyed by the user and the domain
+	authKeys              map[string]*offChainKey   // the GNFD1-EDDSA keys keyed by the user and the domain
+	authKeysV2            map[string][]*offChainKey // the GNFD2-EDDSA keys keyed by the user and the domain
+}
+
+// NewServer - Start a Server without any bucket.
+//
+// - opt: The optional configurations of the Server.
+//
+// - ret: The started Server, it should be closed after use.
+func NewServer(opt Option) *Server {
+	s := &Server{
+		now:                   time.Now,
+		approvalAccount:       opt.ApprovalAccount,
+		approvalExpiredHeight: opt.ApprovalExpiredHeight,
+		segmentSize:           opt.SegmentSize,
+		dataShards:            opt.DataShards,
+		parityShards:          opt.ParityShards,
+		buckets:               make(map[string]*spBucket),
+		objectsByID:           make(map[uint64]*spObject),
+		authNonces:            make(map[string]int32),
+		authKeys:              make(map[string]*offChainKey),
+		authKeysV2:            make(map[string][]*offChainKey),
+	}
+	if s.approvalAccount == nil {
+		account, _, err := types.NewAccount("sp-approval")
+		if err != nil {
+			panic(err)
+		}
+		s.approvalAccount = account
+	}
+	if s.approvalExpiredHeight == 0 {
+		s.approvalExpiredHeight = defaultApprovalExpiredHeight
+	}
+	if s.segmentSize <= 0 {
+		s.segmentSize = defaultSegmentSize
+	}
+	if s.dataShards <= 0 || s.parityShards <= 0 {
+		s.dataShards, s.parityShards = defaultDataShards, defaultParityShards
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// SetClock - Set the clock of the Server, which is used to check the expiry of the requests and the off-chain auth keys.
+//
+// - now: The function returning the current time.
+func (s *Server) SetClock(now func() time.Time) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.now = now
+}
+
+// ApprovalAddress - Get the address of the account signing the approvals.
+func (s *Server) ApprovalAddress() sdk.AccAddress {
+	return s.approvalAccount.GetAddress()
+}
+
+// CreateBucket - Add a bucket created on chain to the Server.
+//
+// - bucketName: The name of the bucket.
+//
+// - owner: The owner of the bucket.
+//
+// - visibility: The visibility of the bucket, the objects inheriting it are public if it is VISIBILITY_TYPE_PUBLIC_READ.
+//
+// - ret: Return error when the bucket already exists, otherwise return nil.
+func (s *Server) CreateBucket(bucketName string, owner sdk.AccAddress, visibility storageTypes.VisibilityType) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if _, ok := s.buckets[bucketName]; ok {
+		return storageTypes.ErrBucketAlreadyExists.Wrapf("bucket: %s", bucketName)
+	}
+	s.buckets[bucketName] = &spBucket{owner: owner, visibility: visibility, objects: make(map[string]*spObject)}
+	return nil
+}
+
+// CreateObject - Add an object created on chain to the Server, it waits for its payload to be uploaded.
+//
+// - bucketName: The name of the bucket.
+//
+// - objectName: The name of the object.
+//
+// - owner: The owner of the object, who uploads the payload.
+//
+// - payloadSize: The size of the payload, the object is sealed once the payload of the size is uploaded.
+//
+// - visibility: The visibility of the object.
+//
+// - ret1: The id of the object.
+//
+// - ret2: Return error when the bucket does not exist or the object already exists, otherwise return nil.
+func (s *Server) CreateObject(bucketName, objectName string, owner sdk.AccAddress, payloadSize uint64,
+	visibility storageTypes.VisibilityType,
+) (sdkmath.Uint, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	bucket, ok := s.buckets[bucketName]
+	if !ok {
+		return sdkmath.ZeroUint(), storageTypes.ErrNoSuchBucket.Wrapf("bucket: %s", bucketName)
+	}
+	if _, ok = bucket.objects[objectName]; ok {
+		return sdkmath.ZeroUint(), storageTypes.ErrObjectAlreadyExists.Wrapf("bucket: %s, object: %s", bucketName, objectName)
+	}
+	object := s.createObject(bucketName, objectName, owner, payloadSize, visibility, types.ContentDefault)
+	return object.info.Id, nil
+}
+
+// createObject adds the object in the created status. The caller must hold the lock.
+func (s *Server) createObject(bucketName, objectName string, owner sdk.AccAddress, payloadSize uint64,
+	visibility storageTypes.VisibilityType, contentType string,
+) *spObject {
+	s.lastID++
+	object := &spObject{info: &storageTypes.ObjectInfo{
+		Owner:          owner.String(),
+		Creator:        owner.String(),
+		BucketName:     bucketName,
+		ObjectName:     objectName,
+		Id:             sdkmath.NewUint(s.lastID),
+		PayloadSize:    payloadSize,
+		Visibility:     visibility,
+		ContentType:    contentType,
+		CreateAt:       s.now().Unix(),
+		ObjectStatus:   storageTypes.OBJECT_STATUS_CREATED,
+		RedundancyType: storageTypes.REDUNDANCY_EC_TYPE,
+		SourceType:     storageTypes.SOURCE_TYPE_ORIGIN,
+	}}
+	s.buckets[bucketName].objects[objectName] = object
+	s.objectsByID[s.lastID] = object
+	if payloadSize == 0 {
+		object.info.ObjectStatus = storageTypes.OBJECT_STATUS_SEALED
+	}
+	return object
+}
+
+// HeadObject - Get the info of an object, including its status.
+//
+// - ret1: The copy of the object info.
+//
+// - ret2: Return error when the bucket or the object does not exist, otherwise return nil.
+func (s *Server) HeadObject(bucketName, objectName string) (*storageTypes.ObjectInfo, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	bucket, ok := s.buckets[bucketName]
+	if !ok {
+		return nil, storageTypes.ErrNoSuchBucket.Wrapf("bucket: %s", bucketName)
+	}
+	object, ok := bucket.objects[objectName]
+	if !ok {
+		return nil, storageTypes.ErrNoSuchObject.Wrapf("bucket: %s, object: %s", bucketName, objectName)
+	}
+	info := *object.info
+	return &info, nil
+}
+
+// ObjectContent - Get the payload of an object received by the Server.
+//
+// - ret1: The copy of the received payload, it is partial if the object has not been sealed.
+//
+// - ret2: Return error when the bucket or the object does not exist, otherwise return nil.
+func (s *Server) ObjectContent(bucketName, objectName string) ([]byte, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	bucket, ok := s.buckets[bucketName]
+	if !ok {
+		return nil, storageTypes.ErrNoSuchBucket.Wrapf("bucket: %s", bucketName)
+	}
+	object, ok := bucket.objects[objectName]
+	if !ok {
+		return nil, storageTypes.ErrNoSuchObject.Wrapf("bucket: %s, object: %s", bucketName, objectName)
+	}
+	return append([]byte{}, object.content...), nil
+}
+
+// serveHTTP routes the requests by their paths.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mtx.Lock()
+	s.requestCount++
+	requestID := fmt.Sprintf("%016x", s.requestCount)
+	s.mtx.Unlock()
+	w.Header().Set("X-Gnfd-Request-Id", requestID)
+
+	var err error
+	switch path := r.URL.Path; {
+	case path == "/auth/request_nonce":
+		err = s.handleRequestNonce(w, r)
+	case path == "/auth/update_key":
+		err = s.handleUpdateKey(w, r, false)
+	case path == "/auth/update_key_v2":
+		err = s.handleUpdateKey(w, r, true)
+	case path == "/auth/keys_v2":
+		err = s.handleListKeysV2(w, r)
+	case path == "/auth/delete_keys_v2":
+		err = s.handleDeleteKeysV2(w, r)
+	case strings.HasPrefix(path, types.AdminURLPrefix+"/"):
+		err = s.handleAdmin(w, r, strings.TrimPrefix(path, types.AdminURLPrefix))
+	default:
+		err = s.handleObject(w, r)
+	}
+	if err != nil {
+		writeError(w, err, requestID)
+	}
+}
+
+// bucketAndObject returns the bucket name and the object name of the request in the virtual-hosted style or the path
+// style.
+func (s *Server) bucketAndObject(r *http.Request) (string, string) {
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	serverHost := s.Listener.Addr().String()
+	if host := r.Host; host != serverHost && strings.HasSuffix(host, "."+serverHost) {
+		return strings.TrimSuffix(host, "."+serverHost), path
+	}
+	bucketName, objectName, _ := strings.Cut(path, "/")
+	return bucketName, objectName
+}
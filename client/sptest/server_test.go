@@ -0,0 +1,431 @@
+package sptest_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	hashlib "github.com/bnb-chain/greenfield-common/go/hash"
+	httplib "github.com/bnb-chain/greenfield-common/go/http"
+	"github.com/bnb-chain/greenfield-go-sdk/client"
+	"github.com/bnb-chain/greenfield-go-sdk/client/sptest"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	spTypes "github.com/bnb-chain/greenfield/x/sp/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	virtualGroupTypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+const (
+	testBucketName = "sptest-bucket"
+	testSPID       = 1
+	testFamilyID   = 1
+	// the segments are small to upload the objects in parts and to challenge their pieces
+	testSegmentSize = 16
+	testAuthDomain  = "https://sptest.example"
+)
+
+// ServerTestSuite runs the SP requests of the client against the Server, the chain queries are stubbed by the
+// interceptor serving the storage provider, the bucket and the objects.
+type ServerTestSuite struct {
+	suite.Suite
+	sp      *sptest.Server
+	client  client.IClient
+	owner   *types.Account
+	objects map[string]*storageTypes.ObjectInfo
+}
+
+func (s *ServerTestSuite) SetupTest() {
+	var err error
+	s.owner, _, err = types.NewAccount("owner")
+	s.Require().NoError(err)
+	s.sp = sptest.NewServer(sptest.Option{SegmentSize: testSegmentSize})
+	s.Require().NoError(s.sp.CreateBucket(testBucketName, s.owner.GetAddress(), storageTypes.VISIBILITY_TYPE_PRIVATE))
+	s.objects = make(map[string]*storageTypes.ObjectInfo)
+
+	// the chain is not reachable at the endpoint, all the chain queries are served by the interceptor
+	s.client = s.newClient(client.Option{})
+}
+
+// newClient returns a client of the owner whose chain queries are served by the interceptor.
+func (s *ServerTestSuite) newClient(option client.Option) client.IClient {
+	option.DefaultAccount = s.owner
+	option.ChainUnaryInterceptors = []grpc.UnaryClientInterceptor{s.chainStub}
+	c, err := client.New("greenfield_9000-121", "http://127.0.0.1:1", option)
+	s.Require().NoError(err)
+	return c
+}
+
+func (s *ServerTestSuite) TearDownTest() {
+	s.Require().NoError(s.client.Close())
+	s.sp.Close()
+}
+
+// chainStub serves the chain queries made by the SP requests of the client.
+func (s *ServerTestSuite) chainStub(_ context.Context, method string, req, reply interface{}, _ *grpc.ClientConn,
+	_ grpc.UnaryInvoker, _ ...grpc.CallOption,
+) error {
+	operator := s.sp.ApprovalAddress().String()
+	switch req := req.(type) {
+	case *spTypes.QueryStorageProvidersRequest:
+		reply.(*spTypes.QueryStorageProvidersResponse).Sps = []*spTypes.StorageProvider{{
+			Id:              testSPID,
+			OperatorAddress: operator,
+			FundingAddress:  operator,
+			SealAddress:     operator,
+			ApprovalAddress: operator,
+			GcAddress:       operator,
+			Endpoint:        s.sp.URL,
+			Status:          spTypes.STATUS_IN_SERVICE,
+		}}
+	case *storageTypes.QueryParamsRequest:
+		params := storageTypes.DefaultParams()
+		params.VersionedParams.MaxSegmentSize = testSegmentSize
+		reply.(*storageTypes.QueryParamsResponse).Params = params
+	case *storageTypes.QueryHeadBucketRequest:
+		if req.BucketName != testBucketName {
+			return storageTypes.ErrNoSuchBucket
+		}
+		reply.(*storageTypes.QueryHeadBucketResponse).BucketInfo = &storageTypes.BucketInfo{
+			Owner:                      s.owner.GetAddress().String(),
+			BucketName:                 testBucketName,
+			Visibility:                 storageTypes.VISIBILITY_TYPE_PRIVATE,
+			Id:                         sdkmath.OneUint(),
+			BucketStatus:               storageTypes.BUCKET_STATUS_CREATED,
+			GlobalVirtualGroupFamilyId: testFamilyID,
+		}
+	case *virtualGroupTypes.QueryGlobalVirtualGroupFamilyRequest:
+		reply.(*virtualGroupTypes.QueryGlobalVirtualGroupFamilyResponse).GlobalVirtualGroupFamily =
+			&virtualGroupTypes.GlobalVirtualGroupFamily{Id: testFamilyID, PrimarySpId: testSPID}
+	case *storageTypes.QueryHeadObjectRequest:
+		object, ok := s.objects[req.ObjectName]
+		if req.BucketName != testBucketName || !ok {
+			return storageTypes.ErrNoSuchObject
+		}
+		reply.(*storageTypes.QueryHeadObjectResponse).ObjectInfo = object
+	default:
+		return status.Errorf(codes.Unimplemented, "the chain query %s is not stubbed", method)
+	}
+	return nil
+}
+
+// createObject creates the object on the stubbed chain and the Server.
+func (s *ServerTestSuite) createObject(objectName string, payloadSize uint64) {
+	id, err := s.sp.CreateObject(testBucketName, objectName, s.owner.GetAddress(), payloadSize, storageTypes.VISIBILITY_TYPE_INHERIT)
+	s.Require().NoError(err)
+	s.objects[objectName] = &storageTypes.ObjectInfo{
+		Owner:        s.owner.GetAddress().String(),
+		Creator:      s.owner.GetAddress().String(),
+		BucketName:   testBucketName,
+		ObjectName:   objectName,
+		Id:           id,
+		PayloadSize:  payloadSize,
+		Visibility:   storageTypes.VISIBILITY_TYPE_INHERIT,
+		ObjectStatus: storageTypes.OBJECT_STATUS_CREATED,
+	}
+}
+
+func (s *ServerTestSuite) Test_PutGetListObjects() {
+	ctx := context.Background()
+	payload := []byte("the payload uploaded to the storage provider emulator")
+	s.createObject("object", uint64(len(payload)))
+	s.createObject("dir/object", uint64(len(payload)))
+
+	for _, objectName := range []string{"object", "dir/object"} {
+		s.Require().NoError(s.client.PutObject(ctx, testBucketName, objectName, int64(len(payload)),
+			bytes.NewReader(payload), types.PutObjectOptions{}))
+	}
+
+	var opts types.GetObjectOptions
+	s.Require().NoError(opts.SetRange(4, 10))
+	reader, info, err := s.client.GetObject(ctx, testBucketName, "object", opts)
+	s.Require().NoError(err)
+	content, err := io.ReadAll(reader)
+	s.Require().NoError(reader.Close())
+	s.Require().NoError(err)
+	s.Require().Equal(payload[4:11], content)
+	s.Require().Equal("object", info.ObjectName)
+
+	result, err := s.client.ListObjects(ctx, testBucketName, types.ListObjectsOptions{Delimiter: "/"})
+	s.Require().NoError(err)
+	s.Require().Len(result.Objects, 1)
+	s.Require().Equal("object", result.Objects[0].ObjectInfo.ObjectName)
+	s.Require().Equal([]string{"dir/"}, result.CommonPrefixes)
+}
+
+func TestServerTestSuite(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
+
+// getObject sends the request of getting the object authorized by the function, and parses the error response.
+func (s *ServerTestSuite) getObject(objectName string, authorize func(r *http.Request)) error {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s", s.sp.URL, testBucketName, objectName), nil)
+	s.Require().NoError(err)
+	req.Header.Set(httplib.HTTPHeaderExpiryTimestamp, time.Now().UTC().Add(time.Minute).Format(types.Iso8601DateFormatSecond))
+	authorize(req)
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+	return types.ConstructErrResponse(resp, testBucketName, objectName)
+}
+
+// signECDSA returns the function authorizing the requests by the GNFD1-ECDSA signature of the account.
+func (s *ServerTestSuite) signECDSA(account *types.Account) func(r *http.Request) {
+	return func(r *http.Request) {
+		signature, err := account.Sign(httplib.GetMsgToSignInGNFD1Auth(r))
+		s.Require().NoError(err)
+		r.Header.Set(types.HTTPHeaderAuthorization, httplib.Gnfd1Ecdsa+",Signature="+hex.EncodeToString(signature))
+	}
+}
+
+// requireErrResponse requires the error to be parsed from the XML error of the Server.
+func (s *ServerTestSuite) requireErrResponse(err error, statusCode int, code string) {
+	var errResp types.ErrResponse
+	s.Require().True(errors.As(err, &errResp), "unexpected error: %v", err)
+	s.Require().Equal(statusCode, errResp.StatusCode)
+	s.Require().Equal(code, errResp.Code)
+	s.Require().NotEmpty(errResp.Message)
+}
+
+func (s *ServerTestSuite) Test_Authentication() {
+	payload := []byte("the private payload")
+	s.createObject("object", uint64(len(payload)))
+	s.Require().NoError(s.client.PutObject(context.Background(), testBucketName, "object", int64(len(payload)),
+		bytes.NewReader(payload), types.PutObjectOptions{}))
+	other, _, err := types.NewAccount("other")
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name       string
+		authorize  func(r *http.Request)
+		statusCode int
+		code       string
+	}{
+		{"signed by the owner", s.signECDSA(s.owner), http.StatusOK, ""},
+		{"not signed", func(r *http.Request) {}, http.StatusForbidden, "AccessDenied"},
+		{"signed by another account", s.signECDSA(other), http.StatusForbidden, "AccessDenied"},
+		{"signature of another request", func(r *http.Request) {
+			s.signECDSA(s.owner)(r)
+			// the signature recovers another signer once a signed header is changed
+			r.Header.Set(httplib.HTTPHeaderExpiryTimestamp, time.Now().UTC().Add(2*time.Minute).Format(types.Iso8601DateFormatSecond))
+		}, http.StatusForbidden, "AccessDenied"},
+		{"truncated signature", func(r *http.Request) {
+			r.Header.Set(types.HTTPHeaderAuthorization, httplib.Gnfd1Ecdsa+",Signature=0102")
+		}, http.StatusUnauthorized, "SignatureDoesNotMatch"},
+		{"signature not in hex", func(r *http.Request) {
+			r.Header.Set(types.HTTPHeaderAuthorization, httplib.Gnfd1Ecdsa+",Signature=xyz")
+		}, http.StatusBadRequest, "InvalidAuthorization"},
+		{"expired request", func(r *http.Request) {
+			r.Header.Set(httplib.HTTPHeaderExpiryTimestamp, time.Now().UTC().Add(-time.Minute).Format(types.Iso8601DateFormatSecond))
+			s.signECDSA(s.owner)(r)
+		}, http.StatusBadRequest, "RequestExpired"},
+		{"GNFD1-EDDSA signature of an unregistered key", func(r *http.Request) {
+			r.Header.Set(types.HTTPHeaderUserAddress, s.owner.GetAddress().String())
+			r.Header.Set("X-Gnfd-App-Domain", testAuthDomain)
+			r.Header.Set(types.HTTPHeaderAuthorization, httplib.Gnfd1Eddsa+",Signature=0102")
+		}, http.StatusUnauthorized, "SignatureDoesNotMatch"},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			err := s.getObject("object", tt.authorize)
+			if tt.statusCode == http.StatusOK {
+				s.Require().NoError(err)
+				return
+			}
+			s.requireErrResponse(err, tt.statusCode, tt.code)
+		})
+	}
+}
+
+func (s *ServerTestSuite) Test_ResumableUpload() {
+	ctx := context.Background()
+	payload := []byte("the payload of two parts uploaded at the offsets")
+	s.createObject("object", uint64(len(payload)))
+	opts := types.PutObjectOptions{PartSize: 2 * testSegmentSize}
+
+	// the upload is interrupted before the last part
+	defer func() { client.UploadSegmentHooker = client.DefaultUploadSegment }()
+	client.UploadSegmentHooker = func(id int) error {
+		if id == 2 {
+			return errors.New("interrupted")
+		}
+		return nil
+	}
+	s.Require().Error(s.client.PutObject(ctx, testBucketName, "object", int64(len(payload)), bytes.NewReader(payload), opts))
+	content, err := s.sp.ObjectContent(testBucketName, "object")
+	s.Require().NoError(err)
+	s.Require().Equal(payload[:2*testSegmentSize], content)
+	info, err := s.sp.HeadObject(testBucketName, "object")
+	s.Require().NoError(err)
+	s.Require().Equal(storageTypes.OBJECT_STATUS_CREATED, info.ObjectStatus)
+
+	// the upload is resumed from the offset queried from the Server, and completed by the last part
+	var uploaded []int
+	client.UploadSegmentHooker = func(id int) error {
+		uploaded = append(uploaded, id)
+		return nil
+	}
+	s.Require().NoError(s.client.PutObject(ctx, testBucketName, "object", int64(len(payload)), bytes.NewReader(payload), opts))
+	s.Require().Equal([]int{2}, uploaded)
+	content, err = s.sp.ObjectContent(testBucketName, "object")
+	s.Require().NoError(err)
+	s.Require().Equal(payload, content)
+	info, err = s.sp.HeadObject(testBucketName, "object")
+	s.Require().NoError(err)
+	s.Require().Equal(storageTypes.OBJECT_STATUS_SEALED, info.ObjectStatus)
+}
+
+// requireApproval requires the approval to be signed by the approval account of the Server over the approval bytes.
+func (s *ServerTestSuite) requireApproval(approvalBytes, sig []byte) {
+	signer, _, err := hashlib.RecoverAddr(ethcrypto.Keccak256(approvalBytes), sig)
+	s.Require().NoError(err)
+	s.Require().Equal(s.sp.ApprovalAddress(), signer)
+}
+
+func (s *ServerTestSuite) Test_Approvals() {
+	ctx := context.Background()
+	owner := s.owner.GetAddress().String()
+
+	createBucketMsg, err := s.client.GetCreateBucketApproval(ctx, &storageTypes.MsgCreateBucket{
+		Creator: owner, BucketName: "new-bucket", PrimarySpAddress: s.sp.ApprovalAddress().String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("new-bucket", createBucketMsg.BucketName)
+	s.Require().Equal(uint64(10000), createBucketMsg.PrimarySpApproval.ExpiredHeight)
+	s.requireApproval(createBucketMsg.GetApprovalBytes(), createBucketMsg.PrimarySpApproval.Sig)
+
+	createObjectMsg, err := s.client.GetCreateObjectApproval(ctx, &storageTypes.MsgCreateObject{
+		Creator: owner, BucketName: testBucketName, ObjectName: "object", PayloadSize: 1,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("object", createObjectMsg.ObjectName)
+	s.requireApproval(createObjectMsg.GetApprovalBytes(), createObjectMsg.PrimarySpApproval.Sig)
+
+	migrateBucketMsg, err := s.client.GetMigrateBucketApproval(ctx, &storageTypes.MsgMigrateBucket{
+		Operator: owner, BucketName: testBucketName, DstPrimarySpId: testSPID,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(testBucketName, migrateBucketMsg.BucketName)
+	s.requireApproval(migrateBucketMsg.GetApprovalBytes(), migrateBucketMsg.DstPrimarySpApproval.Sig)
+
+	// the objects are approved in the buckets of the Server only
+	_, err = s.client.GetCreateObjectApproval(ctx, &storageTypes.MsgCreateObject{
+		Creator: owner, BucketName: "new-bucket", ObjectName: "object", PayloadSize: 1,
+	})
+	s.Require().Error(err)
+}
+
+func (s *ServerTestSuite) Test_ChallengeInfo() {
+	ctx := context.Background()
+	payload := []byte("the payload of the challenged object in four segments")
+	s.createObject("object", uint64(len(payload)))
+	objectID := s.objects["object"].Id.String()
+	opts := types.GetChallengeInfoOptions{Endpoint: s.sp.URL}
+
+	// the object can not be challenged until it is sealed
+	_, err := s.client.GetChallengeInfo(ctx, objectID, 0, types.PrimaryRedundancyIndex, opts)
+	s.requireErrResponse(err, http.StatusBadRequest, "InvalidObjectState")
+	s.Require().NoError(s.client.PutObject(ctx, testBucketName, "object", int64(len(payload)),
+		bytes.NewReader(payload), types.PutObjectOptions{}))
+
+	for _, useV2 := range []bool{false, true} {
+		for _, redundancyIndex := range []int{types.PrimaryRedundancyIndex, 0, 5} {
+			s.Run(fmt.Sprintf("v2 %t redundancy index %d", useV2, redundancyIndex), func() {
+				opts.UseV2version = useV2
+				result, err := s.client.GetChallengeInfo(ctx, objectID, 1, redundancyIndex, opts)
+				s.Require().NoError(err)
+				piece, err := io.ReadAll(result.PieceData)
+				s.Require().NoError(result.PieceData.Close())
+				s.Require().NoError(err)
+				if redundancyIndex == types.PrimaryRedundancyIndex {
+					s.Require().Equal(payload[testSegmentSize:2*testSegmentSize], piece)
+				}
+
+				s.Require().Len(result.PiecesHash, 4)
+				checksums := make([][]byte, len(result.PiecesHash))
+				for i, pieceHash := range result.PiecesHash {
+					checksums[i], err = hex.DecodeString(pieceHash)
+					s.Require().NoError(err)
+				}
+				s.Require().Equal(hashlib.GenerateChecksum(piece), checksums[1])
+				s.Require().Equal(hex.EncodeToString(hashlib.GenerateIntegrityHash(checksums)), result.IntegrityHash)
+			})
+		}
+	}
+}
+
+func (s *ServerTestSuite) Test_OffChainAuth() {
+	ctx := context.Background()
+	payload := []byte("the payload downloaded by the off-chain auth")
+	s.createObject("object", uint64(len(payload)))
+	s.Require().NoError(s.client.PutObject(ctx, testBucketName, "object", int64(len(payload)),
+		bytes.NewReader(payload), types.PutObjectOptions{}))
+
+	authClient := s.newClient(client.Option{OffChainAuthOption: &client.OffChainAuthOption{Seed: "sptest", Domain: testAuthDomain}})
+	defer authClient.Close()
+	_, _, err := authClient.GetObject(ctx, testBucketName, "object", types.GetObjectOptions{})
+	s.requireErrResponse(err, http.StatusUnauthorized, "SignatureDoesNotMatch")
+
+	// the key is registered with the next nonce, which is increased by the registration
+	nonce, err := authClient.GetNextNonce(s.sp.URL)
+	s.Require().NoError(err)
+	s.Require().Equal("1", nonce)
+	result, err := authClient.RegisterEDDSAPublicKey(s.sp.ApprovalAddress().String(), s.sp.URL)
+	s.Require().NoError(err)
+	s.Require().Contains(result, "<Result>true</Result>")
+	nonce, err = authClient.GetNextNonce(s.sp.URL)
+	s.Require().NoError(err)
+	s.Require().Equal("2", nonce)
+
+	reader, _, err := authClient.GetObject(ctx, testBucketName, "object", types.GetObjectOptions{})
+	s.Require().NoError(err)
+	content, err := io.ReadAll(reader)
+	s.Require().NoError(reader.Close())
+	s.Require().NoError(err)
+	s.Require().Equal(payload, content)
+
+	// the signature of the registered key is still verified
+	err = s.getObject("object", func(r *http.Request) {
+		r.Header.Set(types.HTTPHeaderUserAddress, s.owner.GetAddress().String())
+		r.Header.Set("X-Gnfd-App-Domain", testAuthDomain)
+		r.Header.Set(types.HTTPHeaderAuthorization, authClient.OffChainAuthSign([]byte("another request")))
+	})
+	s.requireErrResponse(err, http.StatusUnauthorized, "SignatureDoesNotMatch")
+}
+
+func (s *ServerTestSuite) Test_OffChainAuthV2() {
+	ctx := context.Background()
+	payload := []byte("the payload downloaded by the off-chain auth v2")
+	s.createObject("object", uint64(len(payload)))
+	s.Require().NoError(s.client.PutObject(ctx, testBucketName, "object", int64(len(payload)),
+		bytes.NewReader(payload), types.PutObjectOptions{}))
+
+	// the key is registered to the storage providers by the client
+	option := &client.OffChainAuthOptionV2{Seed: "sptest", Domain: testAuthDomain, ShouldRegisterPubKey: true}
+	authClient := s.newClient(client.Option{OffChainAuthOptionV2: option})
+	defer authClient.Close()
+	publicKeys, err := authClient.ListUserPublicKeyV2(s.sp.URL, testAuthDomain)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{option.PublicKey}, publicKeys)
+	reader, _, err := authClient.GetObject(ctx, testBucketName, "object", types.GetObjectOptions{})
+	s.Require().NoError(err)
+	s.Require().NoError(reader.Close())
+
+	deleted, err := authClient.DeleteUserPublicKeyV2(s.sp.URL, testAuthDomain, publicKeys)
+	s.Require().NoError(err)
+	s.Require().True(deleted)
+	_, _, err = authClient.GetObject(ctx, testBucketName, "object", types.GetObjectOptions{})
+	s.requireErrResponse(err, http.StatusUnauthorized, "SignatureDoesNotMatch")
+}
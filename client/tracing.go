@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	cosmosclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	oracletypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+
+	sdkclient "github.com/bnb-chain/greenfield/sdk/client"
+	gnfdSdkTypes "github.com/bnb-chain/greenfield/sdk/types"
+	bridgetypes "github.com/bnb-chain/greenfield/x/bridge/types"
+	challengetypes "github.com/bnb-chain/greenfield/x/challenge/types"
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+	virtualgrouptypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+// tracerName is the instrumentation name of the spans created by the Client.
+const tracerName = "github.com/bnb-chain/greenfield-go-sdk"
+
+// The attributes of the spans created by the Client.
+const (
+	attrBucket     = attribute.Key("gnfd.bucket")
+	attrObject     = attribute.Key("gnfd.object")
+	attrSPEndpoint = attribute.Key("gnfd.sp.endpoint")
+	attrTxHash     = attribute.Key("gnfd.tx.hash")
+	attrPartNumber = attribute.Key("gnfd.part.number")
+	attrBytes      = attribute.Key("gnfd.bytes")
+	attrAttempt    = attribute.Key("gnfd.attempt")
+	attrRPCMethod  = attribute.Key("rpc.method")
+	attrHTTPMethod = attribute.Key("http.method")
+	attrHTTPStatus = attribute.Key("http.status_code")
+)
+
+// startSpan starts a span of the API call, the span is a no-op if Option.TracerProvider is not set. The name of the
+// API is also kept in the context as the label of the metrics.
+func (c *Client) startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	ctx = context.WithValue(ctx, apiNameKey{}, strings.TrimPrefix(name, "client."))
+	return c.tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// endSpan records the error into the span and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// injectTraceContext propagates the trace context to SP in the W3C traceparent headers, the headers are not signed.
+func injectTraceContext(ctx context.Context, header http.Header) {
+	propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// chainConn traces, measures and intercepts the queries and the broadcasts sent to the chain.
+type chainConn struct {
+	grpc1.ClientConn
+	tracer  trace.Tracer
+	metrics *clientMetrics
+	invoker grpc.UnaryInvoker // invoker calls the interceptors and then the ClientConn
+}
+
+// Invoke starts a child span for the gRPC call, e.g. "/greenfield.storage.Query/HeadBucket".
+func (t chainConn) Invoke(ctx context.Context, method string, req, reply interface{}, opts ...grpc.CallOption) error {
+	_, isBroadcast := req.(*tx.BroadcastTxRequest)
+	name := "chain.query"
+	if isBroadcast {
+		name = "chain.broadcast"
+	}
+	ctx, span := t.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrRPCMethod.String(rpcMethodLabel(method))))
+	start := time.Now()
+	err := t.invoker(ctx, method, req, reply, nil, opts...)
+	if resp, ok := reply.(*tx.BroadcastTxResponse); ok && err == nil && resp.TxResponse != nil {
+		span.SetAttributes(attrTxHash.String(resp.TxResponse.TxHash))
+	}
+	endSpan(span, err)
+	// the broadcasts are measured by BroadcastTx
+	if !isBroadcast {
+		t.metrics.chainQueryDuration.Observe(time.Since(start).Seconds(), rpcMethodLabel(method))
+		t.metrics.chainQueries.Add(1, rpcMethodLabel(method), resultLabel(err))
+	}
+	return err
+}
+
+// instrumentChainClient replaces the gRPC connection of the query clients of the chain client with an instrumented one.
+// The connection queries the node at the endpoint through the ABCI query as the chain client does.
+func instrumentChainClient(cc *sdkclient.GreenfieldClient, endpoint string, customDialer func(string) (*http.Client, error),
+	tracer trace.Tracer, metrics *clientMetrics, interceptors []grpc.UnaryClientInterceptor,
+) error {
+	var (
+		rpcClient cosmosclient.TendermintRPC
+		err       error
+	)
+	if customDialer != nil {
+		rpcClient, err = cosmosclient.NewCustomClientFromNode(endpoint, customDialer)
+	} else {
+		rpcClient, err = cosmosclient.NewClientFromNode(endpoint)
+	}
+	if err != nil {
+		return err
+	}
+
+	cdc := gnfdSdkTypes.Codec()
+	clientCtx := cosmosclient.Context{}.
+		WithCodec(cdc).
+		WithInterfaceRegistry(cdc.InterfaceRegistry()).
+		WithTxConfig(authtx.NewTxConfig(cdc, []signing.SignMode{signing.SignMode_SIGN_MODE_EIP_712})).
+		WithClient(rpcClient)
+	conn := chainConn{ClientConn: clientCtx, tracer: tracer, metrics: metrics}
+	conn.invoker = chainUnaryInterceptors(interceptors, func(ctx context.Context, method string, req, reply interface{}, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return clientCtx.Invoke(ctx, method, req, reply, opts...)
+	})
+
+	cc.AuthQueryClient = authtypes.NewQueryClient(conn)
+	cc.AuthzQueryClient = authztypes.NewQueryClient(conn)
+	cc.BankQueryClient = banktypes.NewQueryClient(conn)
+	cc.ChallengeQueryClient = challengetypes.NewQueryClient(conn)
+	cc.CrosschainQueryClient = crosschaintypes.NewQueryClient(conn)
+	cc.DistrQueryClient = distrtypes.NewQueryClient(conn)
+	cc.FeegrantQueryClient = feegranttypes.NewQueryClient(conn)
+	cc.GashubQueryClient = gashubtypes.NewQueryClient(conn)
+	cc.PaymentQueryClient = paymenttypes.NewQueryClient(conn)
+	cc.SpQueryClient = sptypes.NewQueryClient(conn)
+	cc.BridgeQueryClient = bridgetypes.NewQueryClient(conn)
+	cc.StorageQueryClient = storagetypes.NewQueryClient(conn)
+	cc.GovQueryClientV1 = govv1.NewQueryClient(conn)
+	cc.OracleQueryClient = oracletypes.NewQueryClient(conn)
+	cc.SlashingQueryClient = slashingtypes.NewQueryClient(conn)
+	cc.StakingQueryClient = stakingtypes.NewQueryClient(conn)
+	cc.UpgradeQueryClient = upgradetypes.NewQueryClient(conn)
+	cc.VirtualGroupQueryClient = virtualgrouptypes.NewQueryClient(conn)
+	cc.TmClient = tmservice.NewServiceClient(conn)
+	cc.TxClient = tx.NewServiceClient(conn)
+	return nil
+}
@@ -203,6 +203,29 @@ func (s *BasicTestSuite) Test_Payment() {
 	s.Require().False(paymentAccountAfterDisableRefund.Refundable)
 }
 
+func (s *BasicTestSuite) Test_HDWallet() {
+	wallet, err := types.NewHDWallet("test", basesuite.ParseValidatorMnemonic(0))
+	s.Require().NoError(err)
+
+	// the account with index 0 is the default account of the suite
+	account0, err := wallet.Derive(0)
+	s.Require().NoError(err)
+	s.Require().Equal(s.DefaultAccount.GetAddress(), account0.GetAddress())
+
+	account1, err := wallet.Derive(1)
+	s.Require().NoError(err)
+	txHash, err := s.Client.Transfer(s.ClientContext, account1.GetAddress().String(), math.NewIntFromUint64(1), types2.TxOption{})
+	s.Require().NoError(err)
+	_, err = s.Client.WaitForTx(s.ClientContext, txHash)
+	s.Require().NoError(err)
+
+	usedAccounts, err := s.Client.ScanHDWalletAccounts(s.ClientContext, wallet, types.ScanHDWalletOptions{GapLimit: 5})
+	s.Require().NoError(err)
+	s.Require().GreaterOrEqual(len(usedAccounts), 2)
+	s.Require().Equal(account0.GetAddress(), usedAccounts[0].GetAddress())
+	s.Require().Equal(account1.GetAddress(), usedAccounts[1].GetAddress())
+}
+
 func TestBasicTestSuite(t *testing.T) {
 	suite.Run(t, new(BasicTestSuite))
 }
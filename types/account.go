@@ -2,6 +2,9 @@ package types
 
 import (
 	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/crypto/bls"
 
@@ -9,6 +12,7 @@ import (
 
 	"github.com/bnb-chain/greenfield/sdk/keys"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -16,6 +20,9 @@ import (
 type Account struct {
 	name string
 	km   keys.KeyManager
+	// privKey is the raw private key of the account, it is only used by ExportKeystore as the key manager does not
+	// expose the private key.
+	privKey []byte
 }
 
 // TransferDetail includes the target address and amount for token transfer.
@@ -38,10 +45,7 @@ func NewAccountFromPrivateKey(name, privKey string) (*Account, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Account{
-		name: name,
-		km:   km,
-	}, nil
+	return newAccount(name, km, privKey), nil
 }
 
 // NewAccountFromMnemonic - Create account instance according to mnemonic.
@@ -54,14 +58,14 @@ func NewAccountFromPrivateKey(name, privKey string) (*Account, error) {
 //
 // -ret2: Error message if the mnemonic is not correct, otherwise returns nil.
 func NewAccountFromMnemonic(name, mnemonic string) (*Account, error) {
-	km, err := keys.NewMnemonicKeyManager(mnemonic)
+	if words := len(strings.Split(mnemonic, " ")); words != 12 && words != 24 {
+		return nil, errors.New("mnemonic length should either be 12 or 24")
+	}
+	privKey, err := hd.EthSecp256k1.Derive()(mnemonic, "", keys.FullPath)
 	if err != nil {
 		return nil, err
 	}
-	return &Account{
-		name: name,
-		km:   km,
-	}, nil
+	return NewAccountFromPrivateKey(name, hex.EncodeToString(privKey))
 }
 
 // NewAccount - Create a random new account.
@@ -74,15 +78,12 @@ func NewAccountFromMnemonic(name, mnemonic string) (*Account, error) {
 //
 // -ret3: Error message.
 func NewAccount(name string) (*Account, string, error) {
-	privKey := secp256k1.GenPrivKey()
-	km, err := keys.NewPrivateKeyManager(hex.EncodeToString(privKey.Bytes()))
+	privKey := hex.EncodeToString(secp256k1.GenPrivKey().Bytes())
+	account, err := NewAccountFromPrivateKey(name, privKey)
 	if err != nil {
 		return nil, "", err
 	}
-	return &Account{
-		name: name,
-		km:   km,
-	}, hex.EncodeToString(privKey.Bytes()), nil
+	return account, privKey, nil
 }
 
 // NewBlsAccount - Create a random new account with bls key pairs.
@@ -96,14 +97,17 @@ func NewAccount(name string) (*Account, string, error) {
 // -ret3: Error message.
 func NewBlsAccount(name string) (*Account, string, error) {
 	blsPrivKey, _ := bls.RandKey()
-	km, err := keys.NewBlsPrivateKeyManager(hex.EncodeToString(blsPrivKey.Marshal()))
+	privKey := hex.EncodeToString(blsPrivKey.Marshal())
+	km, err := keys.NewBlsPrivateKeyManager(privKey)
 	if err != nil {
 		return nil, "", err
 	}
-	return &Account{
-		name: name,
-		km:   km,
-	}, hex.EncodeToString(blsPrivKey.Marshal()), nil
+	return newAccount(name, km, privKey), privKey, nil
+}
+
+// GetName - Get the name of the account.
+func (a *Account) GetName() string {
+	return a.name
 }
 
 // GetKeyManager - Get the key manager of the account.
@@ -120,3 +124,36 @@ func (a *Account) GetAddress() sdk.AccAddress {
 func (a *Account) Sign(unsignBytes []byte) ([]byte, error) {
 	return a.km.Sign(unsignBytes)
 }
+
+// newAccount creates the account of the key manager, the HEX-encoded private key has been validated by the key manager.
+func newAccount(name string, km keys.KeyManager, privKey string) *Account {
+	privKeyBytes, _ := hex.DecodeString(privKey)
+	return &Account{
+		name:    name,
+		km:      km,
+		privKey: privKeyBytes,
+	}
+}
+
+// PooledAccountStats indicates the throughput statistics of one account in an account pool.
+type PooledAccountStats struct {
+	Address      string // Address is the HEX-encoded string of the account address.
+	PendingTxs   int64  // PendingTxs is the number of txs which have been submitted but not confirmed yet.
+	SubmittedTxs uint64 // SubmittedTxs is the number of txs which have been submitted successfully.
+	FailedTxs    uint64 // FailedTxs is the number of txs which failed to be submitted or executed.
+	Objects      uint64 // Objects is the number of objects uploaded by the account.
+	Bytes        uint64 // Bytes is the number of payload bytes uploaded by the account.
+}
+
+// AccountPoolStats indicates the aggregate throughput statistics of an account pool.
+type AccountPoolStats struct {
+	Accounts       []PooledAccountStats
+	PendingTxs     int64
+	SubmittedTxs   uint64
+	FailedTxs      uint64
+	Objects        uint64
+	Bytes          uint64
+	Elapsed        time.Duration // Elapsed is the time since the pool was created.
+	TxsPerSecond   float64
+	BytesPerSecond float64
+}
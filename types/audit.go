@@ -0,0 +1,83 @@
+package types
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	AuditPrincipalOwner   = "owner"   // the owner of the bucket, who has full permissions
+	AuditPrincipalPublic  = "public"  // anyone, granted by the public-read visibility
+	AuditPrincipalAccount = "account" // an account granted by a policy
+	AuditPrincipalGroup   = "group"   // a group granted by a policy
+	AuditPrincipalMember  = "member"  // a member of a group granted by a policy
+
+	AuditFlagPublic            = "public"              // the resource is public-read
+	AuditFlagPublicInherited   = "public_inherited"    // the object inherits the public-read visibility of the bucket
+	AuditFlagExpiredPolicy     = "expired_policy"      // the policy has expired
+	AuditFlagExpiredStatement  = "expired_statement"   // the statement has expired
+	AuditFlagExpiredMember     = "expired_member"      // the membership of the group member has expired
+	AuditFlagPrincipalNotFound = "principal_not_found" // the principal group does not exist anymore
+)
+
+// PermissionAuditEntry - A permission of a principal on a resource for an action, it is a row of the audit report.
+type PermissionAuditEntry struct {
+	// Resource is the GRN of the resource, e.g. "grn:b::reports", "grn:o::reports/a.txt" or "grn:g:0x...:analysts".
+	Resource string `json:"resource"`
+	// PrincipalType is one of owner, public, account, group and member.
+	PrincipalType string `json:"principal_type"`
+	// Principal is the address of the account, the id of the group, or "*" for the public.
+	Principal string `json:"principal"`
+	// Group is the id of the group granting the permission to its member.
+	Group  string `json:"group,omitempty"`
+	Action string `json:"action"`
+	Effect string `json:"effect"`
+	// Scope is the resources matched by the statement of a bucket policy, the statement applies to the bucket itself if it is empty.
+	Scope []string `json:"scope,omitempty"`
+	// Expiration is the earliest expiration time of the policy, the statement and the membership.
+	Expiration *time.Time `json:"expiration,omitempty"`
+	Flags      []string   `json:"flags,omitempty"`
+}
+
+// PermissionAuditReport - The permissions granted on a bucket, its objects and the groups referred by their policies.
+type PermissionAuditReport struct {
+	Bucket      string                 `json:"bucket"`
+	Owner       string                 `json:"owner"`
+	GeneratedAt time.Time              `json:"generated_at"`
+	Entries     []PermissionAuditEntry `json:"entries"`
+}
+
+var auditCSVHeader = []string{"resource", "principal_type", "principal", "group", "action", "effect", "scope", "expiration", "flags"}
+
+// WriteJSON - Export the report in indented JSON.
+func (r *PermissionAuditReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteCSV - Export the entries of the report in CSV with a header, one row per principal, resource and action.
+// The scopes and the flags are separated by "|", and the expiration is in RFC3339.
+func (r *PermissionAuditReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(auditCSVHeader); err != nil {
+		return err
+	}
+	for _, entry := range r.Entries {
+		expiration := ""
+		if entry.Expiration != nil {
+			expiration = entry.Expiration.UTC().Format(time.RFC3339)
+		}
+		if err := writer.Write([]string{
+			entry.Resource, entry.PrincipalType, entry.Principal, entry.Group, entry.Action, entry.Effect,
+			strings.Join(entry.Scope, "|"), expiration, strings.Join(entry.Flags, "|"),
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+func TestPermissionAuditReportExport(t *testing.T) {
+	expiration := time.Date(2025, 1, 2, 3, 4, 5, 0, time.FixedZone("UTC+8", 8*3600))
+	tests := []struct {
+		name  string
+		entry types.PermissionAuditEntry
+		row   []string
+	}{
+		{
+			"owner",
+			types.PermissionAuditEntry{Resource: "grn:b::reports", PrincipalType: types.AuditPrincipalOwner, Principal: "0x01",
+				Action: "ACTION_TYPE_ALL", Effect: "EFFECT_ALLOW"},
+			[]string{"grn:b::reports", "owner", "0x01", "", "ACTION_TYPE_ALL", "EFFECT_ALLOW", "", "", ""},
+		},
+		{
+			"public object inheriting the bucket",
+			types.PermissionAuditEntry{Resource: "grn:o::reports/a,b.txt", PrincipalType: types.AuditPrincipalPublic, Principal: "*",
+				Action: "ACTION_GET_OBJECT", Effect: "EFFECT_ALLOW", Flags: []string{types.AuditFlagPublic, types.AuditFlagPublicInherited}},
+			[]string{"grn:o::reports/a,b.txt", "public", "*", "", "ACTION_GET_OBJECT", "EFFECT_ALLOW", "", "", "public|public_inherited"},
+		},
+		{
+			"expired member with scopes",
+			types.PermissionAuditEntry{Resource: "grn:b::reports", PrincipalType: types.AuditPrincipalMember, Principal: "0x02",
+				Group: "7", Action: "ACTION_GET_OBJECT", Effect: "EFFECT_ALLOW",
+				Scope:      []string{"grn:o::reports/a/.*$", "grn:o::reports/b/.*$"},
+				Expiration: &expiration, Flags: []string{types.AuditFlagExpiredMember}},
+			[]string{"grn:b::reports", "member", "0x02", "7", "ACTION_GET_OBJECT", "EFFECT_ALLOW",
+				"grn:o::reports/a/.*$|grn:o::reports/b/.*$", "2025-01-01T19:04:05Z", "expired_member"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := &types.PermissionAuditReport{Bucket: "reports", Owner: "0x01", Entries: []types.PermissionAuditEntry{tt.entry}}
+
+			var buf bytes.Buffer
+			require.NoError(t, report.WriteCSV(&buf))
+			rows, err := csv.NewReader(&buf).ReadAll()
+			require.NoError(t, err)
+			require.Equal(t, [][]string{
+				{"resource", "principal_type", "principal", "group", "action", "effect", "scope", "expiration", "flags"},
+				tt.row,
+			}, rows)
+
+			buf.Reset()
+			require.NoError(t, report.WriteJSON(&buf))
+			var decoded types.PermissionAuditReport
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+			require.Len(t, decoded.Entries, 1)
+			if tt.entry.Expiration != nil {
+				require.True(t, tt.entry.Expiration.Equal(*decoded.Entries[0].Expiration))
+				decoded.Entries[0].Expiration = tt.entry.Expiration
+			}
+			require.Equal(t, tt.entry, decoded.Entries[0])
+		})
+	}
+}
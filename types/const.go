@@ -72,4 +72,30 @@ const (
 
 	WaitTxContextTimeOut = 1 * time.Second
 	DefaultExpireSeconds = 1000
+
+	DefaultHDWalletGapLimit = 20
+
+	DefaultMaxPendingTxPerAccount = 8
+
+	DefaultMaxMsgsPerTx = 10
+
+	DefaultBulkUpdateConcurrency = 4
+
+	DefaultGroupExpirationWindow   = time.Hour * 24 * 7
+	DefaultGroupExpirationInterval = time.Hour
+
+	DefaultRetryMaxAttempts    = 3
+	DefaultRetryInitialBackoff = time.Millisecond * 500
+	DefaultRetryMaxBackoff     = time.Second * 5
+
+	DefaultRPCHealthCheckInterval = time.Second * 30
+	DefaultRPCMaxBlockLag         = 5
+
+	DefaultSPCooldown         = time.Second * 30
+	DefaultSPFailureThreshold = 3
+
+	DefaultCacheParamsTTL           = time.Minute * 10
+	DefaultCacheStorageProvidersTTL = time.Minute
+	DefaultCacheBucketRouteTTL      = time.Minute * 5
+	DefaultCacheBucketInfoTTL       = time.Second * 30
 )
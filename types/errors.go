@@ -2,11 +2,23 @@ package types
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"strings"
+	"syscall"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
 )
 
 const unknownErr = "unknown error"
@@ -14,8 +26,58 @@ const unknownErr = "unknown error"
 var (
 	ErrorDefaultAccountNotExist = errors.New("Default account of client is not exist ")
 	ErrorProposalIDNotFound     = errors.New("Proposal ID not found ")
+	// ErrorOffChainAuthAccountOverride is returned when a request to SP is signed by another account than the default
+	// one with the off-chain auth, whose EdDSA key is only registered for the default account.
+	ErrorOffChainAuthAccountOverride = errors.New("the account can not be overridden per call with the off-chain auth")
+)
+
+// The sentinel errors classifying the errors of SP and the chain, they are matched by errors.Is with the ErrResponse
+// and the TxError, or by the IsXXX helpers with any error returned by the Client, including the gRPC status errors of
+// the chain queries.
+var (
+	ErrNotFound            = errors.New("not found")
+	ErrBucketNotFound      = fmt.Errorf("bucket %w", ErrNotFound)
+	ErrObjectNotFound      = fmt.Errorf("object %w", ErrNotFound)
+	ErrGroupNotFound       = fmt.Errorf("group %w", ErrNotFound)
+	ErrPolicyNotFound      = fmt.Errorf("policy %w", ErrNotFound)
+	ErrAccessDenied        = errors.New("access denied")
+	ErrQuotaExhausted      = errors.New("quota exhausted")
+	ErrInsufficientBalance = errors.New("insufficient balance")
+	ErrSequenceMismatch    = errors.New("account sequence mismatch")
+	ErrObjectNotSealed     = errors.New("object not sealed")
+	ErrSPUnavailable       = errors.New("storage provider unavailable")
 )
 
+// errorKinds are the sentinel errors in the order of matching, the specific ones are matched first.
+var errorKinds = []error{
+	ErrBucketNotFound, ErrObjectNotFound, ErrGroupNotFound, ErrPolicyNotFound, ErrNotFound,
+	ErrAccessDenied, ErrQuotaExhausted, ErrInsufficientBalance, ErrSequenceMismatch, ErrObjectNotSealed, ErrSPUnavailable,
+}
+
+// chainErrorKinds maps the registered errors of the chain to the sentinel errors. The ABCI codes of the query errors
+// are not kept in the gRPC status errors, so the queries are classified by the descriptions of the registered errors.
+var chainErrorKinds = []struct {
+	err  *errorsmod.Error
+	kind error
+}{
+	{storagetypes.ErrNoSuchBucket, ErrBucketNotFound},
+	{storagetypes.ErrNoSuchObject, ErrObjectNotFound},
+	{storagetypes.ErrNoSuchGroup, ErrGroupNotFound},
+	{storagetypes.ErrNoSuchPolicy, ErrPolicyNotFound},
+	{storagetypes.ErrNoSuchGroupMember, ErrNotFound},
+	{paymenttypes.ErrPaymentAccountNotFound, ErrNotFound},
+	{paymenttypes.ErrStreamRecordNotFound, ErrNotFound},
+	{sdkerrors.ErrKeyNotFound, ErrNotFound},
+	{sdkerrors.ErrNotFound, ErrNotFound},
+	{storagetypes.ErrAccessDenied, ErrAccessDenied},
+	{sdkerrors.ErrUnauthorized, ErrAccessDenied},
+	{paymenttypes.ErrInsufficientBalance, ErrInsufficientBalance},
+	{sdkerrors.ErrInsufficientFunds, ErrInsufficientBalance},
+	{sdkerrors.ErrInsufficientFee, ErrInsufficientBalance},
+	{sdkerrors.ErrWrongSequence, ErrSequenceMismatch},
+	{storagetypes.ErrObjectNotSealed, ErrObjectNotSealed},
+}
+
 // ErrResponse define the information of the error response
 type ErrResponse struct {
 	XMLName    xml.Name `xml:"Error"`
@@ -30,6 +92,191 @@ func (r ErrResponse) Error() string {
 		r.StatusCode, r.Code, r.Message)
 }
 
+// Is reports whether the error response is classified as the sentinel error, e.g. ErrNotFound.
+func (r ErrResponse) Is(target error) bool {
+	kind := classifyErrResponse(r)
+	return kind != nil && errors.Is(kind, target)
+}
+
+// classifyErrResponse classifies the error response of SP by its code, its message relayed from the chain, and its
+// status code in order.
+func classifyErrResponse(r ErrResponse) error {
+	switch r.Code {
+	case "NoSuchBucket":
+		return ErrBucketNotFound
+	case "NoSuchObject", "NoSuchKey":
+		return ErrObjectNotFound
+	case "NoSuchGroup":
+		return ErrGroupNotFound
+	case "NoSuchPolicy":
+		return ErrPolicyNotFound
+	case "AccessDenied":
+		return ErrAccessDenied
+	case "ServiceUnavailable":
+		return ErrSPUnavailable
+	}
+	if strings.Contains(r.Message, NoSuchObjectErr) {
+		return ErrObjectNotFound
+	}
+	if kind := classifyMessage(r.Message); kind != nil {
+		return kind
+	}
+	switch r.StatusCode {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusForbidden:
+		return ErrAccessDenied
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return ErrSPUnavailable
+	}
+	return nil
+}
+
+// classifyMessage classifies the error message by the descriptions of the registered errors of the chain.
+func classifyMessage(message string) error {
+	message = strings.ToLower(message)
+	for _, chainErr := range chainErrorKinds {
+		if strings.Contains(message, strings.ToLower(chainErr.err.Error())) {
+			return chainErr.kind
+		}
+	}
+	if strings.Contains(message, "quota") {
+		for _, exhausted := range []string{"not enough", "insufficient", "exceed", "exhaust"} {
+			if strings.Contains(message, exhausted) {
+				return ErrQuotaExhausted
+			}
+		}
+	}
+	return nil
+}
+
+// TxError - The error of a tx failed on the chain, it keeps the ABCI code of the tx result for the classification.
+type TxError struct {
+	Codespace string
+	Code      uint32
+	Log       string
+	msg       string
+}
+
+// NewTxError - Create the error of a failed tx with the ABCI code and log of the tx result, the error message is
+// formatted by the format and args.
+func NewTxError(codespace string, code uint32, log string, format string, args ...interface{}) *TxError {
+	return &TxError{Codespace: codespace, Code: code, Log: log, msg: fmt.Sprintf(format, args...)}
+}
+
+// Error returns the error msg
+func (e *TxError) Error() string {
+	return e.msg
+}
+
+// Is reports whether the failed tx is classified as the sentinel error, e.g. ErrSequenceMismatch.
+func (e *TxError) Is(target error) bool {
+	kind := ClassifyError(errorsmod.ABCIError(e.Codespace, e.Code, e.Log))
+	return kind != nil && errors.Is(kind, target)
+}
+
+// ClassifyError - Classify the error returned by the Client as one of the sentinel errors.
+//
+// - err: The error returned by the Client, the SP error responses, the failed txs, the registered errors of the chain
+// and the gRPC status errors of the chain queries are classified.
+//
+// - ret1: The most specific sentinel error matching the error, e.g. ErrBucketNotFound, or nil if it is not classified.
+func ClassifyError(err error) error {
+	if err == nil {
+		return nil
+	}
+	for _, kind := range errorKinds {
+		if errors.Is(err, kind) {
+			return kind
+		}
+	}
+	for _, chainErr := range chainErrorKinds {
+		if errors.Is(err, chainErr.err) {
+			return chainErr.kind
+		}
+	}
+	if st, ok := status.FromError(err); ok && st.Code() != codes.OK {
+		if kind := classifyMessage(st.Message()); kind != nil {
+			return kind
+		}
+		if st.Code() == codes.NotFound {
+			return ErrNotFound
+		}
+	}
+	return nil
+}
+
+// IsNotFound - Check whether the error means the bucket, object, group, policy or other resource does not exist.
+func IsNotFound(err error) bool {
+	return errors.Is(ClassifyError(err), ErrNotFound)
+}
+
+// IsAccessDenied - Check whether the error means the account has no permission on the resource.
+func IsAccessDenied(err error) bool {
+	return errors.Is(ClassifyError(err), ErrAccessDenied)
+}
+
+// IsQuotaExhausted - Check whether the error means the read quota of the bucket is exhausted.
+func IsQuotaExhausted(err error) bool {
+	return errors.Is(ClassifyError(err), ErrQuotaExhausted)
+}
+
+// IsInsufficientBalance - Check whether the error means the balance of the account is insufficient.
+func IsInsufficientBalance(err error) bool {
+	return errors.Is(ClassifyError(err), ErrInsufficientBalance)
+}
+
+// IsSequenceMismatch - Check whether the error means the tx is signed with a stale account sequence.
+func IsSequenceMismatch(err error) bool {
+	return errors.Is(ClassifyError(err), ErrSequenceMismatch)
+}
+
+// IsObjectNotSealed - Check whether the error means the object has not been sealed.
+func IsObjectNotSealed(err error) bool {
+	return errors.Is(ClassifyError(err), ErrObjectNotSealed)
+}
+
+// IsSPUnavailable - Check whether the error means the storage provider can not be reached or is out of service.
+func IsSPUnavailable(err error) bool {
+	return errors.Is(ClassifyError(err), ErrSPUnavailable)
+}
+
+// IsRetryable - Check whether the error is transient, so the request may succeed if it is sent again.
+//
+// The SP errors of throttling and unavailability, the transient network errors and the sequence mismatches are
+// retryable, the tx should be signed again with the refreshed sequence for the latter. The canceled requests are not.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var errResp ErrResponse
+	if errors.As(err, &errResp) {
+		switch errResp.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		switch errResp.Code {
+		case "InternalError", "SlowDown", "ServiceUnavailable", "RequestTimeout":
+			return true
+		}
+		return false
+	}
+	if kind := ClassifyError(err); kind == ErrSPUnavailable || kind == ErrSequenceMismatch {
+		return true
+	}
+	if st, ok := status.FromError(err); ok && (st.Code() == codes.Unavailable || st.Code() == codes.ResourceExhausted) {
+		return true
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
 // ConstructErrResponse  checks the response is an error response
 func ConstructErrResponse(r *http.Response, bucketName, objectName string) error {
 	if c := r.StatusCode; 200 <= c && c <= 299 {
@@ -0,0 +1,335 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// PublicReadBucketAllowedActions and PublicReadObjectAllowedActions are the read actions allowed to anyone on the
+// public-read buckets and objects, the same as the ones of x/storage.
+var (
+	PublicReadBucketAllowedActions = map[permTypes.ActionType]bool{
+		permTypes.ACTION_GET_OBJECT:     true,
+		permTypes.ACTION_COPY_OBJECT:    true,
+		permTypes.ACTION_EXECUTE_OBJECT: true,
+		permTypes.ACTION_LIST_OBJECT:    true,
+	}
+	PublicReadObjectAllowedActions = map[permTypes.ActionType]bool{
+		permTypes.ACTION_GET_OBJECT:     true,
+		permTypes.ACTION_COPY_OBJECT:    true,
+		permTypes.ACTION_EXECUTE_OBJECT: true,
+	}
+)
+
+// PermissionSnapshot - The state deciding the permission of an operator on a bucket or an object, which is evaluated
+// offline by EvaluatePermission. It can be collected by the PermissionEvaluator of the client or built by hand.
+type PermissionSnapshot struct {
+	// Operator is the hex address of the operator, it is empty for the anonymous users.
+	Operator string                   `json:"operator,omitempty"`
+	Bucket   *storageTypes.BucketInfo `json:"bucket"`
+	// Object is nil when the permission on the bucket is evaluated.
+	Object *storageTypes.ObjectInfo `json:"object,omitempty"`
+	// BucketPolicy is the policy of the operator on the bucket.
+	BucketPolicy *permTypes.Policy `json:"bucket_policy,omitempty"`
+	// ObjectPolicy is the policy of the operator on the object.
+	ObjectPolicy *permTypes.Policy `json:"object_policy,omitempty"`
+	// Groups are the groups the operator is a member of.
+	Groups []GroupPermissionSnapshot `json:"groups,omitempty"`
+}
+
+// GroupPermissionSnapshot - The membership of the operator in a group and the policies of the group.
+type GroupPermissionSnapshot struct {
+	GroupID   uint64 `json:"group_id"`
+	GroupName string `json:"group_name,omitempty"`
+	// MemberExpiration is the expiration time of the membership, the membership never expires if it is nil.
+	MemberExpiration *time.Time        `json:"member_expiration,omitempty"`
+	BucketPolicy     *permTypes.Policy `json:"bucket_policy,omitempty"`
+	ObjectPolicy     *permTypes.Policy `json:"object_policy,omitempty"`
+}
+
+// MatchedStatement - A statement producing the decision of the permission.
+type MatchedStatement struct {
+	// Source describes the policy of the statement, e.g. "bucket policy of group analysts (id 12)".
+	Source    string               `json:"source"`
+	Index     int                  `json:"index"` // Index is the index of the statement in the policy.
+	Statement *permTypes.Statement `json:"statement"`
+}
+
+// String returns the statement and its source in a human-readable format.
+func (m *MatchedStatement) String() string {
+	actions := make([]string, 0, len(m.Statement.Actions))
+	for _, action := range m.Statement.Actions {
+		actions = append(actions, action.String())
+	}
+	s := fmt.Sprintf("statement %d of the %s: %s %s", m.Index, m.Source, m.Statement.Effect, strings.Join(actions, ","))
+	if len(m.Statement.Resources) > 0 {
+		s += " on " + strings.Join(m.Statement.Resources, ",")
+	}
+	return s
+}
+
+// PermissionDecision - The effect of the permission together with the reason and the statements producing it.
+type PermissionDecision struct {
+	// Effect is EFFECT_ALLOW or EFFECT_DENY, the same as the one returned by the chain.
+	Effect permTypes.Effect `json:"effect"`
+	// Reason explains the decision in one sentence.
+	Reason string `json:"reason"`
+	// Statements are the statements producing the decision, it is empty if the decision is not made by the policies.
+	Statements []*MatchedStatement `json:"statements,omitempty"`
+	// Trace records every step of the evaluation, including the policies and the statements being skipped.
+	Trace []string `json:"trace,omitempty"`
+}
+
+// String returns the decision, the statements and the trace in a human-readable format.
+func (d *PermissionDecision) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s\n", d.Effect, d.Reason)
+	for _, statement := range d.Statements {
+		fmt.Fprintf(&b, "  by %s\n", statement)
+	}
+	for _, step := range d.Trace {
+		fmt.Fprintf(&b, "  - %s\n", step)
+	}
+	return b.String()
+}
+
+type permissionEvaluation struct {
+	snapshot   *PermissionSnapshot
+	action     permTypes.ActionType
+	now        time.Time
+	wantedSize *uint64
+	decision   *PermissionDecision
+}
+
+func (e *permissionEvaluation) trace(format string, args ...interface{}) {
+	e.decision.Trace = append(e.decision.Trace, fmt.Sprintf(format, args...))
+}
+
+func (e *permissionEvaluation) decide(effect permTypes.Effect, statements []*MatchedStatement, format string, args ...interface{}) *PermissionDecision {
+	e.decision.Effect = effect
+	e.decision.Statements = statements
+	e.decision.Reason = fmt.Sprintf(format, args...)
+	return e.decision
+}
+
+// EvaluatePermission - Evaluate the permission of the operator in the snapshot offline, with the same rules as the chain:
+// the public-read visibility, the ownership, the explicit deny over allow, the object policies over the bucket policies,
+// the resources of the bucket statements, and the expiration of the policies, the statements and the group members.
+//
+// - snapshot: The state deciding the permission, the permission on the object is evaluated if the object is set.
+//
+// - action: The action to be evaluated.
+//
+// - opts: The options to set the evaluation time and the wanted size of ACTION_CREATE_OBJECT.
+//
+// - ret1: The decision of the permission with its explanation.
+//
+// - ret2: Return error when the snapshot is invalid, otherwise return nil.
+func EvaluatePermission(snapshot *PermissionSnapshot, action permTypes.ActionType, opts EvaluatePermissionOptions) (*PermissionDecision, error) {
+	if snapshot.Bucket == nil {
+		return nil, fmt.Errorf("the bucket of the permission snapshot is not set")
+	}
+	var operator sdk.AccAddress
+	if snapshot.Operator != "" {
+		var err error
+		if operator, err = sdk.AccAddressFromHexUnsafe(snapshot.Operator); err != nil {
+			return nil, fmt.Errorf("invalid operator %s: %w", snapshot.Operator, err)
+		}
+	}
+	e := &permissionEvaluation{
+		snapshot:   snapshot,
+		action:     action,
+		now:        opts.Time,
+		wantedSize: opts.WantedSize,
+		decision:   &PermissionDecision{},
+	}
+	if e.now.IsZero() {
+		e.now = time.Now()
+	}
+	if snapshot.Object == nil {
+		return e.evalBucket(operator), nil
+	}
+	return e.evalObject(operator), nil
+}
+
+func (e *permissionEvaluation) evalBucket(operator sdk.AccAddress) *PermissionDecision {
+	bucket := e.snapshot.Bucket
+	if bucket.Visibility == storageTypes.VISIBILITY_TYPE_PUBLIC_READ {
+		if PublicReadBucketAllowedActions[e.action] {
+			return e.decide(permTypes.EFFECT_ALLOW, nil, "the bucket %s is public-read and %s is a read action", bucket.BucketName, e.action)
+		}
+		e.trace("the bucket %s is public-read but %s is not a read action", bucket.BucketName, e.action)
+	}
+	if operator.Empty() {
+		return e.decide(permTypes.EFFECT_DENY, nil, "the anonymous users can only read the public-read resources")
+	}
+	if strings.EqualFold(bucket.Owner, operator.String()) {
+		return e.decide(permTypes.EFFECT_ALLOW, nil, "the operator is the owner of the bucket %s", bucket.BucketName)
+	}
+
+	effect, statements := e.verifyPolicy("bucket", func(group *GroupPermissionSnapshot) *permTypes.Policy { return group.BucketPolicy },
+		e.snapshot.BucketPolicy, "")
+	if effect == permTypes.EFFECT_ALLOW {
+		return e.decide(permTypes.EFFECT_ALLOW, statements, "the bucket policies allow %s", e.action)
+	}
+	if effect == permTypes.EFFECT_DENY {
+		return e.decide(permTypes.EFFECT_DENY, statements, "the bucket policies deny %s explicitly", e.action)
+	}
+	return e.decide(permTypes.EFFECT_DENY, nil, "no statement of the bucket policies allows %s", e.action)
+}
+
+func (e *permissionEvaluation) evalObject(operator sdk.AccAddress) *PermissionDecision {
+	bucket, object := e.snapshot.Bucket, e.snapshot.Object
+	switch {
+	case object.Visibility == storageTypes.VISIBILITY_TYPE_PUBLIC_READ && PublicReadObjectAllowedActions[e.action]:
+		return e.decide(permTypes.EFFECT_ALLOW, nil, "the object %s is public-read and %s is a read action", object.ObjectName, e.action)
+	case object.Visibility == storageTypes.VISIBILITY_TYPE_INHERIT && bucket.Visibility == storageTypes.VISIBILITY_TYPE_PUBLIC_READ &&
+		PublicReadObjectAllowedActions[e.action]:
+		return e.decide(permTypes.EFFECT_ALLOW, nil, "the object %s inherits the public-read visibility of the bucket %s and %s is a read action",
+			object.ObjectName, bucket.BucketName, e.action)
+	}
+	e.trace("the object %s is not public for %s with the visibility %s, and the visibility of the bucket is %s",
+		object.ObjectName, e.action, object.Visibility, bucket.Visibility)
+	if operator.Empty() {
+		return e.decide(permTypes.EFFECT_DENY, nil, "the anonymous users can only read the public-read resources")
+	}
+	if strings.EqualFold(object.Owner, operator.String()) {
+		return e.decide(permTypes.EFFECT_ALLOW, nil, "the operator is the owner of the object %s", object.ObjectName)
+	}
+
+	// the statements of the bucket policies apply to the object only if their resources match the object
+	resource := gnfdTypes.NewObjectGRN(object.BucketName, object.ObjectName).String()
+	bucketEffect, bucketStatements := e.verifyPolicy("bucket", func(group *GroupPermissionSnapshot) *permTypes.Policy { return group.BucketPolicy },
+		e.snapshot.BucketPolicy, resource)
+	if bucketEffect == permTypes.EFFECT_DENY {
+		return e.decide(permTypes.EFFECT_DENY, bucketStatements, "the bucket policies deny %s on the object explicitly", e.action)
+	}
+	objectEffect, objectStatements := e.verifyPolicy("object", func(group *GroupPermissionSnapshot) *permTypes.Policy { return group.ObjectPolicy },
+		e.snapshot.ObjectPolicy, "")
+	switch {
+	case objectEffect == permTypes.EFFECT_DENY:
+		return e.decide(permTypes.EFFECT_DENY, objectStatements, "the object policies deny %s explicitly", e.action)
+	case objectEffect == permTypes.EFFECT_ALLOW && bucketEffect == permTypes.EFFECT_ALLOW:
+		return e.decide(permTypes.EFFECT_ALLOW, append(bucketStatements, objectStatements...), "both the bucket and the object policies allow %s", e.action)
+	case objectEffect == permTypes.EFFECT_ALLOW:
+		return e.decide(permTypes.EFFECT_ALLOW, objectStatements, "the object policies allow %s", e.action)
+	case bucketEffect == permTypes.EFFECT_ALLOW:
+		return e.decide(permTypes.EFFECT_ALLOW, bucketStatements, "the bucket policies allow %s on the object", e.action)
+	}
+	return e.decide(permTypes.EFFECT_DENY, nil, "no statement of the bucket or the object policies allows %s", e.action)
+}
+
+// verifyPolicy evaluates the policy of the operator first, then the policies of its groups if the policy of the
+// operator is unspecified, an explicit deny of any group wins over the allows of the others.
+func (e *permissionEvaluation) verifyPolicy(kind string, groupPolicy func(group *GroupPermissionSnapshot) *permTypes.Policy,
+	accountPolicy *permTypes.Policy, resource string,
+) (permTypes.Effect, []*MatchedStatement) {
+	if accountPolicy != nil {
+		effect, statements := e.evalPolicy(kind+" policy of the operator", accountPolicy, resource)
+		if effect != permTypes.EFFECT_UNSPECIFIED {
+			return effect, statements
+		}
+	} else {
+		e.trace("the operator has no %s policy", kind)
+	}
+
+	var allowed []*MatchedStatement
+	for i := range e.snapshot.Groups {
+		group := &e.snapshot.Groups[i]
+		policy := groupPolicy(group)
+		if policy == nil {
+			continue
+		}
+		source := fmt.Sprintf("%s policy of group %s (id %d)", kind, group.GroupName, group.GroupID)
+		effect, statements := e.evalPolicy(source, policy, resource)
+		if effect == permTypes.EFFECT_UNSPECIFIED {
+			continue
+		}
+		if group.MemberExpiration != nil && !group.MemberExpiration.After(e.now) {
+			e.trace("the %s is ignored since the membership of the operator expired at %s", source, group.MemberExpiration.UTC().Format(time.RFC3339))
+			continue
+		}
+		if effect == permTypes.EFFECT_DENY {
+			return permTypes.EFFECT_DENY, statements
+		}
+		allowed = append(allowed, statements...)
+	}
+	if len(allowed) > 0 {
+		return permTypes.EFFECT_ALLOW, allowed
+	}
+	return permTypes.EFFECT_UNSPECIFIED, nil
+}
+
+// evalPolicy evaluates the statements of the policy, an explicit deny wins over the allows.
+func (e *permissionEvaluation) evalPolicy(source string, policy *permTypes.Policy, resource string) (permTypes.Effect, []*MatchedStatement) {
+	if policy.ExpirationTime != nil && policy.ExpirationTime.Before(e.now) {
+		e.trace("the %s expired at %s", source, policy.ExpirationTime.UTC().Format(time.RFC3339))
+		return permTypes.EFFECT_UNSPECIFIED, nil
+	}
+	var allowed []*MatchedStatement
+	for i, statement := range policy.Statements {
+		if statement.ExpirationTime != nil && statement.ExpirationTime.Before(e.now) {
+			e.trace("statement %d of the %s expired at %s", i, source, statement.ExpirationTime.UTC().Format(time.RFC3339))
+			continue
+		}
+		matched := &MatchedStatement{Source: source, Index: i, Statement: statement}
+		switch e.evalStatement(matched, resource) {
+		case permTypes.EFFECT_DENY:
+			return permTypes.EFFECT_DENY, []*MatchedStatement{matched}
+		case permTypes.EFFECT_ALLOW:
+			allowed = append(allowed, matched)
+		}
+	}
+	if len(allowed) > 0 {
+		return permTypes.EFFECT_ALLOW, allowed
+	}
+	e.trace("no statement of the %s matches %s", source, e.action)
+	return permTypes.EFFECT_UNSPECIFIED, nil
+}
+
+// evalStatement evaluates the statement without consuming its limit size as the chain does when creating an object.
+func (e *permissionEvaluation) evalStatement(matched *MatchedStatement, resource string) permTypes.Effect {
+	statement := matched.Statement
+	if resource != "" {
+		if statement.Resources == nil {
+			e.trace("%s is skipped since it has no resources to match the object", matched)
+			return permTypes.EFFECT_UNSPECIFIED
+		}
+		isMatch := false
+		for _, res := range statement.Resources {
+			reg, err := regexp.Compile(res)
+			if err == nil && reg.MatchString(resource) {
+				isMatch = true
+				break
+			}
+		}
+		if !isMatch {
+			e.trace("%s is skipped since its resources do not match %s", matched, resource)
+			return permTypes.EFFECT_UNSPECIFIED
+		}
+	}
+
+	for _, action := range statement.Actions {
+		if action != e.action && action != permTypes.ACTION_TYPE_ALL {
+			continue
+		}
+		if statement.Effect == permTypes.EFFECT_DENY {
+			return permTypes.EFFECT_DENY
+		}
+		if e.action == permTypes.ACTION_CREATE_OBJECT && statement.LimitSize != nil && e.wantedSize != nil &&
+			statement.LimitSize.GetValue() < *e.wantedSize {
+			e.trace("%s denies since the wanted size %d exceeds its remaining limit size %d", matched, *e.wantedSize, statement.LimitSize.GetValue())
+			return permTypes.EFFECT_DENY
+		}
+		return statement.Effect
+	}
+	return permTypes.EFFECT_UNSPECIFIED
+}
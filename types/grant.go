@@ -0,0 +1,190 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	GrantKindPolicy      = "policy"       // the grant is an object policy expiring at the expiration time
+	GrantKindGroupMember = "group_member" // the grant is a group membership expiring at the expiration time
+)
+
+// Grant - A time-boxed grant of an object to a principal, it is recorded in a GrantRegistry until it is swept.
+type Grant struct {
+	ID     string `json:"id"`
+	Kind   string `json:"kind"`
+	Bucket string `json:"bucket"`
+	Object string `json:"object"`
+	// Principal is the HEX-encoded address of the account granted.
+	Principal string `json:"principal"`
+	// Actions are the actions of the policy, they are decided by the policies of the group for the group member grants.
+	Actions []string `json:"actions,omitempty"`
+	// Group and GroupOwner identify the group of the group member grants.
+	Group      string `json:"group,omitempty"`
+	GroupOwner string `json:"group_owner,omitempty"`
+	// ExistingMember means the principal was a member of the group before the grant, the membership is renewed by the
+	// grant and is not removed by the sweep.
+	ExistingMember bool      `json:"existing_member,omitempty"`
+	TxHash         string    `json:"tx_hash"`
+	CreatedAt      time.Time `json:"created_at"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+// Expired returns whether the grant has expired at the time.
+func (g *Grant) Expired(now time.Time) bool {
+	return !g.ExpiresAt.After(now)
+}
+
+// GrantRegistry - The registry recording the grants until they are swept, the implementations should be safe for
+// concurrent use.
+type GrantRegistry interface {
+	// Put records the grant, it replaces the grant with the same id.
+	Put(grant *Grant) error
+	// List returns all the recorded grants in the order of their expiration time.
+	List() ([]*Grant, error)
+	// Delete removes the grant, it does nothing if the grant is not recorded.
+	Delete(id string) error
+}
+
+// memoryGrantRegistry keeps the grants in memory.
+type memoryGrantRegistry struct {
+	mtx    sync.Mutex
+	grants map[string]*Grant
+}
+
+// NewMemoryGrantRegistry - Create a registry keeping the grants in memory, they are lost when the process exits.
+func NewMemoryGrantRegistry() GrantRegistry {
+	return &memoryGrantRegistry{grants: make(map[string]*Grant)}
+}
+
+func (r *memoryGrantRegistry) Put(grant *Grant) error {
+	if grant.ID == "" {
+		return errors.New("the id of the grant is empty")
+	}
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	copied := *grant
+	r.grants[grant.ID] = &copied
+	return nil
+}
+
+func (r *memoryGrantRegistry) List() ([]*Grant, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	grants := make([]*Grant, 0, len(r.grants))
+	for _, grant := range r.grants {
+		copied := *grant
+		grants = append(grants, &copied)
+	}
+	sortGrants(grants)
+	return grants, nil
+}
+
+func (r *memoryGrantRegistry) Delete(id string) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	delete(r.grants, id)
+	return nil
+}
+
+// fileGrantRegistry keeps the grants in a JSON file, which is rewritten atomically on every change.
+type fileGrantRegistry struct {
+	mtx    sync.Mutex
+	path   string
+	memory *memoryGrantRegistry
+}
+
+// NewFileGrantRegistry - Create a registry keeping the grants in a JSON file, so that the grants can be swept by
+// another process, e.g. a cron job.
+//
+// - path: The path of the file, it is created on the first change if it does not exist.
+//
+// - ret1: The registry loaded from the file.
+//
+// - ret2: Return error when the file can not be read or is malformed, otherwise return nil.
+func NewFileGrantRegistry(path string) (GrantRegistry, error) {
+	r := &fileGrantRegistry{path: path, memory: &memoryGrantRegistry{grants: make(map[string]*Grant)}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return r, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var grants []*Grant
+	if err = json.Unmarshal(data, &grants); err != nil {
+		return nil, fmt.Errorf("invalid grant registry %s: %w", path, err)
+	}
+	for _, grant := range grants {
+		r.memory.grants[grant.ID] = grant
+	}
+	return r, nil
+}
+
+func (r *fileGrantRegistry) Put(grant *Grant) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if err := r.memory.Put(grant); err != nil {
+		return err
+	}
+	return r.save()
+}
+
+func (r *fileGrantRegistry) List() ([]*Grant, error) {
+	return r.memory.List()
+}
+
+func (r *fileGrantRegistry) Delete(id string) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if err := r.memory.Delete(id); err != nil {
+		return err
+	}
+	return r.save()
+}
+
+// save writes the grants to the file atomically, the caller should hold the lock.
+func (r *fileGrantRegistry) save() error {
+	grants, err := r.memory.List()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(grants, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(r.path, data)
+}
+
+// writeFileAtomic writes the data to a temporary file and renames it, so that the file is either the old or the new one.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func sortGrants(grants []*Grant) {
+	sort.Slice(grants, func(i, j int) bool {
+		if !grants[i].ExpiresAt.Equal(grants[j].ExpiresAt) {
+			return grants[i].ExpiresAt.Before(grants[j].ExpiresAt)
+		}
+		return grants[i].ID < grants[j].ID
+	})
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	GroupExpirationEventExpiring    = "expiring"     // the member expires within the window
+	GroupExpirationEventExpired     = "expired"      // the member has expired but is still recorded in the group
+	GroupExpirationEventRenewed     = "renewed"      // the member has been renewed by a rule
+	GroupExpirationEventRenewFailed = "renew_failed" // the member matched a rule but failed to be renewed
+	GroupExpirationEventCheckFailed = "check_failed" // the groups or the members failed to be listed
+)
+
+// GroupMemberExpiration - A group member with its expiration time.
+type GroupMemberExpiration struct {
+	GroupOwner     string    `json:"group_owner"`
+	GroupName      string    `json:"group_name"`
+	GroupID        string    `json:"group_id"`
+	Member         string    `json:"member"`
+	ExpirationTime time.Time `json:"expiration_time"`
+}
+
+// GroupExpirationEvent - The event emitted by the GroupExpirationMonitor.
+type GroupExpirationEvent struct {
+	Type string
+	// Member is the member the event is about, it is empty for GroupExpirationEventCheckFailed.
+	Member GroupMemberExpiration
+	// RenewedUntil and TxHash are set for GroupExpirationEventRenewed.
+	RenewedUntil time.Time
+	TxHash       string
+	// Err is set for GroupExpirationEventRenewFailed and GroupExpirationEventCheckFailed.
+	Err error
+}
+
+// String returns the human-readable description of the event.
+func (e GroupExpirationEvent) String() string {
+	switch e.Type {
+	case GroupExpirationEventRenewed:
+		return fmt.Sprintf("%s of the group %s renewed until %s in the tx %s", e.Member.Member, e.Member.GroupName,
+			e.RenewedUntil.UTC().Format(time.RFC3339), e.TxHash)
+	case GroupExpirationEventRenewFailed:
+		return fmt.Sprintf("%s of the group %s failed to be renewed: %v", e.Member.Member, e.Member.GroupName, e.Err)
+	case GroupExpirationEventCheckFailed:
+		return fmt.Sprintf("failed to check the group member expirations: %v", e.Err)
+	default:
+		return fmt.Sprintf("%s of the group %s %s at %s", e.Member.Member, e.Member.GroupName, e.Type,
+			e.Member.ExpirationTime.UTC().Format(time.RFC3339))
+	}
+}
+
+// GroupRenewalRule - The rule renewing the expiring members of a group, e.g. renew everyone in the group for 30 days.
+type GroupRenewalRule struct {
+	// GroupName is the name of the group owned by the monitored owner.
+	GroupName string
+	// Members are the HEX-encoded addresses of the members renewed, all the members of the group are renewed if it is empty.
+	Members []string
+	// Duration is how long the members are renewed for, counted from the time of the renewal.
+	Duration time.Duration
+}
+
+// Validate checks whether the rule is well-formed.
+func (r GroupRenewalRule) Validate() error {
+	if r.GroupName == "" {
+		return errors.New("the group name of the renewal rule is empty")
+	}
+	if r.Duration <= 0 {
+		return fmt.Errorf("the duration of the renewal rule of the group %s should be positive, got %s", r.GroupName, r.Duration)
+	}
+	for _, member := range r.Members {
+		if _, err := sdk.AccAddressFromHexUnsafe(member); err != nil {
+			return fmt.Errorf("invalid member %s of the renewal rule of the group %s: %w", member, r.GroupName, err)
+		}
+	}
+	return nil
+}
+
+// Matches returns whether the member of the group is renewed by the rule.
+func (r GroupRenewalRule) Matches(groupName, member string) bool {
+	if r.GroupName != groupName {
+		return false
+	}
+	if len(r.Members) == 0 {
+		return true
+	}
+	addr, err := sdk.AccAddressFromHexUnsafe(member)
+	if err != nil {
+		return false
+	}
+	for _, m := range r.Members {
+		if ruleAddr, err := sdk.AccAddressFromHexUnsafe(m); err == nil && ruleAddr.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+func TestGroupRenewalRuleMatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    types.GroupRenewalRule
+		group   string
+		member  string
+		matched bool
+	}{
+		{"all the members", types.GroupRenewalRule{GroupName: "team"}, "team", memberA, true},
+		{"another group", types.GroupRenewalRule{GroupName: "team"}, "guests", memberA, false},
+		{"listed member", types.GroupRenewalRule{GroupName: "team", Members: []string{memberB, memberA}}, "team", memberA, true},
+		{"unlisted member", types.GroupRenewalRule{GroupName: "team", Members: []string{memberB}}, "team", memberA, false},
+		{"listed member in another case", types.GroupRenewalRule{GroupName: "team", Members: []string{strings.ToUpper(memberA[2:])}}, "team", memberA, true},
+		{"invalid member", types.GroupRenewalRule{GroupName: "team", Members: []string{memberA}}, "team", "0x11", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.matched, tt.rule.Matches(tt.group, tt.member))
+		})
+	}
+}
+
+func TestGroupRenewalRuleValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    types.GroupRenewalRule
+		wantErr bool
+	}{
+		{"valid rule", types.GroupRenewalRule{GroupName: "team", Members: []string{memberA}, Duration: time.Hour}, false},
+		{"no group name", types.GroupRenewalRule{Duration: time.Hour}, true},
+		{"no duration", types.GroupRenewalRule{GroupName: "team"}, true},
+		{"negative duration", types.GroupRenewalRule{GroupName: "team", Duration: -time.Hour}, true},
+		{"invalid member", types.GroupRenewalRule{GroupName: "team", Members: []string{"0x11"}, Duration: time.Hour}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
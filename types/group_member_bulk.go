@@ -0,0 +1,92 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// GroupMemberCheckpoint - The progress of BulkUpdateGroupMember, it is saved to a file after every confirmed tx so
+// that an interrupted update can be resumed without resubmitting the confirmed members.
+type GroupMemberCheckpoint struct {
+	GroupOwner string `json:"group_owner"`
+	GroupName  string `json:"group_name"`
+	// Added and Removed are the members added and removed by the confirmed txs.
+	Added    []string `json:"added,omitempty"`
+	Removed  []string `json:"removed,omitempty"`
+	TxHashes []string `json:"tx_hashes,omitempty"`
+
+	mtx  sync.Mutex
+	path string
+}
+
+// LoadGroupMemberCheckpoint - Load the checkpoint of the group from the file, an empty checkpoint is returned if the
+// file does not exist.
+//
+// - path: The path of the checkpoint file.
+//
+// - groupOwner: The HEX-encoded string of the group owner address.
+//
+// - groupName: The group name identifies the group.
+//
+// - ret1: The checkpoint saved to the same path.
+//
+// - ret2: Return error when the file can not be read, is malformed or belongs to another group, otherwise return nil.
+func LoadGroupMemberCheckpoint(path, groupOwner, groupName string) (*GroupMemberCheckpoint, error) {
+	checkpoint := &GroupMemberCheckpoint{GroupOwner: groupOwner, GroupName: groupName, path: path}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return checkpoint, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("invalid group member checkpoint %s: %w", path, err)
+	}
+	if checkpoint.GroupOwner != groupOwner || checkpoint.GroupName != groupName {
+		return nil, fmt.Errorf("the checkpoint %s belongs to the group %s of %s", path, checkpoint.GroupName, checkpoint.GroupOwner)
+	}
+	return checkpoint, nil
+}
+
+// Record adds the members of a confirmed tx to the checkpoint and saves it, it is safe for concurrent use.
+func (c *GroupMemberCheckpoint) Record(txHash string, added, removed []string) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.Added = append(c.Added, added...)
+	c.Removed = append(c.Removed, removed...)
+	c.TxHashes = append(c.TxHashes, txHash)
+	if c.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(c.path, data)
+}
+
+// Remove deletes the checkpoint file once the update has completed.
+func (c *GroupMemberCheckpoint) Remove() error {
+	if c.path == "" {
+		return nil
+	}
+	if err := os.Remove(c.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// BulkGroupMemberUpdateResult - The result of BulkUpdateGroupMember.
+type BulkGroupMemberUpdateResult struct {
+	// Added and Removed are the members changed by the confirmed txs, including the ones resumed from the checkpoint.
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+	// AlreadyMembers are the members to add which were members of the group, NotMembers are the members to remove which
+	// were not members of the group, they are skipped.
+	AlreadyMembers []string `json:"already_members,omitempty"`
+	NotMembers     []string `json:"not_members,omitempty"`
+	TxHashes       []string `json:"tx_hashes,omitempty"`
+}
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GroupMemberSyncReport - The changes of the members made by SyncGroupMembers, or to be made in the dry run.
+type GroupMemberSyncReport struct {
+	GroupOwner string          `json:"group_owner"`
+	GroupName  string          `json:"group_name"`
+	GroupID    string          `json:"group_id"`
+	Added      []DesiredMember `json:"added,omitempty"`
+	Renewed    []DesiredMember `json:"renewed,omitempty"`
+	Removed    []string        `json:"removed,omitempty"`
+	Unchanged  int             `json:"unchanged"`
+	// TxHashes are the hashes of the confirmed txs, it is empty in the dry run.
+	TxHashes []string `json:"tx_hashes,omitempty"`
+	DryRun   bool     `json:"dry_run"`
+}
+
+// String returns the human-readable summary of the changes, one member per line.
+func (r *GroupMemberSyncReport) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "group %s (id %s) of %s: %d added, %d renewed, %d removed, %d unchanged", r.GroupName, r.GroupID,
+		r.GroupOwner, len(r.Added), len(r.Renewed), len(r.Removed), r.Unchanged)
+	if r.DryRun {
+		sb.WriteString(" (dry run)")
+	}
+	for _, member := range r.Added {
+		sb.WriteString("\n  + " + member.String())
+	}
+	for _, member := range r.Renewed {
+		sb.WriteString("\n  ~ " + member.String())
+	}
+	for _, member := range r.Removed {
+		sb.WriteString("\n  - " + member)
+	}
+	return sb.String()
+}
+
+// String returns the address of the member, followed by the expiration time if it is set.
+func (m DesiredMember) String() string {
+	if m.Expiration == nil {
+		return m.Address
+	}
+	return fmt.Sprintf("%s (expires %s)", m.Address, m.Expiration.UTC().Format(time.RFC3339))
+}
+
+// ParseDesiredMembersCSV - Parse the desired members of a group from CSV, e.g. exported from an HR system.
+//
+// Each record has the HEX-encoded address of the member and an optional RFC3339 expiration time, the member never
+// expires if the expiration time is empty. The first record is skipped if it is a header starting with "address".
+//
+// - r: The reader of the CSV.
+//
+// - ret1: The desired members.
+//
+// - ret2: Return error when the CSV is malformed or an address or an expiration time is invalid, otherwise return nil.
+func ParseDesiredMembersCSV(r io.Reader) ([]DesiredMember, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) > 0 && len(records[0]) > 0 && strings.EqualFold(strings.TrimSpace(records[0][0]), "address") {
+		records = records[1:]
+	}
+
+	members := make([]DesiredMember, 0, len(records))
+	for i, record := range records {
+		if len(record) > 2 {
+			return nil, fmt.Errorf("record %d: expect at most 2 fields, got %d", i+1, len(record))
+		}
+		member := DesiredMember{Address: strings.TrimSpace(record[0])}
+		if len(record) == 2 && strings.TrimSpace(record[1]) != "" {
+			expiration, err := time.Parse(time.RFC3339, strings.TrimSpace(record[1]))
+			if err != nil {
+				return nil, fmt.Errorf("record %d: invalid expiration time: %w", i+1, err)
+			}
+			member.Expiration = &expiration
+		}
+		if err = validateDesiredMember(member); err != nil {
+			return nil, fmt.Errorf("record %d: %w", i+1, err)
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+// ParseDesiredMembersJSON - Parse the desired members of a group from a JSON array, e.g.
+// [{"address": "0x...", "expiration": "2025-01-01T00:00:00Z"}].
+//
+// - data: The JSON array of the desired members, the unknown fields are rejected.
+//
+// - ret1: The desired members.
+//
+// - ret2: Return error when the JSON is malformed or an address is invalid, otherwise return nil.
+func ParseDesiredMembersJSON(data []byte) ([]DesiredMember, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var members []DesiredMember
+	if err := decoder.Decode(&members); err != nil {
+		return nil, err
+	}
+	for i, member := range members {
+		if err := validateDesiredMember(member); err != nil {
+			return nil, fmt.Errorf("member %d: %w", i, err)
+		}
+	}
+	return members, nil
+}
+
+func validateDesiredMember(member DesiredMember) error {
+	if member.Address == "" {
+		return errors.New("the address of the member is empty")
+	}
+	if _, err := sdk.AccAddressFromHexUnsafe(member.Address); err != nil {
+		return fmt.Errorf("invalid address %s: %w", member.Address, err)
+	}
+	return nil
+}
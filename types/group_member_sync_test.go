@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+const (
+	memberA = "0x1111111111111111111111111111111111111111"
+	memberB = "0x2222222222222222222222222222222222222222"
+)
+
+func TestParseDesiredMembers(t *testing.T) {
+	expiration := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		csv     string
+		json    string
+		members []types.DesiredMember
+		wantErr bool
+	}{
+		{
+			name:    "members with and without expiration",
+			csv:     "address,expiration\n" + memberA + ",2025-01-01T00:00:00Z\n" + memberB + "\n",
+			json:    `[{"address":"` + memberA + `","expiration":"2025-01-01T00:00:00Z"},{"address":"` + memberB + `"}]`,
+			members: []types.DesiredMember{{Address: memberA, Expiration: &expiration}, {Address: memberB}},
+		},
+		{
+			name:    "comments, spaces and empty expiration",
+			csv:     "# exported from the HR system\n" + memberA + ", \n " + memberB + "\n",
+			json:    `[{"address":"` + memberA + `"},{"address":"` + memberB + `","expiration":null}]`,
+			members: []types.DesiredMember{{Address: memberA}, {Address: memberB}},
+		},
+		{
+			name:    "no members",
+			csv:     "address,expiration\n",
+			json:    `[]`,
+			members: []types.DesiredMember{},
+		},
+		{
+			name:    "invalid address",
+			csv:     "0x1234\n",
+			json:    `[{"address":"0x1234"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "empty address",
+			csv:     ",2025-01-01T00:00:00Z\n",
+			json:    `[{"expiration":"2025-01-01T00:00:00Z"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid expiration",
+			csv:     memberA + ",2025-01-01\n",
+			json:    `[{"address":"` + memberA + `","expiration":"2025-01-01"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "extra fields",
+			csv:     memberA + ",2025-01-01T00:00:00Z,admin\n",
+			json:    `[{"address":"` + memberA + `","role":"admin"}]`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fromCSV, err := types.ParseDesiredMembersCSV(strings.NewReader(tt.csv))
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.members, fromCSV)
+			}
+
+			fromJSON, err := types.ParseDesiredMembersJSON([]byte(tt.json))
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.members, fromJSON)
+			}
+		})
+	}
+}
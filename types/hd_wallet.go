@@ -0,0 +1,157 @@
+package types
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/go-bip39"
+)
+
+const (
+	// HDPathPrefix is the BIP44 path prefix of Greenfield accounts, the account index is appended to it.
+	HDPathPrefix = "m/44'/60'/0'/0/"
+	// MaxHDWalletIndex is the max account index, the index is not hardened in the path and BIP32 limits the
+	// non-hardened indexes to 2^31-1.
+	MaxHDWalletIndex uint32 = 1<<31 - 1
+)
+
+// HDWallet derives indexed accounts from one mnemonic with the path m/44'/60'/0'/0/i.
+//
+// The derived accounts are cached in the wallet, so that they can be listed and reused.
+type HDWallet struct {
+	name     string
+	mnemonic string
+
+	mtx      sync.RWMutex
+	accounts map[uint32]*Account
+}
+
+// HDPath returns the BIP44 derivation path of the account with the given index, the index can not exceed
+// MaxHDWalletIndex.
+func HDPath(index uint32) (string, error) {
+	if index > MaxHDWalletIndex {
+		return "", fmt.Errorf("the account index %d exceeds the max index %d", index, MaxHDWalletIndex)
+	}
+	return fmt.Sprintf("%s%d", HDPathPrefix, index), nil
+}
+
+// NewHDWallet - Create a HD wallet instance according to mnemonic.
+//
+// -name: The wallet name, the derived accounts are named as "name-index".
+//
+// -mnemonic: The mnemonic string.
+//
+// -ret1: The pointer of the created wallet instance.
+//
+// -ret2: Error message if the mnemonic is not correct, otherwise returns nil.
+func NewHDWallet(name, mnemonic string) (*HDWallet, error) {
+	words := strings.Split(mnemonic, " ")
+	if len(words) != 12 && len(words) != 24 {
+		return nil, errors.New("mnemonic length should either be 12 or 24")
+	}
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, errors.New("invalid mnemonic")
+	}
+	return &HDWallet{
+		name:     name,
+		mnemonic: mnemonic,
+		accounts: make(map[uint32]*Account),
+	}, nil
+}
+
+// Derive - Derive the account with the given index, the account is derived only once and reused afterwards.
+//
+// -index: The account index in the path m/44'/60'/0'/0/index, it can not exceed MaxHDWalletIndex.
+//
+// -ret1: The pointer of the derived account instance.
+//
+// -ret2: Error message if the derivation failed, otherwise returns nil.
+func (w *HDWallet) Derive(index uint32) (*Account, error) {
+	w.mtx.RLock()
+	account, ok := w.accounts[index]
+	w.mtx.RUnlock()
+	if ok {
+		return account, nil
+	}
+
+	path, err := HDPath(index)
+	if err != nil {
+		return nil, err
+	}
+	privKey, err := hd.EthSecp256k1.Derive()(w.mnemonic, "", path)
+	if err != nil {
+		return nil, err
+	}
+	account, err = NewAccountFromPrivateKey(fmt.Sprintf("%s-%d", w.name, index), hex.EncodeToString(privKey))
+	if err != nil {
+		return nil, err
+	}
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	if cached, ok := w.accounts[index]; ok {
+		return cached, nil
+	}
+	w.accounts[index] = account
+	return account, nil
+}
+
+// DeriveRange - Derive the accounts with indexes in [start, start+count), the range can not exceed MaxHDWalletIndex.
+func (w *HDWallet) DeriveRange(start, count uint32) ([]*Account, error) {
+	if count == 0 {
+		return []*Account{}, nil
+	}
+	if start > MaxHDWalletIndex || count-1 > MaxHDWalletIndex-start {
+		return nil, fmt.Errorf("the range of %d accounts from the index %d exceeds the max index %d", count, start, MaxHDWalletIndex)
+	}
+	accounts := make([]*Account, 0, count)
+	for i := start; i < start+count; i++ {
+		account, err := w.Derive(i)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
+// Indexes - List the indexes of the accounts which have been derived, in ascending order.
+func (w *HDWallet) Indexes() []uint32 {
+	w.mtx.RLock()
+	defer w.mtx.RUnlock()
+	indexes := make([]uint32, 0, len(w.accounts))
+	for index := range w.accounts {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	return indexes
+}
+
+// Accounts - List the accounts which have been derived, ordered by index.
+func (w *HDWallet) Accounts() []*Account {
+	indexes := w.Indexes()
+	w.mtx.RLock()
+	defer w.mtx.RUnlock()
+	accounts := make([]*Account, 0, len(indexes))
+	for _, index := range indexes {
+		accounts = append(accounts, w.accounts[index])
+	}
+	return accounts
+}
+
+// IndexOf - Return the index of the derived account with the given HEX-encoded address.
+func (w *HDWallet) IndexOf(address string) (uint32, bool) {
+	w.mtx.RLock()
+	defer w.mtx.RUnlock()
+	for index, account := range w.accounts {
+		if strings.EqualFold(account.GetAddress().String(), address) {
+			return index, true
+		}
+	}
+	return 0, false
+}
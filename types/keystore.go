@@ -0,0 +1,133 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/bnb-chain/greenfield/sdk/keys"
+	ethbls "github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+const (
+	// KeystoreVersion is the version of keystore json files generated by the SDK.
+	KeystoreVersion = 3
+	// BlsKeystoreType marks a keystore json file which stores an encrypted bls private key.
+	BlsKeystoreType = "bls"
+)
+
+var ErrorKeystoreTypeMismatch = errors.New("the keystore type does not match the account type")
+
+// blsKeystoreJSON is the layout of a keystore file holding a bls private key. It shares the crypto section
+// with the Ethereum keystore v3 format, so the same passphrase tooling can be used to manage it.
+type blsKeystoreJSON struct {
+	Type    string              `json:"type"`
+	PubKey  string              `json:"pubkey"`
+	Crypto  keystore.CryptoJSON `json:"crypto"`
+	ID      string              `json:"id"`
+	Version int                 `json:"version"`
+}
+
+// NewAccountFromKeystore - Create account instance from an Ethereum keystore v3 json file content.
+//
+// -name: Account name.
+//
+// -keyJSON: The content of the keystore file, encrypted with scrypt and AES-CTR.
+//
+// -passphrase: The passphrase used to decrypt the keystore.
+//
+// -ret1: The pointer of the created account instance.
+//
+// -ret2: Error message if the keystore or the passphrase is not correct, otherwise returns nil.
+func NewAccountFromKeystore(name string, keyJSON []byte, passphrase string) (*Account, error) {
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return NewAccountFromPrivateKey(name, hex.EncodeToString(crypto.FromECDSA(key.PrivateKey)))
+}
+
+// NewBlsAccountFromKeystore - Create account instance with bls key pairs from a bls keystore json file content.
+//
+// -name: Account name.
+//
+// -keyJSON: The content of the bls keystore file, it is generated by ExportKeystore of a bls account.
+//
+// -passphrase: The passphrase used to decrypt the keystore.
+//
+// -ret1: The pointer of the created account instance.
+//
+// -ret2: Error message if the keystore or the passphrase is not correct, otherwise returns nil.
+func NewBlsAccountFromKeystore(name string, keyJSON []byte, passphrase string) (*Account, error) {
+	var blsKey blsKeystoreJSON
+	if err := json.Unmarshal(keyJSON, &blsKey); err != nil {
+		return nil, err
+	}
+	if blsKey.Type != BlsKeystoreType {
+		return nil, ErrorKeystoreTypeMismatch
+	}
+	if blsKey.Version != KeystoreVersion {
+		return nil, fmt.Errorf("version not supported: %v", blsKey.Version)
+	}
+	privKey, err := keystore.DecryptDataV3(blsKey.Crypto, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	km, err := keys.NewBlsPrivateKeyManager(hex.EncodeToString(privKey))
+	if err != nil {
+		return nil, err
+	}
+	if blsKey.PubKey != "" && blsKey.PubKey != hex.EncodeToString(km.PubKey().Bytes()) {
+		return nil, errors.New("the bls public key does not match the decrypted private key")
+	}
+	return &Account{
+		name:    name,
+		km:      km,
+		privKey: privKey,
+	}, nil
+}
+
+// ExportKeystore - Encrypt the private key of the account with the passphrase and return the keystore json file content.
+//
+// The eth_secp256k1 accounts are exported in the Ethereum keystore v3 format, so the result can also be loaded by
+// bsctypes.NewBscAccountFromKeystore. The bls accounts are exported in a keystore file sharing the same crypto section.
+//
+// -passphrase: The passphrase used to encrypt the keystore.
+//
+// -ret1: The content of the keystore file.
+//
+// -ret2: Error message if the encryption failed, otherwise returns nil.
+func (a *Account) ExportKeystore(passphrase string) ([]byte, error) {
+	privKey := a.privKey
+	if len(privKey) == 0 {
+		return nil, errors.New("the private key of the account is not available")
+	}
+	if a.km.Type() == ethbls.KeyType {
+		cryptoJSON, err := keystore.EncryptDataV3(privKey, []byte(passphrase), keystore.StandardScryptN, keystore.StandardScryptP)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(blsKeystoreJSON{
+			Type:    BlsKeystoreType,
+			PubKey:  hex.EncodeToString(a.km.PubKey().Bytes()),
+			Crypto:  cryptoJSON,
+			ID:      uuid.New().String(),
+			Version: KeystoreVersion,
+		})
+	}
+
+	privateKey, err := crypto.ToECDSA(privKey)
+	if err != nil {
+		return nil, err
+	}
+	key := &keystore.Key{
+		Id:         uuid.New(),
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}
+	return keystore.EncryptKey(key, passphrase, keystore.StandardScryptN, keystore.StandardScryptP)
+}
@@ -11,13 +11,15 @@ import (
 )
 
 type SetTagsOptions struct {
-	TxOpts *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	TxOpts  *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account *Account               // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 // CreateBucketOptions indicates the metadata to construct `CreateBucket` msg of storage module.
 type CreateBucketOptions struct {
 	Visibility     storageTypes.VisibilityType // Visibility defines the bucket public status.
 	TxOpts         *gnfdsdktypes.TxOption      // TxOpts defines the options to customize a transaction.
+	Account        *Account                    // Account defines the account signing the transaction instead of the default account of the client.
 	PaymentAddress string                      // PaymentAddress indicates the HEX-encoded string of the payment address.
 	ChargedQuota   uint64                      // ChargedQuota defines the read data that users are charged for, measured in bytes.
 	IsAsyncMode    bool                        // indicate whether to create the bucket in asynchronous mode.
@@ -27,25 +29,29 @@ type CreateBucketOptions struct {
 // MigrateBucketOptions indicates the metadata to construct `MigrateBucket` msg of storage module.
 type MigrateBucketOptions struct {
 	TxOpts      *gnfdsdktypes.TxOption
-	IsAsyncMode bool // indicate whether to create the bucket in asynchronous mode
+	Account     *Account // Account defines the account signing the transaction instead of the default account of the client.
+	IsAsyncMode bool     // indicate whether to create the bucket in asynchronous mode
 }
 
 // CancelMigrateBucketOptions indicates the metadata to construct `CancelMigrateBucket` msg of storage module.
 type CancelMigrateBucketOptions struct {
 	TxOpts      *gnfdsdktypes.TxOption
-	IsAsyncMode bool // indicate whether to create the bucket in asynchronous mode
+	Account     *Account // Account defines the account signing the transaction instead of the default account of the client.
+	IsAsyncMode bool     // indicate whether to create the bucket in asynchronous mode
 }
 
 // VoteProposalOptions indicates the metadata to construct `VoteProposal` msg.
 type VoteProposalOptions struct {
 	Metadata string                // Metadata defines the metadata to be submitted along with the vote.
 	TxOpts   gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account  *Account              // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 // SubmitProposalOptions indicates the metadata to construct `SubmitProposal` msg.
 type SubmitProposalOptions struct {
 	Metadata string                // metadata efines the metadata to be submitted along with the proposal.
 	TxOpts   gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account  *Account              // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 // CreateStorageProviderOptions indicates the metadata to construct `CreateStorageProvider` msg.
@@ -58,76 +64,90 @@ type CreateStorageProviderOptions struct {
 	ProposalSummary       string
 	ProposalMetaData      string
 	TxOpts                gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account               *Account              // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 // GrantDepositForStorageProviderOptions indicates the metadata to construct `Grant` msg.
 type GrantDepositForStorageProviderOptions struct {
 	Expiration *time.Time            // Expiration defines the expiration time of grant.
 	TxOpts     gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account    *Account              // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 // DeleteBucketOption indicates the metadata to construct `DeleteBucket` msg.
 type DeleteBucketOption struct {
-	TxOpts *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	TxOpts  *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account *Account               // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 // UpdatePaymentOption indicates the metadata to construct `UpdateBucketInfo` msg.
 type UpdatePaymentOption struct {
-	TxOpts *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	TxOpts  *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account *Account               // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 // SetBucketFlowRateLimitOption indicates the metadata to construct `SetBucketFlowRateLimit` msg.
 type SetBucketFlowRateLimitOption struct {
-	TxOpts *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	TxOpts  *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account *Account               // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 // UpdateBucketOptions indicates the metadata to construct `UpdateBucketInfo` msg of storage module.
 type UpdateBucketOptions struct {
 	Visibility     storageTypes.VisibilityType // Visibility defines the bucket public status.
 	TxOpts         *gnfdsdktypes.TxOption      // TxOpts defines the options to customize a transaction.
+	Account        *Account                    // Account defines the account signing the transaction instead of the default account of the client.
 	PaymentAddress string                      // PaymentAddress defines the HEX-encoded string of the payment address.
 	ChargedQuota   *uint64                     // ChargedQuota defines the read data that users are charged for, measured in bytes.
 }
 
 // UpdateObjectOption indicates the metadata to construct `UpdateObjectInfo` msg of storage module.
 type UpdateObjectOption struct {
-	TxOpts *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	TxOpts  *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account *Account               // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 // CancelUpdateObjectOption indicates the metadata to construct `CancelUpdateObjectContent` msg of storage module.
 type CancelUpdateObjectOption struct {
-	TxOpts *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	TxOpts  *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account *Account               // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 // CancelCreateOption indicates the metadata to construct `CancelCreateObject` msg of storage module.
 type CancelCreateOption struct {
-	TxOpts *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	TxOpts  *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account *Account               // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 // BuyQuotaOption indicates the metadata to construct `UpdateBucketInfo` msg of storage module.
 type BuyQuotaOption struct {
-	TxOpts *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	TxOpts  *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account *Account               // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 // UpdateVisibilityOption indicates the metadata to construct `UpdateBucketInfo` msg of storage module.
 type UpdateVisibilityOption struct {
-	TxOpts *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	TxOpts  *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account *Account               // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 // DeleteObjectOption indicates the metadata to construct `DeleteObject` msg of storage module.
 type DeleteObjectOption struct {
-	TxOpts *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	TxOpts  *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account *Account               // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 // DeleteGroupOption indicates the metadata to construct `DeleteGroup` msg of storage module.
 type DeleteGroupOption struct {
-	TxOpts *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	TxOpts  *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account *Account               // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 // CreateObjectOptions - indicates the metadata to construct `createObject` message of storage module.
 type CreateObjectOptions struct {
 	Visibility          storageTypes.VisibilityType // Visibility defines the bucket public status.
 	TxOpts              *gnfdsdktypes.TxOption      // TxOpts defines the options to customize a transaction.
+	Account             *Account                    // Account defines the account signing the transaction instead of the default account of the client.
 	SecondarySPAccs     []sdk.AccAddress            // SecondarySPAccs indicates a list of secondary Storage Provider's addresses.
 	ContentType         string                      // ContentType defines the content type of object.
 	IsReplicaType       bool                        // IsReplicaType indicates whether the object uses REDUNDANCY_REPLICA_TYPE.
@@ -139,6 +159,7 @@ type CreateObjectOptions struct {
 // UpdateObjectOptions - indicates the metadata to construct `updateObjectContent` message of storage module.
 type UpdateObjectOptions struct {
 	TxOpts              *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account             *Account               // Account defines the account signing the transaction instead of the default account of the client.
 	SecondarySPAccs     []sdk.AccAddress       // SecondarySPAccs indicates a list of secondary Storage Provider's addresses.
 	ContentType         string                 // ContentType defines the content type of object.
 	IsReplicaType       bool                   // IsReplicaType indicates whether the object uses REDUNDANCY_REPLICA_TYPE.
@@ -148,25 +169,29 @@ type UpdateObjectOptions struct {
 
 // CreateGroupOptions indicates the metadata to construct `CreateGroup` msg.
 type CreateGroupOptions struct {
-	Extra  string                     // Extra defines the extra meta for a group.
-	TxOpts *gnfdsdktypes.TxOption     // TxOpts defines the options to customize a transaction.
-	Tags   *storageTypes.ResourceTags // set tags when creating bucket
+	Extra   string                     // Extra defines the extra meta for a group.
+	TxOpts  *gnfdsdktypes.TxOption     // TxOpts defines the options to customize a transaction.
+	Account *Account                   // Account defines the account signing the transaction instead of the default account of the client.
+	Tags    *storageTypes.ResourceTags // set tags when creating bucket
 }
 
 // UpdateGroupMemberOption indicates the metadata to construct `UpdateGroupMembers` msg.
 type UpdateGroupMemberOption struct {
 	TxOpts         *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account        *Account               // Account defines the account signing the transaction instead of the default account of the client.
 	ExpirationTime []*time.Time           // ExpirationTime defines a list of expiration time for each group member to be updated.
 }
 
 // LeaveGroupOption indicates the metadata to construct `LeaveGroup` msg of storage module.
 type LeaveGroupOption struct {
-	TxOpts *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	TxOpts  *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account *Account               // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 // RenewGroupMemberOption indicates the metadata to construct `RenewGroupMember` msg of storage module.
 type RenewGroupMemberOption struct {
 	TxOpts         *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account        *Account               // Account defines the account signing the transaction instead of the default account of the client.
 	ExpirationTime []*time.Time           // ExpirationTime defines a list of expiration time for each group member to be updated.
 }
 
@@ -219,12 +244,14 @@ type ListObjectsOptions struct {
 // PutPolicyOption indicates the metadata to construct `PutPolicy` msg of storage module.
 type PutPolicyOption struct {
 	TxOpts           *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account          *Account               // Account defines the account signing the transaction instead of the default account of the client.
 	PolicyExpireTime *time.Time             // PolicyExpireTime defines the expiration timestamp of policy.
 }
 
 // DeletePolicyOption indicates the metadata to construct `DeletePolicy` msg of storage module.
 type DeletePolicyOption struct {
-	TxOpts *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	TxOpts  *gnfdsdktypes.TxOption // TxOpts defines the options to customize a transaction.
+	Account *Account               // Account defines the account signing the transaction instead of the default account of the client.
 }
 
 type NewStatementOptions struct {
@@ -241,13 +268,15 @@ type PutObjectOptions struct {
 	Delegated        bool // Delegated indicates that the request to SP will require SP to create/update objet behalf of the uploader.
 	IsUpdate         bool // IsUpdate indicates that the request to SP is a delegated update object request.
 	Visibility       storageTypes.VisibilityType
+	Account          *Account // Account defines the account signing the requests to SP instead of the default account of the client.
 }
 
 // GetObjectOptions contains the options for `GetObject` API.
 type GetObjectOptions struct {
-	Range            string `url:"-" header:"Range,omitempty"` // Range support for downloading partial data.
-	SupportResumable bool   // SupportResumable support resumable download. Resumable downloads refer to the capability of resuming interrupted or incomplete downloads from the point where they were paused or disrupted.
-	PartSize         uint64 // PartSize indicate the resumable download's part size, download a large file in multiple parts. The part size is an integer multiple of the segment size.
+	Range            string   `url:"-" header:"Range,omitempty"` // Range support for downloading partial data.
+	SupportResumable bool     // SupportResumable support resumable download. Resumable downloads refer to the capability of resuming interrupted or incomplete downloads from the point where they were paused or disrupted.
+	PartSize         uint64   // PartSize indicate the resumable download's part size, download a large file in multiple parts. The part size is an integer multiple of the segment size.
+	Account          *Account // Account defines the account signing the requests to SP instead of the default account of the client.
 }
 
 // GetChallengeInfoOptions contains the options for querying challenge data.
@@ -361,3 +390,84 @@ type ListObjectPoliciesOptions struct {
 	Endpoint   string // Endpoint indicates the endpoint of sp.
 	SPAddress  string // SPAddress indicates the HEX-encoded string of the sp address to be challenged.
 }
+
+// ScanHDWalletOptions contains the options for `ScanHDWalletAccounts` API.
+type ScanHDWalletOptions struct {
+	StartIndex uint32 // StartIndex defines the index of the first account to be scanned.
+	GapLimit   uint32 // GapLimit defines how many consecutive unused accounts stop the scan, the default value is 20.
+}
+
+// AccountPoolOptions contains the options for creating an `AccountPool`.
+type AccountPoolOptions struct {
+	MaxPendingTxPerAccount int64    // MaxPendingTxPerAccount defines the number of in-flight txs allowed per account, the default value is 8.
+	MinBalance             math.Int // MinBalance defines the balance under which an account is topped up by the funder when rebalancing.
+	TopUpAmount            math.Int // TopUpAmount defines the amount transferred from the funder to each account whose balance is under MinBalance.
+}
+
+// UploadObjectOptions contains the options for `AccountPool.UploadObject` API.
+type UploadObjectOptions struct {
+	CreateOpts CreateObjectOptions // CreateOpts defines the options to create the object meta on chain.
+	PutOpts    PutObjectOptions    // PutOpts defines the options to upload the object payload to the storage provider.
+}
+
+// PermissionReconcilerOptions contains the options for creating a `PermissionReconciler`.
+type PermissionReconcilerOptions struct {
+	Account             *Account               // Account defines the account owning the groups and signing the txs instead of the default account of the client.
+	TxOpts              *gnfdsdktypes.TxOption // TxOpts defines the options to customize the txs.
+	MaxMsgsPerTx        int                    // MaxMsgsPerTx defines the number of msgs batched into one tx, the default value is 10.
+	PruneObjectPolicies bool                   // PruneObjectPolicies defines whether the policies of the undesired principals on the desired objects are deleted.
+	DryRun              bool                   // DryRun defines whether Reconcile only plans the changes without applying them.
+}
+
+// EvaluatePermissionOptions contains the options for `EvaluatePermission` API.
+type EvaluatePermissionOptions struct {
+	Time       time.Time // Time defines the time when the expiration of the policies and the group members is evaluated, the default value is now.
+	WantedSize *uint64   // WantedSize defines the size of the object to be created, which is checked against the limit size of ACTION_CREATE_OBJECT.
+}
+
+// AuditBucketPermissionsOptions contains the options for `AuditBucketPermissions` API.
+type AuditBucketPermissionsOptions struct {
+	Accounts []string  // Accounts defines the extra accounts whose bucket policies and group policies are audited, since the bucket policies can not be listed.
+	Time     time.Time // Time defines the time when the expiration is evaluated, the default value is now.
+}
+
+// GrantManagerOptions contains the options for creating a `GrantManager`.
+type GrantManagerOptions struct {
+	Account *Account               // Account defines the account sharing the objects and signing the txs instead of the default account of the client.
+	TxOpts  *gnfdsdktypes.TxOption // TxOpts defines the options to customize the txs.
+}
+
+// PolicyTemplateOptions contains the options for `NewPolicyTemplateStatements` and `ApplyPolicyTemplate` API.
+type PolicyTemplateOptions struct {
+	Prefix     string     // Prefix defines the prefix of the objects scoped by the statements, all the objects are scoped if it is empty.
+	Expiration *time.Time // Expiration defines the expiration time of the statements.
+	LimitSize  uint64     // LimitSize defines the total size of the objects allowed to be created, it is only used by the delegated uploader template.
+}
+
+// SyncGroupMembersOptions contains the options for `SyncGroupMembers` API.
+type SyncGroupMembersOptions struct {
+	TxOpts       *gnfdsdktypes.TxOption // TxOpts defines the options to customize the txs.
+	Account      *Account               // Account defines the account signing the txs instead of the default account of the client.
+	MaxMsgsPerTx int                    // MaxMsgsPerTx defines the number of msgs batched into one tx, the default value is 10.
+	DryRun       bool                   // DryRun defines whether only the changes are reported without sending the txs.
+}
+
+// BulkUpdateGroupMemberOptions contains the options for `BulkUpdateGroupMember` API.
+type BulkUpdateGroupMemberOptions struct {
+	TxOpts         *gnfdsdktypes.TxOption // TxOpts defines the options to customize the txs, the Nonce is set by the API.
+	Account        *Account               // Account defines the account signing the txs instead of the default account of the client.
+	MaxMsgsPerTx   int                    // MaxMsgsPerTx defines the number of msgs batched into one tx, the default value is 10.
+	Concurrency    int                    // Concurrency defines the number of the membership queries and the unconfirmed txs in flight, the default value is 4.
+	CheckpointPath string                 // CheckpointPath defines the file recording the progress, the update is resumed from it and it is removed on completion.
+}
+
+// GroupExpirationMonitorOptions contains the options for creating a `GroupExpirationMonitor`.
+type GroupExpirationMonitorOptions struct {
+	Owner    string                     // Owner defines the HEX-encoded address of the owner of the monitored groups, the default value is the address of Account.
+	Account  *Account                   // Account defines the account renewing the members instead of the default account of the client.
+	TxOpts   *gnfdsdktypes.TxOption     // TxOpts defines the options to customize the renewal txs.
+	Window   time.Duration              // Window defines how long before the expiration the members are reported, the default value is 7 days.
+	Interval time.Duration              // Interval defines the interval between the checks of Run, the default value is 1 hour.
+	Rules    []GroupRenewalRule         // Rules defines the rules renewing the expiring and expired members.
+	OnEvent  func(GroupExpirationEvent) // OnEvent defines the callback receiving the events, the events are only logged if it is nil.
+}
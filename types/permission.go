@@ -0,0 +1,186 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/bnb-chain/greenfield/types/resource"
+)
+
+// DesiredPermissions - The desired state of the groups, the group members and the policies, which is reconciled with
+// the chain by the PermissionReconciler. The groups are owned by the reconciling account.
+//
+// An example in YAML:
+//
+//	groups:
+//	  - name: analysts
+//	    members:
+//	      - address: 0x1D5F7B9B1c4a6C0B0a9f1A3c4C7a5D1a3c8B2e6F
+//	        expiration: 2025-01-01T00:00:00Z
+//	bucket_policies:
+//	  - bucket: reports
+//	    principal_group: analysts
+//	    statements:
+//	      - effect: Allow
+//	        actions: [ACTION_GET_OBJECT, ACTION_LIST_OBJECT]
+//	        resources: ["grn:o::reports/.*$"]
+//	  - bucket: reports
+//	    principal:
+//	      account: 0x6C1E0b6b1B23b2F0D1e9D5e8d5B3F0F0f4A2C9D1
+//	    absent: true
+type DesiredPermissions struct {
+	Groups         []DesiredGroup  `json:"groups,omitempty"`
+	BucketPolicies []DesiredPolicy `json:"bucket_policies,omitempty"`
+	ObjectPolicies []DesiredPolicy `json:"object_policies,omitempty"`
+	GroupPolicies  []DesiredPolicy `json:"group_policies,omitempty"`
+}
+
+// DesiredGroup - The desired group with all its members, the members not listed are removed from the group.
+type DesiredGroup struct {
+	Name    string          `json:"name"`
+	Extra   string          `json:"extra,omitempty"`
+	Members []DesiredMember `json:"members,omitempty"`
+}
+
+// DesiredMember - The desired member of a group, the member never expires if Expiration is not set.
+type DesiredMember struct {
+	Address    string     `json:"address"`
+	Expiration *time.Time `json:"expiration,omitempty"`
+}
+
+// DesiredPolicy - The desired policy of a principal on a bucket, an object or a group owned by the reconciling account.
+//
+// The principal is either the Principal of the policy document, or PrincipalGroup which is the name of a group owned by
+// the reconciling account, the group can be one of the desired groups which has not been created yet.
+type DesiredPolicy struct {
+	// Bucket is the bucket of the bucket policies and the object policies.
+	Bucket string `json:"bucket,omitempty"`
+	// Object is the object of the object policies.
+	Object string `json:"object,omitempty"`
+	// Group is the name of the group of the group policies.
+	Group string `json:"group,omitempty"`
+	// PrincipalGroup is the name of the group owned by the reconciling account as the principal.
+	PrincipalGroup string `json:"principal_group,omitempty"`
+	// Absent means the policy of the principal should be deleted, the statements are not needed then.
+	Absent bool `json:"absent,omitempty"`
+	PolicyDocument
+}
+
+// ParseDesiredPermissions - Parse the desired permissions in JSON or YAML and validate the policies.
+//
+// - data: The content of the desired permissions.
+//
+// - ret1: The parsed desired permissions.
+//
+// - ret2: Return error when the content is malformed or a policy is invalid, otherwise return nil.
+func ParseDesiredPermissions(data []byte) (*DesiredPermissions, error) {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid desired permissions: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.DisallowUnknownFields()
+	desired := &DesiredPermissions{}
+	if err = decoder.Decode(desired); err != nil {
+		return nil, fmt.Errorf("invalid desired permissions: %w", err)
+	}
+	if err = desired.Validate(); err != nil {
+		return nil, err
+	}
+	return desired, nil
+}
+
+// Validate - Validate the groups and the policies of the desired permissions.
+func (d *DesiredPermissions) Validate() error {
+	groups := make(map[string]bool, len(d.Groups))
+	for _, group := range d.Groups {
+		if group.Name == "" {
+			return errors.New("the name of a desired group is empty")
+		}
+		if groups[group.Name] {
+			return fmt.Errorf("the group %s is desired more than once", group.Name)
+		}
+		groups[group.Name] = true
+	}
+
+	policies := make(map[string]bool)
+	validate := func(kind string, resourceType resource.ResourceType, policy *DesiredPolicy) error {
+		key, err := policy.key(resourceType)
+		if err != nil {
+			return fmt.Errorf("%s policy: %w", kind, err)
+		}
+		if policies[key] {
+			return fmt.Errorf("the %s policy of %s is desired more than once", kind, key)
+		}
+		policies[key] = true
+		if policy.Absent {
+			return nil
+		}
+		if err = policy.Validate(resourceType); err != nil {
+			return fmt.Errorf("%s policy of %s: %w", kind, key, err)
+		}
+		return nil
+	}
+	for i := range d.BucketPolicies {
+		if err := validate("bucket", resource.RESOURCE_TYPE_BUCKET, &d.BucketPolicies[i]); err != nil {
+			return err
+		}
+	}
+	for i := range d.ObjectPolicies {
+		if err := validate("object", resource.RESOURCE_TYPE_OBJECT, &d.ObjectPolicies[i]); err != nil {
+			return err
+		}
+	}
+	for i := range d.GroupPolicies {
+		if d.GroupPolicies[i].PrincipalGroup != "" || d.GroupPolicies[i].Principal.GroupID != 0 {
+			return fmt.Errorf("the principal of the group policy of %s can only be an account", d.GroupPolicies[i].Group)
+		}
+		if err := validate("group", resource.RESOURCE_TYPE_GROUP, &d.GroupPolicies[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// key identifies the resource and the principal of the policy, it checks the fields are set for the resource type.
+func (p *DesiredPolicy) key(resourceType resource.ResourceType) (string, error) {
+	var resourceName string
+	switch resourceType {
+	case resource.RESOURCE_TYPE_BUCKET:
+		if p.Bucket == "" || p.Object != "" || p.Group != "" {
+			return "", errors.New("only the bucket should be set")
+		}
+		resourceName = p.Bucket
+	case resource.RESOURCE_TYPE_OBJECT:
+		if p.Bucket == "" || p.Object == "" || p.Group != "" {
+			return "", errors.New("only the bucket and the object should be set")
+		}
+		resourceName = p.Bucket + "/" + p.Object
+	case resource.RESOURCE_TYPE_GROUP:
+		if p.Group == "" || p.Bucket != "" || p.Object != "" {
+			return "", errors.New("only the group should be set")
+		}
+		resourceName = p.Group
+	}
+
+	switch {
+	case p.PrincipalGroup != "" && p.Principal != nil:
+		return "", fmt.Errorf("only one of the principal and the principal group of %s can be set", resourceName)
+	case p.PrincipalGroup != "":
+		return resourceName + " for group " + p.PrincipalGroup, nil
+	case p.Principal == nil:
+		return "", fmt.Errorf("the principal of %s is not set", resourceName)
+	}
+	if _, err := p.ToPrincipal(); err != nil {
+		return "", fmt.Errorf("%s: %w", resourceName, err)
+	}
+	if p.Principal.GroupID != 0 {
+		return fmt.Sprintf("%s for group id %d", resourceName, p.Principal.GroupID), nil
+	}
+	return resourceName + " for account " + p.Principal.Account, nil
+}
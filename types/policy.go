@@ -0,0 +1,359 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"sigs.k8s.io/yaml"
+
+	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/types/common"
+	"github.com/bnb-chain/greenfield/types/resource"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+)
+
+const (
+	PolicyEffectAllow = "Allow"
+	PolicyEffectDeny  = "Deny"
+	// PolicyActionAll is the short name of permTypes.ACTION_TYPE_ALL in the policy documents.
+	PolicyActionAll = "*"
+)
+
+// PolicyDocument - The human-readable policy document which can be reviewed and versioned as data, in JSON or YAML.
+//
+// An example in YAML:
+//
+//	principal:
+//	  account: 0x1D5F7B9B1c4a6C0B0a9f1A3c4C7a5D1a3c8B2e6F
+//	expiration: 2025-01-01T00:00:00Z
+//	statements:
+//	  - effect: Allow
+//	    actions: [ACTION_GET_OBJECT, ACTION_LIST_OBJECT]
+//	    resources: ["grn:o::my-bucket/photos/.*$"]
+//	  - effect: Allow
+//	    actions: [ACTION_CREATE_OBJECT]
+//	    limit_size: 1073741824
+//
+// The actions are the names of permTypes.ActionType, the "ACTION_" prefix and the case can be omitted, and "*" means
+// all the actions. The resources are the regular expressions matching the GRNs of the objects in the bucket, they can
+// only be used in the bucket policies. They are not wildcards: the chain matches them anywhere in the GRNs, so they
+// should be anchored at the end with "$", and the special characters of the object names such as "." should be
+// escaped, see NewObjectResourcePattern. The chain requires them to start with the literal "grn:o::bucket/", which
+// anchors them at the start unless an object name itself contains such a GRN, since "^" is not allowed by the chain.
+// E.g. "grn:o::my-bucket/photos/.*$" matches the objects whose names start with "photos/", while
+// "grn:o::my-bucket/photos/*" would also match the object "photosX", as "/*" means zero or more slashes.
+type PolicyDocument struct {
+	// Principal is the account or the group granted the permissions, it is not needed by the templates of the documents.
+	Principal *PolicyPrincipal `json:"principal,omitempty"`
+	// Expiration is the expiration time of the whole policy, it takes priority over the ones of the statements.
+	Expiration *time.Time `json:"expiration,omitempty"`
+	// Statements are the permissions granted or denied to the principal.
+	Statements []PolicyStatement `json:"statements"`
+}
+
+// PolicyPrincipal - The principal of the policy document, either Account or GroupID should be set.
+type PolicyPrincipal struct {
+	Account string `json:"account,omitempty"`
+	GroupID uint64 `json:"group_id,omitempty"`
+}
+
+// PolicyStatement - The statement of the policy document.
+type PolicyStatement struct {
+	// Effect is either "Allow" or "Deny".
+	Effect string `json:"effect"`
+	// Actions are the names of the actions, e.g. "ACTION_GET_OBJECT".
+	Actions []string `json:"actions"`
+	// Resources are the regular expressions of the GRNs of the objects anchored with "$", they can only be used in the
+	// bucket policies.
+	Resources []string `json:"resources,omitempty"`
+	// Expiration is the expiration time of the statement.
+	Expiration *time.Time `json:"expiration,omitempty"`
+	// LimitSize is the total size of the objects allowed to be created, it can only be used with ACTION_CREATE_OBJECT.
+	LimitSize *uint64 `json:"limit_size,omitempty"`
+}
+
+// ParsePolicyDocument - Parse the policy document in JSON or YAML, the unknown fields are rejected.
+//
+// - data: The content of the policy document.
+//
+// - ret1: The parsed policy document, it is not validated against a resource type yet.
+//
+// - ret2: Return error when the document is malformed or has unknown effects or actions, otherwise return nil.
+func ParsePolicyDocument(data []byte) (*PolicyDocument, error) {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy document: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.DisallowUnknownFields()
+	doc := &PolicyDocument{}
+	if err = decoder.Decode(doc); err != nil {
+		return nil, fmt.Errorf("invalid policy document: %w", err)
+	}
+	if _, err = doc.ToStatements(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// NewPolicyDocument - Convert the policy queried from the chain to the policy document, e.g. the result of GetBucketPolicy.
+//
+// - policy: The policy queried from the chain.
+//
+// - ret1: The policy document of the policy.
+//
+// - ret2: Return error when the principal of the policy is invalid, otherwise return nil.
+func NewPolicyDocument(policy *permTypes.Policy) (*PolicyDocument, error) {
+	doc := &PolicyDocument{Expiration: policy.ExpirationTime}
+	if policy.Principal != nil {
+		switch policy.Principal.Type {
+		case permTypes.PRINCIPAL_TYPE_GNFD_ACCOUNT:
+			doc.Principal = &PolicyPrincipal{Account: policy.Principal.Value}
+		case permTypes.PRINCIPAL_TYPE_GNFD_GROUP:
+			groupID, err := strconv.ParseUint(policy.Principal.Value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid group id %s of the principal: %w", policy.Principal.Value, err)
+			}
+			doc.Principal = &PolicyPrincipal{GroupID: groupID}
+		default:
+			return nil, fmt.Errorf("unsupported principal type %s", policy.Principal.Type)
+		}
+	}
+	doc.Statements = make([]PolicyStatement, 0, len(policy.Statements))
+	for _, statement := range policy.Statements {
+		docStatement := PolicyStatement{
+			Effect:     PolicyEffectAllow,
+			Actions:    make([]string, 0, len(statement.Actions)),
+			Resources:  statement.Resources,
+			Expiration: statement.ExpirationTime,
+		}
+		if statement.Effect == permTypes.EFFECT_DENY {
+			docStatement.Effect = PolicyEffectDeny
+		}
+		for _, action := range statement.Actions {
+			docStatement.Actions = append(docStatement.Actions, action.String())
+		}
+		if statement.LimitSize != nil {
+			limitSize := statement.LimitSize.GetValue()
+			docStatement.LimitSize = &limitSize
+		}
+		doc.Statements = append(doc.Statements, docStatement)
+	}
+	return doc, nil
+}
+
+// ToStatements - Convert the statements of the policy document to the statements of the permission module.
+//
+// - ret1: The statements which can be used by PutBucketPolicy, PutObjectPolicy and PutGroupPolicy.
+//
+// - ret2: Return error when an effect or an action is unknown, otherwise return nil.
+func (d *PolicyDocument) ToStatements() ([]*permTypes.Statement, error) {
+	if len(d.Statements) == 0 {
+		return nil, errors.New("the policy document has no statements")
+	}
+	statements := make([]*permTypes.Statement, 0, len(d.Statements))
+	for i, docStatement := range d.Statements {
+		effect, err := parsePolicyEffect(docStatement.Effect)
+		if err != nil {
+			return nil, fmt.Errorf("statement %d: %w", i, err)
+		}
+		if len(docStatement.Actions) == 0 {
+			return nil, fmt.Errorf("statement %d: no actions", i)
+		}
+		statement := &permTypes.Statement{
+			Effect:         effect,
+			Actions:        make([]permTypes.ActionType, 0, len(docStatement.Actions)),
+			Resources:      docStatement.Resources,
+			ExpirationTime: docStatement.Expiration,
+		}
+		for _, name := range docStatement.Actions {
+			action, err := ParsePolicyAction(name)
+			if err != nil {
+				return nil, fmt.Errorf("statement %d: %w", i, err)
+			}
+			statement.Actions = append(statement.Actions, action)
+		}
+		if docStatement.LimitSize != nil {
+			statement.LimitSize = &common.UInt64Value{Value: *docStatement.LimitSize}
+		}
+		statements = append(statements, statement)
+	}
+	return statements, nil
+}
+
+// ToPrincipal - Convert the principal of the policy document to the marshaled principal used by the policy APIs.
+//
+// - ret1: The marshaled principal, it is the same as the one created by NewPrincipalWithAccount or NewPrincipalWithGroupId.
+//
+// - ret2: Return error when the principal is not set or invalid, otherwise return nil.
+func (d *PolicyDocument) ToPrincipal() (Principal, error) {
+	if d.Principal == nil {
+		return "", errors.New("the principal of the policy document is not set")
+	}
+	var principal *permTypes.Principal
+	switch {
+	case d.Principal.Account != "" && d.Principal.GroupID != 0:
+		return "", errors.New("only one of the account and the group id can be set in the principal")
+	case d.Principal.Account != "":
+		addr, err := sdk.AccAddressFromHexUnsafe(d.Principal.Account)
+		if err != nil {
+			return "", fmt.Errorf("invalid account %s of the principal: %w", d.Principal.Account, err)
+		}
+		principal = permTypes.NewPrincipalWithAccount(addr)
+	case d.Principal.GroupID != 0:
+		principal = permTypes.NewPrincipalWithGroupId(sdkmath.NewUint(d.Principal.GroupID))
+	default:
+		return "", errors.New("either the account or the group id should be set in the principal")
+	}
+	principalBytes, err := principal.Marshal()
+	if err != nil {
+		return "", err
+	}
+	return Principal(principalBytes), nil
+}
+
+// Validate - Validate the policy document before it is put to the resource of the type.
+//
+// - resourceType: The type of the resource, i.e. bucket, object or group.
+//
+// - ret1: Return error when the principal or a statement is not allowed on the resource type, otherwise return nil.
+func (d *PolicyDocument) Validate(resourceType resource.ResourceType) error {
+	if d.Principal != nil {
+		if _, err := d.ToPrincipal(); err != nil {
+			return err
+		}
+	}
+	statements, err := d.ToStatements()
+	if err != nil {
+		return err
+	}
+	return ValidateStatements(resourceType, statements)
+}
+
+// ToJSON - Serialize the policy document in the indented JSON.
+func (d *PolicyDocument) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(d, "", "  ")
+}
+
+// ToYAML - Serialize the policy document in YAML.
+func (d *PolicyDocument) ToYAML() ([]byte, error) {
+	return yaml.Marshal(d)
+}
+
+// ValidateStatements - Validate the statements with the rules of the chain before they are put to the resource of the type.
+//
+// The actions should be allowed on the resource type, the resources can only be used in the bucket policies and should
+// be the regular expressions of the GRNs anchored at the end with "$", and the limit size can only be used with
+// ACTION_CREATE_OBJECT in the bucket policies.
+//
+// - resourceType: The type of the resource, i.e. bucket, object or group.
+//
+// - statements: The statements to validate.
+//
+// - ret1: Return error when a statement is not allowed, otherwise return nil.
+func ValidateStatements(resourceType resource.ResourceType, statements []*permTypes.Statement) error {
+	for i, statement := range statements {
+		if err := validateStatement(resourceType, statement); err != nil {
+			return fmt.Errorf("statement %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func validateStatement(resourceType resource.ResourceType, statement *permTypes.Statement) error {
+	if err := statement.ValidateBasic(resourceType); err != nil {
+		return err
+	}
+	if resourceType != resource.RESOURCE_TYPE_BUCKET {
+		if statement.Resources != nil {
+			return permTypes.ErrInvalidStatement.Wrap("The Resources option can only be used at the bucket level.")
+		}
+		return nil
+	}
+
+	for _, r := range statement.Resources {
+		if err := validateResourcePattern(r); err != nil {
+			return err
+		}
+	}
+	containsCreateObject := false
+	for _, action := range statement.Actions {
+		if !permTypes.BucketAllowedActionsAfterPampas[action] {
+			return permTypes.ErrInvalidStatement.Wrapf("%s not allowed to be used on bucket.", action.String())
+		}
+		if action == permTypes.ACTION_CREATE_OBJECT {
+			containsCreateObject = true
+		}
+	}
+	if !containsCreateObject && statement.LimitSize != nil {
+		return permTypes.ErrInvalidStatement.Wrap("The LimitSize option can only be used with CreateObject actions at the bucket level.")
+	}
+	return nil
+}
+
+// NewObjectResourcePattern - Build the resource of the bucket statements matching the objects whose names start with
+// the prefix, e.g. "grn:o::my-bucket/photos/.*$" for the prefix "photos/". The prefix is quoted, so its special
+// characters such as "." only match themselves. The bucket name is kept as is since the chain requires a valid bucket
+// name in the resources, a "." of it can only match the bucket itself as the bucket policies only apply to its objects.
+//
+// - bucketName: The bucket name of the objects.
+//
+// - prefix: The prefix of the object names, all the objects of the bucket are matched if it is empty.
+//
+// - ret1: The regular expression of the GRNs of the objects.
+func NewObjectResourcePattern(bucketName, prefix string) string {
+	return gnfdTypes.NewObjectGRN(bucketName, regexp.QuoteMeta(prefix)).String() + ".*$"
+}
+
+// validateResourcePattern checks the resource of a bucket statement is a regular expression anchored at the end, since
+// the chain matches the resources anywhere in the GRNs. The start can not be anchored with "^" as the chain requires
+// the resources to start with "grn:", which is checked by the ValidateBasic of the statement.
+func validateResourcePattern(pattern string) error {
+	if _, err := regexp.Compile(pattern); err != nil {
+		return permTypes.ErrInvalidStatement.Wrapf("The Resources regexp compile failed, err: %s", err)
+	}
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return permTypes.ErrInvalidStatement.Wrapf("The Resources regexp compile failed, err: %s", err)
+	}
+	if re.Op == syntax.OpConcat && re.Sub[len(re.Sub)-1].Op == syntax.OpEndText {
+		return nil
+	}
+	return permTypes.ErrInvalidStatement.Wrapf("The Resources regexp %q should be anchored with $ to match the end of the GRN.", pattern)
+}
+
+// ParsePolicyAction - Parse the name of the action, e.g. "ACTION_GET_OBJECT", "get_object" or "*".
+func ParsePolicyAction(name string) (permTypes.ActionType, error) {
+	if name == PolicyActionAll {
+		return permTypes.ACTION_TYPE_ALL, nil
+	}
+	normalized := strings.ToUpper(strings.TrimSpace(name))
+	if !strings.HasPrefix(normalized, "ACTION_") {
+		normalized = "ACTION_" + normalized
+	}
+	action, ok := permTypes.ActionType_value[normalized]
+	if !ok || permTypes.ActionType(action) == permTypes.ACTION_UNSPECIFIED {
+		return permTypes.ACTION_UNSPECIFIED, fmt.Errorf("unknown action %s", name)
+	}
+	return permTypes.ActionType(action), nil
+}
+
+func parsePolicyEffect(name string) (permTypes.Effect, error) {
+	switch strings.ToUpper(strings.TrimSpace(name)) {
+	case "ALLOW", permTypes.EFFECT_ALLOW.String():
+		return permTypes.EFFECT_ALLOW, nil
+	case "DENY", permTypes.EFFECT_DENY.String():
+		return permTypes.EFFECT_DENY, nil
+	default:
+		return permTypes.EFFECT_UNSPECIFIED, fmt.Errorf("unknown effect %s, it should be %s or %s", name, PolicyEffectAllow, PolicyEffectDeny)
+	}
+}
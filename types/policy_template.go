@@ -0,0 +1,104 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bnb-chain/greenfield/types/common"
+	"github.com/bnb-chain/greenfield/types/resource"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+)
+
+// PolicyTemplate - The template of the bucket policy statements for a common access pattern.
+type PolicyTemplate string
+
+const (
+	// PolicyTemplatePublicReadPrefix grants reading the objects under the prefix and listing the bucket. Since the
+	// policies are granted to principals, the objects are readable by the anonymous users only with the public-read
+	// visibility, the template is meant for a group which anyone can join or for the accounts of a CDN.
+	PolicyTemplatePublicReadPrefix PolicyTemplate = "public_read_prefix"
+	// PolicyTemplateDropbox grants creating objects, and denies listing the bucket and reading the objects under the
+	// prefix explicitly, so that the uploaders can not see what has been uploaded even if they are granted elsewhere.
+	PolicyTemplateDropbox PolicyTemplate = "dropbox"
+	// PolicyTemplateReadOnlyAuditor grants listing the bucket and reading the objects under the prefix, without executing them.
+	PolicyTemplateReadOnlyAuditor PolicyTemplate = "read_only_auditor"
+	// PolicyTemplateDelegatedUploader grants creating objects up to the size limit in total, the size limit is
+	// consumed by the objects created and is required by the template.
+	PolicyTemplateDelegatedUploader PolicyTemplate = "delegated_uploader"
+)
+
+// PolicyTemplates are all the policy templates.
+var PolicyTemplates = []PolicyTemplate{
+	PolicyTemplatePublicReadPrefix,
+	PolicyTemplateDropbox,
+	PolicyTemplateReadOnlyAuditor,
+	PolicyTemplateDelegatedUploader,
+}
+
+// NewPolicyTemplateStatements - Produce the validated bucket policy statements of the template.
+//
+// The prefix scopes the statements on the objects, e.g. getting and deleting objects. The chain does not check the
+// resources of the statements when creating objects or listing the bucket, so these actions are not scoped.
+//
+// - template: The policy template.
+//
+// - bucketName: The bucket name identifies the bucket the statements are put to.
+//
+// - opts: The options to set the prefix of the objects, the expiration time of the statements and the size limit.
+//
+// - ret1: The statements of the template.
+//
+// - ret2: Return error when the template is unknown or the options are invalid for the template, otherwise return nil.
+func NewPolicyTemplateStatements(template PolicyTemplate, bucketName string, opts PolicyTemplateOptions) ([]*permTypes.Statement, error) {
+	if bucketName == "" {
+		return nil, errors.New("the bucket name of the policy template is empty")
+	}
+	if opts.LimitSize != 0 && template != PolicyTemplateDelegatedUploader {
+		return nil, fmt.Errorf("the size limit can not be used with the policy template %s", template)
+	}
+	objects := []string{NewObjectResourcePattern(bucketName, opts.Prefix)}
+
+	var statements []*permTypes.Statement
+	switch template {
+	case PolicyTemplatePublicReadPrefix:
+		statements = []*permTypes.Statement{{
+			Effect:    permTypes.EFFECT_ALLOW,
+			Actions:   []permTypes.ActionType{permTypes.ACTION_LIST_OBJECT, permTypes.ACTION_GET_OBJECT, permTypes.ACTION_EXECUTE_OBJECT},
+			Resources: objects,
+		}}
+	case PolicyTemplateDropbox:
+		statements = []*permTypes.Statement{{
+			Effect:  permTypes.EFFECT_ALLOW,
+			Actions: []permTypes.ActionType{permTypes.ACTION_CREATE_OBJECT},
+		}, {
+			Effect:    permTypes.EFFECT_DENY,
+			Actions:   []permTypes.ActionType{permTypes.ACTION_LIST_OBJECT, permTypes.ACTION_GET_OBJECT, permTypes.ACTION_COPY_OBJECT},
+			Resources: objects,
+		}}
+	case PolicyTemplateReadOnlyAuditor:
+		statements = []*permTypes.Statement{{
+			Effect:    permTypes.EFFECT_ALLOW,
+			Actions:   []permTypes.ActionType{permTypes.ACTION_LIST_OBJECT, permTypes.ACTION_GET_OBJECT},
+			Resources: objects,
+		}}
+	case PolicyTemplateDelegatedUploader:
+		if opts.LimitSize == 0 {
+			return nil, fmt.Errorf("the size limit is required by the policy template %s", template)
+		}
+		statements = []*permTypes.Statement{{
+			Effect:    permTypes.EFFECT_ALLOW,
+			Actions:   []permTypes.ActionType{permTypes.ACTION_CREATE_OBJECT},
+			LimitSize: &common.UInt64Value{Value: opts.LimitSize},
+		}}
+	default:
+		return nil, fmt.Errorf("unknown policy template %s", template)
+	}
+
+	for _, statement := range statements {
+		statement.ExpirationTime = opts.Expiration
+	}
+	if err := ValidateStatements(resource.RESOURCE_TYPE_BUCKET, statements); err != nil {
+		return nil, fmt.Errorf("invalid statements of the policy template %s: %w", template, err)
+	}
+	return statements, nil
+}
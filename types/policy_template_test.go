@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+func TestPolicyTemplateResources(t *testing.T) {
+	statements, err := types.NewPolicyTemplateStatements(types.PolicyTemplatePublicReadPrefix, "my.bucket",
+		types.PolicyTemplateOptions{Prefix: "a.b/"})
+	require.NoError(t, err)
+	require.Len(t, statements, 1)
+
+	tests := []struct {
+		name   string
+		grn    string
+		effect permTypes.Effect
+	}{
+		{"object under the prefix", gnfdTypes.NewObjectGRN("my.bucket", "a.b/c.txt").String(), permTypes.EFFECT_ALLOW},
+		{"object of the prefix itself", gnfdTypes.NewObjectGRN("my.bucket", "a.b/").String(), permTypes.EFFECT_ALLOW},
+		{"dot of the prefix is not a wildcard", gnfdTypes.NewObjectGRN("my.bucket", "aXb/c.txt").String(), permTypes.EFFECT_UNSPECIFIED},
+		{"name sharing the prefix without the slash", gnfdTypes.NewObjectGRN("my.bucket", "a.bc/d.txt").String(), permTypes.EFFECT_UNSPECIFIED},
+		{"name containing the prefix", gnfdTypes.NewObjectGRN("my.bucket", "x/a.b/c.txt").String(), permTypes.EFFECT_UNSPECIFIED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the statements are evaluated by the chain's own matching of the resources
+			effect, _ := statements[0].Eval(permTypes.ACTION_GET_OBJECT, &permTypes.VerifyOptions{Resource: tt.grn})
+			require.Equal(t, tt.effect, effect)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/types/resource"
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+)
+
+func TestValidateStatementsResources(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource string
+		valid    bool
+	}{
+		{"anchored pattern", types.NewObjectResourcePattern("my-bucket", "photos/"), true},
+		{"anchored literal", "grn:o::my-bucket/a\\.txt$", true},
+		{"anchored alternatives", "grn:o::my-bucket/(a|b)/.*$", true},
+		{"unanchored glob", "grn:o::my-bucket/photos/*", false},
+		{"missing end anchor", "grn:o::my-bucket/photos/.*", false},
+		{"anchor of one alternative only", "grn:o::my-bucket/a/.*|b/.*$", false},
+		{"start anchor rejected by the chain", "^grn:o::my-bucket/photos/.*$", false},
+		{"quoted bucket name rejected by the chain", "grn:o::my\\.bucket/photos/.*$", false},
+		{"invalid regexp", "grn:o::my-bucket/(photos$", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.ValidateStatements(resource.RESOURCE_TYPE_BUCKET, []*permTypes.Statement{{
+				Effect:    permTypes.EFFECT_ALLOW,
+				Actions:   []permTypes.ActionType{permTypes.ACTION_GET_OBJECT},
+				Resources: []string{tt.resource},
+			}})
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gnfdTypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/types/resource"
+	"github.com/bnb-chain/greenfield/types/s3util"
+)
+
+// Resource - A bucket, an object or a group addressed by its Greenfield Resource Name (GRN), e.g.
+//
+//	bucket: "grn:b::bucketName"
+//	object: "grn:o::bucketName/objectName"
+//	group:  "grn:g:ownerAddress:groupName"
+//
+// A resource with wildcards is only meant for the resources of the policy statements, e.g. "grn:o::bucketName/prefix.*".
+type Resource struct {
+	Type       resource.ResourceType
+	BucketName string // BucketName is set for the bucket and object resources.
+	ObjectName string // ObjectName is set for the object resources.
+	GroupOwner string // GroupOwner is the HEX-encoded address of the group owner, it is set for the group resources.
+	GroupName  string // GroupName is set for the group resources.
+}
+
+// NewBucketResource - Create the resource of the bucket.
+func NewBucketResource(bucketName string) Resource {
+	return Resource{Type: resource.RESOURCE_TYPE_BUCKET, BucketName: bucketName}
+}
+
+// NewObjectResource - Create the resource of the object.
+func NewObjectResource(bucketName, objectName string) Resource {
+	return Resource{Type: resource.RESOURCE_TYPE_OBJECT, BucketName: bucketName, ObjectName: objectName}
+}
+
+// NewGroupResource - Create the resource of the group.
+//
+// - groupOwner: The HEX-encoded string of the group owner address.
+//
+// - groupName: The group name identifies the group.
+func NewGroupResource(groupOwner, groupName string) Resource {
+	return Resource{Type: resource.RESOURCE_TYPE_GROUP, GroupOwner: groupOwner, GroupName: groupName}
+}
+
+// ParseResource - Parse the resource from its GRN.
+//
+// - grn: The GRN of the resource.
+//
+// - wildcards: Whether the names can contain wildcards, which is only allowed in the resources of the policy statements.
+//
+// - ret1: The parsed resource.
+//
+// - ret2: Return error when the GRN is malformed or the names are invalid, otherwise return nil.
+func ParseResource(grn string, wildcards bool) (Resource, error) {
+	var parsed gnfdTypes.GRN
+	if err := parsed.ParseFromString(grn, wildcards); err != nil {
+		return Resource{}, err
+	}
+	var r Resource
+	switch parsed.ResourceType() {
+	case resource.RESOURCE_TYPE_BUCKET:
+		r = NewBucketResource(parsed.MustGetBucketName())
+	case resource.RESOURCE_TYPE_OBJECT:
+		bucketName, objectName, err := parsed.GetBucketAndObjectName()
+		if err != nil {
+			return Resource{}, err
+		}
+		r = NewObjectResource(bucketName, objectName)
+	case resource.RESOURCE_TYPE_GROUP:
+		owner, groupName := parsed.MustGetGroupOwnerAndAccount()
+		r = NewGroupResource(owner.String(), groupName)
+	default:
+		return Resource{}, fmt.Errorf("unknown resource type of the GRN %s", grn)
+	}
+	if err := r.Validate(wildcards); err != nil {
+		return Resource{}, err
+	}
+	return r, nil
+}
+
+// Validate checks whether the names of the resource are valid, the names are only checked to be non-empty if
+// wildcards are allowed.
+func (r Resource) Validate(wildcards bool) error {
+	switch r.Type {
+	case resource.RESOURCE_TYPE_BUCKET, resource.RESOURCE_TYPE_OBJECT:
+		if r.BucketName == "" || strings.Contains(r.BucketName, "/") {
+			return fmt.Errorf("invalid bucket name %q of the resource", r.BucketName)
+		}
+		if !wildcards {
+			if err := s3util.CheckValidBucketName(r.BucketName); err != nil {
+				return err
+			}
+		}
+		if r.Type == resource.RESOURCE_TYPE_BUCKET {
+			if r.ObjectName != "" {
+				return fmt.Errorf("the bucket resource %s has the object name %s", r.BucketName, r.ObjectName)
+			}
+			return nil
+		}
+		if r.ObjectName == "" {
+			return errors.New("the object name of the resource is empty")
+		}
+		if !wildcards {
+			return s3util.CheckValidObjectName(r.ObjectName)
+		}
+		return nil
+	case resource.RESOURCE_TYPE_GROUP:
+		if _, err := sdk.AccAddressFromHexUnsafe(r.GroupOwner); err != nil {
+			return fmt.Errorf("invalid group owner %s of the resource: %w", r.GroupOwner, err)
+		}
+		if r.GroupName == "" {
+			return errors.New("the group name of the resource is empty")
+		}
+		if !wildcards {
+			return s3util.CheckValidGroupName(r.GroupName)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown resource type %s", r.Type)
+	}
+}
+
+// GRN returns the GRN of the resource in the chain types, it returns nil if the group owner is invalid.
+func (r Resource) GRN() *gnfdTypes.GRN {
+	switch r.Type {
+	case resource.RESOURCE_TYPE_BUCKET:
+		return gnfdTypes.NewBucketGRN(r.BucketName)
+	case resource.RESOURCE_TYPE_OBJECT:
+		return gnfdTypes.NewObjectGRN(r.BucketName, r.ObjectName)
+	case resource.RESOURCE_TYPE_GROUP:
+		owner, err := sdk.AccAddressFromHexUnsafe(r.GroupOwner)
+		if err != nil {
+			return nil
+		}
+		return gnfdTypes.NewGroupGRN(owner, r.GroupName)
+	default:
+		return nil
+	}
+}
+
+// String returns the GRN of the resource, e.g. "grn:o::bucketName/objectName", it returns "" if the resource is invalid.
+func (r Resource) String() string {
+	grn := r.GRN()
+	if grn == nil {
+		return ""
+	}
+	return grn.String()
+}
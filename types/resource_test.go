@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	"github.com/bnb-chain/greenfield/types/resource"
+)
+
+func TestResourceRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		resource  types.Resource
+		grn       string
+		wildcards bool
+	}{
+		{"bucket", types.NewBucketResource("my-bucket"), "grn:b::my-bucket", false},
+		{"object", types.NewObjectResource("my-bucket", "a.txt"), "grn:o::my-bucket/a.txt", false},
+		{"object in a folder", types.NewObjectResource("my-bucket", "dir/sub/a.txt"), "grn:o::my-bucket/dir/sub/a.txt", false},
+		{"group", types.NewGroupResource(memberA, "team"), "grn:g:" + memberA + ":team", false},
+		{"object pattern", types.NewObjectResource("my-bucket", "dir/.*"), "grn:o::my-bucket/dir/.*", true},
+		{"bucket pattern", types.NewBucketResource("my-.*"), "grn:b::my-.*", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.resource.Validate(tt.wildcards))
+			require.Equal(t, tt.grn, tt.resource.String())
+
+			parsed, err := types.ParseResource(tt.grn, tt.wildcards)
+			require.NoError(t, err)
+			require.Equal(t, tt.resource, parsed)
+			require.Equal(t, tt.grn, parsed.String())
+		})
+	}
+}
+
+func TestParseResourceErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		grn       string
+		wildcards bool
+	}{
+		{"not a GRN", "my-bucket", false},
+		{"unknown resource type", "grn:x::my-bucket", false},
+		{"invalid bucket name", "grn:b::My_Bucket", false},
+		{"object without the object name", "grn:o::my-bucket", false},
+		{"object with an empty object name", "grn:o::my-bucket/", true},
+		{"object pattern without wildcards", "grn:o::my-bucket/dir/.*", false},
+		{"group with an invalid owner", "grn:g:0x11:team", false},
+		{"group without the group name", "grn:g:" + memberA + ":", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := types.ParseResource(tt.grn, tt.wildcards)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestResourceStringInvalid(t *testing.T) {
+	require.Empty(t, types.NewGroupResource("0x11", "team").String())
+	require.Empty(t, types.Resource{}.String())
+	require.Error(t, types.Resource{}.Validate(true))
+	require.Error(t, types.NewObjectResource("my-bucket", "").Validate(true))
+	require.Error(t, types.Resource{Type: resource.RESOURCE_TYPE_BUCKET, BucketName: "my-bucket", ObjectName: "a.txt"}.Validate(false))
+}
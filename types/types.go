@@ -75,3 +75,31 @@ type StorageProvider struct {
 	Description     spTypes.Description
 	BlsKey          []byte
 }
+
+// CacheCounter indicates the hits and misses of a kind of cached metadata.
+type CacheCounter struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// CacheStats indicates the hits and misses of the metadata cache of the client.
+type CacheStats struct {
+	Params           CacheCounter
+	StorageProviders CacheCounter
+	BucketRoutes     CacheCounter
+	BucketInfos      CacheCounter
+}
+
+// SPHealthScore indicates the health of an SP endpoint observed by the client.
+type SPHealthScore struct {
+	Endpoint            string
+	Score               float64 // Score is in [0, 1], a higher score means a healthier SP
+	AvgLatency          time.Duration
+	Requests            uint64
+	Failures            uint64
+	ConsecutiveFailures int
+	InCooldown          bool
+	CooldownUntil       time.Time
+	LastError           string
+	LastProbe           time.Time
+}